@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepools
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rightsizing"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "machinepools"
+	short = "Suggest the instance types and replicas of the machine pools of a cluster"
+	long  = "Compares the CPU and memory requested and used on the nodes of a cluster with the capacity of " +
+		"their machine pools, and suggests the replicas and instance types that bring the utilization of " +
+		"the nodes to a target. The demand of a machine pool is the larger of the requests and the usage of " +
+		"its nodes. Another instance type is only suggested when it fits the demand with much less " +
+		"overhead. The suggestions come with the commands that apply them, the instance type of a machine " +
+		"pool is changed by replacing the machine pool with a copy.\n\n" + rightsizing.MetricsFormat
+	example = `  # Analyze the machine pools of cluster 'mycluster'
+  rosa analyze machinepools --cluster=mycluster --metrics-file=usage.json
+
+  # Size the machine pools for a utilization of 60% and print the result as JSON
+  rosa analyze machinepools --cluster=mycluster --metrics-file=usage.json --target-utilization=60 -o json`
+
+	metricsFileFlag       = "metrics-file"
+	targetUtilizationFlag = "target-utilization"
+)
+
+var aliases = []string{"machinepool", "machine-pools", "machine-pool"}
+
+type AnalyzeMachinePoolsOptions struct {
+	MetricsFile       string
+	TargetUtilization int
+}
+
+func NewAnalyzeMachinePoolsCommand() *cobra.Command {
+	options := &AnalyzeMachinePoolsOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), AnalyzeMachinePoolsRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.MetricsFile,
+		metricsFileFlag,
+		"",
+		"Path to the JSON file with the CPU and memory requests and usage of the nodes of the cluster.",
+	)
+	flags.IntVar(
+		&options.TargetUtilization,
+		targetUtilizationFlag,
+		rightsizing.DefaultTargetUtilization,
+		"Percentage of the CPU and memory of the nodes that the workloads should use.",
+	)
+	output.AddFlag(cmd)
+	cmd.MarkFlagRequired(metricsFileFlag)
+	return cmd
+}
+
+func AnalyzeMachinePoolsRunner(options *AnalyzeMachinePoolsOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if options.TargetUtilization < 1 || options.TargetUtilization > 100 {
+			return fmt.Errorf("Expected a percentage between 1 and 100 for '--%s'", targetUtilizationFlag)
+		}
+		metrics, err := rightsizing.LoadMetrics(options.MetricsFile)
+		if err != nil {
+			return fmt.Errorf("Failed to load the metrics file '%s': %v", options.MetricsFile, err)
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		var pools []*rightsizing.Pool
+		if cluster.Hypershift().Enabled() {
+			nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+			}
+			pools = rightsizing.NodePools(nodePools)
+		} else {
+			machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+			}
+			pools = rightsizing.MachinePools(machinePools)
+		}
+
+		r.Reporter.Debugf("Fetching instance types")
+		machineTypes, err := r.OCMClient.GetAvailableMachineTypes(ocm.FeatureFilters{})
+		if err != nil {
+			return fmt.Errorf("Failed to fetch instance types: %v", err)
+		}
+
+		analysis := rightsizing.Analyze(clusterKey, cluster.Name(), pools, metrics, machineTypes, options.TargetUtilization)
+		if output.HasFlag() {
+			return output.Print(analysis)
+		}
+		for _, warning := range analysis.Warnings {
+			r.Reporter.Warnf("%s", warning)
+		}
+		if len(analysis.Recommendations) == 0 {
+			r.Reporter.Infof("There are no machine pools with metrics to analyze on cluster '%s'", clusterKey)
+			return nil
+		}
+		printAnalysis(analysis)
+		return nil
+	}
+}
+
+func printAnalysis(analysis *rightsizing.Analysis) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "ID\tINSTANCE TYPE\tREPLICAS\tNODES\tCPU\tMEMORY\tSUGGESTION\n")
+	for _, recommendation := range analysis.Recommendations {
+		suggestion := "-"
+		switch {
+		case recommendation.NewInstanceType != "":
+			suggestion = fmt.Sprintf("%s x %s", recommendation.NewReplicas, recommendation.NewInstanceType)
+		case recommendation.NewReplicas != "":
+			suggestion = fmt.Sprintf("%s replicas", recommendation.NewReplicas)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%d\t%.0f%%\t%.0f%%\t%s\n",
+			recommendation.MachinePool,
+			recommendation.InstanceType,
+			recommendation.Replicas,
+			recommendation.Usage.Nodes,
+			recommendation.CPUUtilization*100,
+			recommendation.MemoryUtilization*100,
+			suggestion,
+		)
+	}
+	writer.Flush()
+
+	commands := []string{}
+	fmt.Println()
+	for _, recommendation := range analysis.Recommendations {
+		fmt.Printf("%s: %s\n", recommendation.MachinePool, recommendation.Reason)
+		commands = append(commands, recommendation.Commands...)
+	}
+	if len(commands) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Printf("To apply the suggestions for a target utilization of %d%%, run:\n", analysis.TargetUtilization)
+	for _, command := range commands {
+		fmt.Printf("  %s\n", command)
+	}
+}
@@ -0,0 +1,149 @@
+package machinepools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	machineTypes = `{
+  "kind": "MachineTypeList",
+  "page": 1,
+  "size": 2,
+  "total": 2,
+  "items": [
+    {
+      "kind": "MachineType",
+      "id": "m5.xlarge",
+      "category": "general_purpose",
+      "architecture": "amd64",
+      "memory": {"value": 17179869184, "unit": "B"},
+      "cpu": {"value": 4, "unit": "vCPU"}
+    },
+    {
+      "kind": "MachineType",
+      "id": "m5.4xlarge",
+      "category": "general_purpose",
+      "architecture": "amd64",
+      "memory": {"value": 68719476736, "unit": "B"},
+      "cpu": {"value": 16, "unit": "vCPU"}
+    }
+  ]
+}`
+	currentAccount = `{"kind": "Account", "organization": {"id": "123abc", "kind": "Organization"}}`
+	quotaCost      = `{"kind": "QuotaCostList", "items": []}`
+)
+
+// writeMetrics writes a metrics file where every node of the machine pool requests the same resources
+func writeMetrics(machinePool string, nodes int, cpu string, memory string) string {
+	series := func(value string) string {
+		result := ""
+		for i := 0; i < nodes; i++ {
+			if i > 0 {
+				result += ","
+			}
+			result += fmt.Sprintf(`{"metric": {"node": "node-%d", "machine_pool": "%s"}, "value": [1, "%s"]}`,
+				i, machinePool, value)
+		}
+		return fmt.Sprintf(`{"status": "success", "data": {"resultType": "vector", "result": [%s]}}`, result)
+	}
+	path := filepath.Join(GinkgoT().TempDir(), "usage.json")
+	contents := fmt.Sprintf(`{"cpu_requests": %s, "cpu_usage": %s, "memory_requests": %s, "memory_usage": %s}`,
+		series(cpu), series("0"), series(memory), series("0"))
+	Expect(os.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+	return path
+}
+
+var _ = Describe("analyze machinepools", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewAnalyzeMachinePoolsCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Aliases).To(ContainElements(aliases))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(metricsFileFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(targetUtilizationFlag)).NotTo(BeNil())
+	})
+
+	Context("Analyze machine pools runner", func() {
+		var t *TestingRuntime
+
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Name("cluster")
+			c.State(cmv1.ClusterStateReady)
+			c.Hypershift(cmv1.NewHypershift().Enabled(false))
+		})
+		machinePool, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").Replicas(3).
+			AvailabilityZones("us-east-1a").Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+			t.SetCluster("", nil)
+		})
+
+		It("Suggests the replicas of the machine pools and the commands to apply them", func() {
+			t.SetCluster("cluster", cluster)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatMachinePoolList([]*cmv1.MachinePool{machinePool})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, machineTypes))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, currentAccount))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, quotaCost))
+
+			cmd := NewAnalyzeMachinePoolsCommand()
+			Expect(cmd.Flags().Set("cluster", "cluster")).To(Succeed())
+			options := &AnalyzeMachinePoolsOptions{
+				MetricsFile:       writeMetrics("workers", 3, "1", "4294967296"),
+				TargetUtilization: 70,
+			}
+			Expect(t.StdOutReader.Record()).To(Succeed())
+			err := AnalyzeMachinePoolsRunner(options)(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, err := t.StdOutReader.Read()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal(
+				"ID       INSTANCE TYPE  REPLICAS  NODES  CPU  MEMORY  SUGGESTION\n" +
+					"workers  m5.xlarge      3         3      25%  25%     2 replicas\n" +
+					"\n" +
+					"workers: 2 nodes reach the target utilization of 70%, the machine pool has 3\n" +
+					"\n" +
+					"To apply the suggestions for a target utilization of 70%, run:\n" +
+					"  rosa edit machinepool --cluster=cluster --replicas=2 workers\n"))
+		})
+
+		It("Rejects an invalid target utilization", func() {
+			options := &AnalyzeMachinePoolsOptions{MetricsFile: "usage.json", TargetUtilization: 0}
+			err := AnalyzeMachinePoolsRunner(options)(context.Background(), t.RosaRuntime,
+				NewAnalyzeMachinePoolsCommand(), nil)
+			Expect(err).To(MatchError("Expected a percentage between 1 and 100 for '--target-utilization'"))
+		})
+
+		It("Fails for an invalid metrics file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "usage.json")
+			Expect(os.WriteFile(path, []byte(`{}`), 0600)).To(Succeed())
+			options := &AnalyzeMachinePoolsOptions{MetricsFile: path, TargetUtilization: 70}
+			err := AnalyzeMachinePoolsRunner(options)(context.Background(), t.RosaRuntime,
+				NewAnalyzeMachinePoolsCommand(), nil)
+			Expect(err).To(MatchError(fmt.Sprintf("Failed to load the metrics file '%s': missing the response "+
+				"of query 'cpu_requests'", path)))
+		})
+	})
+})
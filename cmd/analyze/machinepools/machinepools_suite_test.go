@@ -0,0 +1,13 @@
+package machinepools
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAnalyzeMachinePools(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Analyze Machine Pools Suite")
+}
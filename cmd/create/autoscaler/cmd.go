@@ -46,7 +46,10 @@ const (
   rosa create autoscaler --cluster=mycluster --log-verbosity 3
 
   # Create a cluster-autoscaler with total CPU constraints
-  rosa create autoscaler --cluster=mycluster --min-cores 10 --max-cores 100`
+  rosa create autoscaler --cluster=mycluster --min-cores 10 --max-cores 100
+
+  # Create a cluster-autoscaler that removes underutilized nodes quickly, with a longer provision time
+  rosa create autoscaler --cluster=mycluster --autoscaler-profile cost-optimized --max-node-provision-time 20m`
 )
 
 var aliases = []string{"cluster-autoscaler"}
@@ -67,6 +70,7 @@ func NewCreateAutoscalerCommand() *cobra.Command {
 	ocm.AddClusterFlag(cmd)
 	interactive.AddFlag(flags)
 	autoscalerArgs := clusterautoscaler.AddClusterAutoscalerFlags(cmd, argsPrefix)
+	AddProfileFlag(cmd)
 	cmd.Run = rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateAutoscalerRunner(autoscalerArgs))
 	return cmd
 }
@@ -98,7 +102,8 @@ func CreateAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) ro
 				"You should edit it via 'rosa edit autoscaler'", clusterKey)
 		}
 
-		if !clusterautoscaler.IsAutoscalerSetViaCLI(command.Flags(), argsPrefix) && !interactive.Enabled() {
+		if !clusterautoscaler.IsAutoscalerSetViaCLI(command.Flags(), argsPrefix) &&
+			!command.Flags().Changed(ProfileFlag) && !interactive.Enabled() {
 			interactive.Enable()
 			r.Reporter.Infof("Enabling interactive mode")
 		}
@@ -111,6 +116,11 @@ func CreateAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) ro
 			IsHostedCp:     cluster.Hypershift().Enabled(),
 		}
 
+		err = ApplyProfile(command.Flags(), argsPrefix, autoscalerArgs)
+		if err != nil {
+			return err
+		}
+
 		autoscalerArgs, err := clusterautoscaler.GetAutoscalerOptions(
 			command.Flags(), "", false, autoscalerArgs, autoscalerValidationArgs)
 		if err != nil {
@@ -118,6 +128,11 @@ func CreateAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) ro
 				cluster.ID(), err)
 		}
 
+		err = CheckResourceLimits(r, cluster, autoscalerArgs.ResourceLimits)
+		if err != nil {
+			return err
+		}
+
 		autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(autoscalerArgs)
 		if err != nil {
 			return fmt.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
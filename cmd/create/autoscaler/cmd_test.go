@@ -173,6 +173,7 @@ var _ = Describe("create autoscaler", func() {
 			t.ApiServer.RouteToHandler(http.MethodGet,
 				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
 				RespondWithJSON(http.StatusNotFound, "{}"))
+			routeCapacity(t, cluster)
 			t.ApiServer.RouteToHandler(http.MethodPost,
 				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
 				CombineHandlers(
@@ -192,5 +193,76 @@ var _ = Describe("create autoscaler", func() {
 			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("Creates an autoscaler with the settings of a profile", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.RouteToHandler(http.MethodGet,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
+				RespondWithJSON(http.StatusNotFound, "{}"))
+			routeCapacity(t, cluster)
+			t.ApiServer.RouteToHandler(http.MethodPost,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
+				CombineHandlers(
+					RespondWithJSON(http.StatusOK, FormatResource(test.MockAutoscaler(nil))),
+					VerifyJQ(`.scale_down.enabled`, true),
+					VerifyJQ(`.scale_down.unneeded_time`, "5m"),
+					VerifyJQ(`.scale_down.utilization_threshold`, "0.700000"),
+					VerifyJQ(`.balance_similar_node_groups`, true),
+					VerifyJQ(`.max_node_provision_time`, "20m"),
+				))
+			cmd := NewCreateAutoscalerCommand()
+			Expect(cmd.ParseFlags([]string{"--autoscaler-profile", "cost-optimized",
+				"--max-node-provision-time", "20m"})).To(Succeed())
+			args := &clusterautoscaler.AutoscalerArgs{}
+			args.MaxNodeProvisionTime = "20m"
+			args.ResourceLimits.Cores.Max = 100
+			args.ResourceLimits.Memory.Max = 1000
+			t.SetCluster("cluster", nil)
+			err := CreateAutoscalerRunner(args)(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Returns an error if the minimum cores can't be reached by the cluster", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.RouteToHandler(http.MethodGet,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
+				RespondWithJSON(http.StatusNotFound, "{}"))
+			routeCapacity(t, cluster)
+			cmd := NewCreateAutoscalerCommand()
+			Expect(cmd.ParseFlags([]string{"--min-cores", "50", "--max-cores", "100"})).To(Succeed())
+			args := &clusterautoscaler.AutoscalerArgs{}
+			args.MaxNodeProvisionTime = "15m"
+			args.ResourceLimits.Cores = clusterautoscaler.ResourceRange{Min: 50, Max: 100}
+			t.SetCluster("cluster", nil)
+			err := CreateAutoscalerRunner(args)(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("Resource limits can't be reached by the cluster: the minimum " +
+				"cores of 50 exceeds the 40 cores of the cluster with its machine pools at their max replicas"))
+		})
 	})
 })
+
+// routeCapacity serves a machine pool that scales to 10 'm5.xlarge' nodes, 40 cores and 160 GiB of memory
+func routeCapacity(t *TestingRuntime, cluster *cmv1.Cluster) {
+	machinePool, err := cmv1.NewMachinePool().ID("worker").InstanceType("m5.xlarge").
+		Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(10)).Build()
+	Expect(err).NotTo(HaveOccurred())
+	machineType, err := cmv1.NewMachineType().ID("m5.xlarge").
+		CPU(cmv1.NewValue().Value(4).Unit("vCPU")).
+		Memory(cmv1.NewValue().Value(16 * (1 << 30)).Unit("B")).Build()
+	Expect(err).NotTo(HaveOccurred())
+	t.ApiServer.RouteToHandler(http.MethodGet,
+		fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools", cluster.ID()),
+		RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{machinePool})))
+	t.ApiServer.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/machine_types",
+		RespondWithJSON(http.StatusOK, FormatMachineTypeList([]*cmv1.MachineType{machineType})))
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/clusterautoscaler"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// ProfileFlag selects a named set of autoscaler settings. The AWS '--profile' flag is inherited by the
+// autoscaler commands, so the autoscaler profile has a flag of its own.
+const ProfileFlag = "autoscaler-profile"
+
+// AddProfileFlag adds the flag that selects an autoscaler profile
+func AddProfileFlag(cmd *cobra.Command) {
+	cmd.Flags().String(
+		ProfileFlag,
+		"",
+		fmt.Sprintf("Named set of autoscaler settings, flags set explicitly take precedence over the profile. "+
+			"Options are %s. Only supported for self-hosted (Classic) control plane clusters.",
+			strings.Join(clusterautoscaler.Profiles(), ",")),
+	)
+	cmd.RegisterFlagCompletionFunc(ProfileFlag,
+		func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+			return clusterautoscaler.Profiles(), cobra.ShellCompDirectiveDefault
+		})
+}
+
+// ApplyProfile sets the autoscaler arguments of the profile selected with the profile flag, arguments
+// whose flags were set explicitly are left unchanged
+func ApplyProfile(flags *pflag.FlagSet, prefix string, args *clusterautoscaler.AutoscalerArgs) error {
+	profile, err := flags.GetString(ProfileFlag)
+	if err != nil || profile == "" {
+		return nil
+	}
+	explicit := map[string]bool{}
+	flags.Visit(func(flag *pflag.Flag) {
+		if setting, ok := strings.CutPrefix(flag.Name, prefix); ok {
+			explicit[setting] = true
+		}
+	})
+	err = clusterautoscaler.ApplyProfile(profile, explicit, args)
+	if err != nil {
+		return fmt.Errorf("Failed to apply the autoscaler profile: %v", err)
+	}
+	return nil
+}
+
+// CheckResourceLimits validates the resource limits of the autoscaler of a classic cluster against the
+// capacity of its nodes and reports the limits that stop the machine pools before their max replicas
+func CheckResourceLimits(r *rosa.Runtime, cluster *cmv1.Cluster, limits clusterautoscaler.ResourceLimits) error {
+	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("Failed getting machine pools for cluster '%s': %v", r.ClusterKey, err)
+	}
+	machineTypes, err := r.OCMClient.GetMachineTypes(ocm.FeatureFilters{})
+	if err != nil {
+		return fmt.Errorf("Failed getting instance types: %v", err)
+	}
+	warnings, err := clusterautoscaler.ValidateResourceLimits(limits,
+		clusterautoscaler.ClusterCapacity(cluster, machinePools, machineTypes))
+	for _, warning := range warnings {
+		r.Reporter.Warnf("%s", warning)
+	}
+	return err
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/openshift/rosa/cmd/create/externalauthprovider"
 	"github.com/openshift/rosa/cmd/create/iamserviceaccount"
 	"github.com/openshift/rosa/cmd/create/idp"
+	"github.com/openshift/rosa/cmd/create/idps"
 	"github.com/openshift/rosa/cmd/create/imagemirror"
+	"github.com/openshift/rosa/cmd/create/ingress"
 	"github.com/openshift/rosa/cmd/create/kubeletconfig"
 	"github.com/openshift/rosa/cmd/create/logforwarder"
 	"github.com/openshift/rosa/cmd/create/machinepool"
@@ -60,6 +62,8 @@ func init() {
 	Cmd.AddCommand(cluster.Cmd)
 	Cmd.AddCommand(iamserviceaccount.Cmd)
 	Cmd.AddCommand(idp.Cmd)
+	idpsCommand := idps.NewCreateIdpsCommand()
+	Cmd.AddCommand(idpsCommand)
 	machinepool := machinepool.NewCreateMachinePoolCommand()
 	Cmd.AddCommand(machinepool)
 	Cmd.AddCommand(oidcconfig.Cmd)
@@ -84,6 +88,7 @@ func init() {
 	Cmd.AddCommand(decisionCommand)
 	Cmd.AddCommand(network.NewNetworkCommand())
 	Cmd.AddCommand(spotterminationqueue.NewCreateSpotTerminationQueueCommand())
+	Cmd.AddCommand(ingress.NewCreateIngressCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
@@ -96,7 +101,7 @@ func init() {
 		oidcprovider.Cmd, breakglasscredential.Cmd,
 		admin.Cmd, autoscalerCommand, dnsdomains.Cmd,
 		externalauthprovider.Cmd, iamserviceaccount.Cmd, idp.Cmd, kubeletConfig, tuningconfigs.Cmd,
-		decisionCommand,
+		decisionCommand, idpsCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -19,13 +19,13 @@ package idp
 import (
 	"fmt"
 	"os"
-	"regexp"
 	"strconv"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	pkgidp "github.com/openshift/rosa/pkg/idp"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -85,8 +85,6 @@ var args struct {
 var validIdps = []string{"github", "gitlab", "google", "htpasswd", "ldap", "openid"}
 var validMappingMethods = []string{"add", "claim", "generate", "lookup"}
 
-var idRE = regexp.MustCompile(`(?i)^[0-9a-z]+([-_][0-9a-z]+)*$`)
-
 var Cmd = &cobra.Command{
 	Use:   "idp",
 	Short: "Add an identity provider (IDP) for a cluster",
@@ -452,14 +450,7 @@ func ValidateIdpName(idpName any) error {
 		return fmt.Errorf("invalid type for identity provider name: expected a string, got %T", idpName)
 	}
 
-	if !idRE.MatchString(name) {
-		return fmt.Errorf("invalid identifier '%s' for 'name'", idpName)
-	}
-
-	if strings.EqualFold(name, "cluster-admin") {
-		return fmt.Errorf("the name \"cluster-admin\" is reserved for admin user IDP")
-	}
-	return nil
+	return pkgidp.ValidateName(name)
 }
 
 func doCreateIDP(
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idps
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/idp"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "idps"
+	short = "Add identity providers (IDPs) to a cluster from a file"
+	long  = "Add the identity providers of a YAML document, as exported by 'rosa export idps', to a cluster. " +
+		"Secrets can be set in the document, or read from an environment variable with '${env:NAME}' or " +
+		"from a file with '${file:PATH}'. All identity providers are validated and their secrets resolved " +
+		"before any of them is created."
+	example = `  # Add the identity providers of a file to a cluster named 'mycluster'
+  export GITHUB_1_CLIENT_SECRET=...
+  rosa create idps --cluster=mycluster --from-file=idps.yaml`
+
+	fromFileFlag = "from-file"
+)
+
+type CreateIdpsOptions struct {
+	FromFile string
+}
+
+func NewCreateIdpsCommand() *cobra.Command {
+	options := &CreateIdpsOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateIdpsRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.FromFile,
+		fromFileFlag,
+		"",
+		"Path to a YAML document with the identity providers to add, as exported by 'rosa export idps'.",
+	)
+	cmd.MarkFlagRequired(fromFileFlag)
+	return cmd
+}
+
+func CreateIdpsRunner(options *CreateIdpsOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Adding IDP is not supported for clusters with external authentication configured")
+		}
+
+		document, err := idp.LoadDocument(options.FromFile)
+		if err != nil {
+			return fmt.Errorf("Failed to load identity providers from '%s': %v", options.FromFile, err)
+		}
+
+		existing, err := r.OCMClient.GetIdentityProviders(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
+		}
+		for _, p := range document.IdentityProviders {
+			for _, e := range existing {
+				if e.Name() == p.Name {
+					return fmt.Errorf("Identity provider '%s' already exists on cluster '%s'", p.Name, clusterKey)
+				}
+			}
+		}
+
+		// Resolve every secret before creating anything, a missing secret must not leave the cluster with
+		// only part of the identity providers
+		idps := []*cmv1.IdentityProvider{}
+		for _, p := range document.IdentityProviders {
+			ocmIdp, err := p.Build()
+			if err != nil {
+				return fmt.Errorf("Failed to create identity providers for cluster '%s': %v", clusterKey, err)
+			}
+			idps = append(idps, ocmIdp)
+		}
+
+		r.Reporter.Infof("Configuring %d identity providers for cluster '%s'", len(idps), clusterKey)
+		created := []string{}
+		for _, ocmIdp := range idps {
+			createdIdp, err := r.OCMClient.CreateIdentityProvider(cluster.ID(), ocmIdp)
+			if err != nil {
+				if len(created) > 0 {
+					r.Reporter.Warnf("Identity providers %s were created before the failure",
+						strings.Join(created, ", "))
+				}
+				return fmt.Errorf("Failed to add IDP '%s' to cluster '%s': %v", ocmIdp.Name(), clusterKey, err)
+			}
+			created = append(created, ocmIdp.Name())
+			r.Reporter.Infof("Identity Provider '%s' has been created", ocmIdp.Name())
+			if ocm.HasAuthURLSupport(createdIdp) {
+				callbackURL, err := ocm.GetOAuthURL(cluster, createdIdp)
+				if err == nil {
+					r.Reporter.Infof("Callback URI: %s", callbackURL)
+				}
+			}
+		}
+		r.Reporter.Infof("It may take several minutes for this access to become active.\n" +
+			"   To add cluster administrators, see 'rosa grant user --help'.")
+		return nil
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idps
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const document = `identity_providers:
+- name: google-1
+  type: google
+  google:
+    client_id: client
+    client_secret: ${env:GOOGLE_1_CLIENT_SECRET}
+- name: corp-sso
+  type: openid
+  open_id:
+    client_id: sso-client
+    client_secret: ${file:%s}
+    issuer: https://sso.example.com
+    claims:
+      groups: [groups]
+`
+
+var _ = Describe("create idps", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewCreateIdpsCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(fromFileFlag)).NotTo(BeNil())
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+		var options *CreateIdpsOptions
+		var received []*cmv1.IdentityProvider
+
+		add := func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			idp, err := cmv1.UnmarshalIdentityProvider(body)
+			Expect(err).NotTo(HaveOccurred())
+			received = append(received, idp)
+			var buf bytes.Buffer
+			Expect(cmv1.MarshalIdentityProvider(idp, &buf)).To(Succeed())
+			RespondWithJSON(http.StatusCreated, buf.String())(w, req)
+		}
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			received = nil
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			}))
+
+			dir := GinkgoT().TempDir()
+			secretPath := filepath.Join(dir, "sso-secret")
+			Expect(os.WriteFile(secretPath, []byte("sso-secret\n"), 0600)).To(Succeed())
+			options = &CreateIdpsOptions{FromFile: filepath.Join(dir, "idps.yaml")}
+			Expect(os.WriteFile(options.FromFile, []byte(fmt.Sprintf(document, secretPath)), 0600)).To(Succeed())
+		})
+
+		It("Creates the identity providers of the file with their resolved secrets", func() {
+			GinkgoT().Setenv("GOOGLE_1_CLIENT_SECRET", "google-secret")
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})),
+				add,
+				add,
+			)
+
+			err := CreateIdpsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(received).To(HaveLen(2))
+			Expect(received[0].Name()).To(Equal("google-1"))
+			Expect(received[0].Google().ClientSecret()).To(Equal("google-secret"))
+			Expect(received[1].Name()).To(Equal("corp-sso"))
+			Expect(received[1].OpenID().ClientSecret()).To(Equal("sso-secret"))
+			Expect(received[1].OpenID().Claims().Groups()).To(Equal([]string{"groups"}))
+		})
+
+		It("Creates nothing when a secret can't be resolved", func() {
+			os.Unsetenv("GOOGLE_1_CLIENT_SECRET")
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})),
+			)
+
+			err := CreateIdpsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Failed to create identity providers for cluster 'cluster': failed to " +
+				"resolve the client secret of identity provider 'google-1': environment variable " +
+				"'GOOGLE_1_CLIENT_SECRET' isn't set"))
+			Expect(received).To(BeEmpty())
+		})
+
+		It("Fails when an identity provider already exists", func() {
+			existing, err := cmv1.NewIdentityProvider().ID("i1").Name("corp-sso").
+				Type(cmv1.IdentityProviderTypeOpenID).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{existing})),
+			)
+
+			err = CreateIdpsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Identity provider 'corp-sso' already exists on cluster 'cluster'"))
+			Expect(received).To(BeEmpty())
+		})
+
+		It("Fails on an invalid file", func() {
+			Expect(os.WriteFile(options.FromFile, []byte("identity_providers: []"), 0600)).To(Succeed())
+			err := CreateIdpsRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("expected at least one identity provider")))
+		})
+	})
+})
@@ -24,14 +24,20 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 const (
-	use     = "image-mirror"
-	short   = "Create image mirror for a cluster"
-	long    = "Create an image mirror configuration for a Hosted Control Plane cluster. The image mirror ID will be auto-generated."
+	use   = "image-mirror"
+	short = "Create image mirror for a cluster"
+	long  = "Create an image mirror configuration for a Hosted Control Plane cluster. The image mirror ID will be auto-generated.\n\n" +
+		"With '--from-file' the image mirrors of the cluster are made to match the ImageDigestMirrorSet " +
+		"and ImageContentSourcePolicy manifests of a file, such as the ones generated by oc-mirror: missing " +
+		"image mirrors are created, image mirrors with other mirrors are updated and image mirrors that aren't " +
+		"in the file are deleted. The whole file is validated before any change is made, and files with " +
+		"ImageTagMirrorSet manifests are rejected since digest is the only supported type."
 	example = `  # Create an image mirror for cluster "mycluster"
   rosa create image-mirror --cluster=mycluster \
     --source=registry.example.com/team \
@@ -40,9 +46,14 @@ const (
   # Create with a specific type (digest is default and only supported type)
   rosa create image-mirror --cluster=mycluster \
     --type=digest --source=docker.io/library \
-    --mirrors=internal-registry.company.com/dockerhub`
+    --mirrors=internal-registry.company.com/dockerhub
+
+  # Create the image mirrors of the manifests generated by oc-mirror
+  rosa create image-mirrors --cluster=mycluster --from-file=idms-oc-mirror.yaml`
 )
 
+const fromFileFlag = "from-file"
+
 var (
 	aliases = []string{"image-mirrors"}
 )
@@ -82,12 +93,23 @@ func NewCreateImageMirrorCommand() *cobra.Command {
 		"List of mirror registries (comma-separated, required)",
 	)
 
-	_ = cmd.MarkFlagRequired("source")
-	_ = cmd.MarkFlagRequired("mirrors")
+	flags.StringVar(
+		&options.Args().FromFile,
+		fromFileFlag,
+		"",
+		"Path to a file with ImageDigestMirrorSet or ImageContentSourcePolicy manifests that the image "+
+			"mirrors of the cluster are made to match.",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(fromFileFlag, "source")
+	cmd.MarkFlagsMutuallyExclusive(fromFileFlag, "mirrors")
+	cmd.MarkFlagsMutuallyExclusive(fromFileFlag, "type")
+	cmd.MarkFlagsOneRequired(fromFileFlag, "source")
 
 	ocm.AddClusterFlag(cmd)
 	arguments.AddProfileFlag(cmd.Flags())
 	arguments.AddRegionFlag(cmd.Flags())
+	confirm.AddFlag(cmd.Flags())
 	return cmd
 }
 
@@ -108,6 +130,14 @@ func CreateImageMirrorRunner(options *CreateImageMirrorOptions) rosa.CommandRunn
 			return fmt.Errorf("Image mirrors are only supported on Hosted Control Plane clusters")
 		}
 
+		if args.FromFile != "" {
+			return createImageMirrorsFromFile(runtime, clusterKey, cluster, args)
+		}
+
+		if args.Source == "" {
+			return fmt.Errorf("Source registry is required. Specify it with the --source flag")
+		}
+
 		if len(args.Mirrors) == 0 {
 			return fmt.Errorf("At least one mirror registry must be specified")
 		}
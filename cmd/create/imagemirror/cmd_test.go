@@ -2,10 +2,14 @@ package imagemirror
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
 
@@ -183,6 +187,121 @@ var _ = Describe("Create image mirror", func() {
 			})
 		})
 
+		Context("From file", func() {
+			manifests := `apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+metadata:
+  name: idms-release-0
+spec:
+  imageDigestMirrors:
+  - source: registry.redhat.io
+    mirrors:
+    - mirror.example.com
+    - backup.example.com
+  - source: quay.io/openshift
+    mirrors:
+    - mirror.example.com/openshift
+`
+
+			It("Creates, updates and deletes the image mirrors to match the manifests", func() {
+				existing, err := cmv1.NewImageMirror().ID("quay").Type("digest").Source("quay.io/openshift").
+					Mirrors("old.example.com/openshift").Build()
+				Expect(err).ToNot(HaveOccurred())
+				stale, err := cmv1.NewImageMirror().ID("stale").Type("digest").Source("docker.io/library").
+					Mirrors("mirror.example.com/library").Build()
+				Expect(err).ToNot(HaveOccurred())
+				path := filepath.Join(GinkgoT().TempDir(), "idms.yaml")
+				Expect(os.WriteFile(path, []byte(manifests), 0600)).To(Succeed())
+
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hcpClusterReady))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+					fmt.Sprintf(`{"items": [%s, %s], "page": 1, "size": 2, "total": 2}`,
+						test.FormatResource(existing), test.FormatResource(stale))))
+				t.ApiServer.AppendHandlers(CombineHandlers(
+					VerifyRequest(http.MethodPost,
+						fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/image_mirrors", mockHCPClusterReady.ID())),
+					VerifyJSON(`{"kind": "ImageMirror", "type": "digest", "source": "registry.redhat.io", `+
+						`"mirrors": ["mirror.example.com", "backup.example.com"]}`),
+					RespondWithJSON(http.StatusCreated, formatCreatedImageMirror()),
+				))
+				t.ApiServer.AppendHandlers(CombineHandlers(
+					VerifyRequest(http.MethodPatch,
+						fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/image_mirrors/quay", mockHCPClusterReady.ID())),
+					VerifyJSON(`{"kind": "ImageMirror", "mirrors": ["mirror.example.com/openshift"]}`),
+					RespondWithJSON(http.StatusOK, test.FormatResource(existing)),
+				))
+				t.ApiServer.AppendHandlers(CombineHandlers(
+					VerifyRequest(http.MethodDelete,
+						fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/image_mirrors/stale", mockHCPClusterReady.ID())),
+					RespondWithJSON(http.StatusNoContent, ""),
+				))
+
+				options := NewCreateImageMirrorOptions()
+				options.Args().FromFile = path
+				runner := CreateImageMirrorRunner(options)
+				err = t.StdOutReader.Record()
+				Expect(err).ToNot(HaveOccurred())
+				cmd := NewCreateImageMirrorCommand()
+				Expect(cmd.Flag("yes").Value.Set("true")).To(Succeed())
+				DeferCleanup(func() {
+					Expect(cmd.Flag("yes").Value.Set("false")).To(Succeed())
+				})
+				err = cmd.Flag("cluster").Value.Set(clusterId)
+				Expect(err).ToNot(HaveOccurred())
+				err = runner(context.Background(), t.RosaRuntime, cmd, []string{})
+				Expect(err).ToNot(HaveOccurred())
+				stdout, err := t.StdOutReader.Read()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stdout).To(ContainSubstring(
+					"Image mirror with ID 'test-mirror-123' has been created for source 'registry.redhat.io'"))
+				Expect(stdout).To(ContainSubstring("Image mirror 'quay' of source 'quay.io/openshift' has been " +
+					"updated, mirrors: [old.example.com/openshift] -> [mirror.example.com/openshift]"))
+				Expect(stdout).To(ContainSubstring("Image mirror 'stale' of source 'docker.io/library' has been deleted"))
+				Expect(stdout).To(ContainSubstring("1 created, 1 updated, 1 deleted, 0 unchanged"))
+			})
+
+			It("Rejects tag mirrors before making any change", func() {
+				path := filepath.Join(GinkgoT().TempDir(), "itms.yaml")
+				Expect(os.WriteFile(path, []byte(manifests+`---
+apiVersion: config.openshift.io/v1
+kind: ImageTagMirrorSet
+metadata:
+  name: itms-generic-0
+spec:
+  imageTagMirrors:
+  - source: docker.io/library
+    mirrors:
+    - mirror.example.com/library
+`), 0600)).To(Succeed())
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hcpClusterReady))
+				options := NewCreateImageMirrorOptions()
+				options.Args().FromFile = path
+				runner := CreateImageMirrorRunner(options)
+				cmd := NewCreateImageMirrorCommand()
+				err := cmd.Flag("cluster").Value.Set(clusterId)
+				Expect(err).ToNot(HaveOccurred())
+				err = runner(context.Background(), t.RosaRuntime, cmd, []string{})
+				Expect(err).To(MatchError(fmt.Sprintf("Invalid image mirrors in '%s': only digest image mirrors "+
+					"are supported, the tag mirrors of sources 'docker.io/library' can't be created", path)))
+				Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(1))
+			})
+
+			It("Returns error for an invalid file", func() {
+				path := filepath.Join(GinkgoT().TempDir(), "idms.yaml")
+				Expect(os.WriteFile(path, []byte("kind: ConfigMap\n"), 0600)).To(Succeed())
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hcpClusterReady))
+				options := NewCreateImageMirrorOptions()
+				options.Args().FromFile = path
+				runner := CreateImageMirrorRunner(options)
+				cmd := NewCreateImageMirrorCommand()
+				err := cmd.Flag("cluster").Value.Set(clusterId)
+				Expect(err).ToNot(HaveOccurred())
+				err = runner(context.Background(), t.RosaRuntime, cmd, []string{})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Failed to read image mirrors from"))
+			})
+		})
+
 		Context("Command structure", func() {
 			It("Has correct command properties", func() {
 				cmd := NewCreateImageMirrorCommand()
@@ -194,7 +313,7 @@ var _ = Describe("Create image mirror", func() {
 
 			It("Has expected flags", func() {
 				cmd := NewCreateImageMirrorCommand()
-				flags := []string{"cluster", "type", "source", "mirrors", "profile", "region"}
+				flags := []string{"cluster", "type", "source", "mirrors", "from-file", "yes", "profile", "region"}
 				for _, flagName := range flags {
 					flag := cmd.Flag(flagName)
 					Expect(flag).ToNot(BeNil(), "Flag %s should exist", flagName)
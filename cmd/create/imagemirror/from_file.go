@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/imagemirror"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// createImageMirrorsFromFile makes the image mirrors of a cluster match the manifests of a file
+func createImageMirrorsFromFile(runtime *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	args *CreateImageMirrorUserOptions) error {
+	mirrors, err := imagemirror.LoadManifests(args.FromFile)
+	if err != nil {
+		return fmt.Errorf("Failed to read image mirrors from '%s': %v", args.FromFile, err)
+	}
+	err = imagemirror.Validate(mirrors)
+	if err != nil {
+		return fmt.Errorf("Invalid image mirrors in '%s': %v", args.FromFile, err)
+	}
+
+	existing, err := runtime.OCMClient.ListImageMirrors(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("Failed to list image mirrors: %v", err)
+	}
+
+	plan := imagemirror.Diff(existing, mirrors)
+	if plan.Empty() {
+		runtime.Reporter.Infof("The image mirrors of cluster '%s' already match '%s'", clusterKey, args.FromFile)
+		return nil
+	}
+
+	if len(plan.Delete) > 0 {
+		sources := []string{}
+		for _, imageMirror := range plan.Delete {
+			sources = append(sources, imageMirror.Source())
+		}
+		if !confirm.Confirm("delete the image mirrors of sources '%s' that are not in '%s' from cluster '%s'",
+			strings.Join(sources, "', '"), args.FromFile, clusterKey) {
+			return nil
+		}
+	}
+
+	for _, mirror := range plan.Create {
+		createdMirror, err := runtime.OCMClient.CreateImageMirror(
+			cluster.ID(), mirror.Type, mirror.Source, mirror.Mirrors)
+		if err != nil {
+			return fmt.Errorf("Failed to create image mirror for source '%s': %v", mirror.Source, err)
+		}
+		runtime.Reporter.Infof("Image mirror with ID '%s' has been created for source '%s'",
+			createdMirror.ID(), mirror.Source)
+	}
+	for _, update := range plan.Update {
+		_, err := runtime.OCMClient.UpdateImageMirror(cluster.ID(), update.ID, update.Mirror.Mirrors, nil)
+		if err != nil {
+			return fmt.Errorf("Failed to update image mirror '%s': %v", update.ID, err)
+		}
+		runtime.Reporter.Infof("Image mirror '%s' of source '%s' has been updated, mirrors: %v -> %v",
+			update.ID, update.Mirror.Source, update.Current, update.Mirror.Mirrors)
+	}
+	for _, imageMirror := range plan.Delete {
+		err := runtime.OCMClient.DeleteImageMirror(cluster.ID(), imageMirror.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to delete image mirror '%s': %v", imageMirror.ID(), err)
+		}
+		runtime.Reporter.Infof("Image mirror '%s' of source '%s' has been deleted", imageMirror.ID(),
+			imageMirror.Source())
+	}
+
+	runtime.Reporter.Infof("Image mirrors of cluster '%s' match '%s': %d created, %d updated, %d deleted, "+
+		"%d unchanged", clusterKey, args.FromFile, len(plan.Create), len(plan.Update), len(plan.Delete),
+		plan.Unchanged)
+	return nil
+}
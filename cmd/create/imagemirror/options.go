@@ -21,9 +21,10 @@ import (
 )
 
 type CreateImageMirrorUserOptions struct {
-	Type    string
-	Source  string
-	Mirrors []string
+	Type     string
+	Source   string
+	Mirrors  []string
+	FromFile string
 }
 
 type CreateImageMirrorOptions struct {
@@ -0,0 +1,256 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/helper"
+	pkgingress "github.com/openshift/rosa/pkg/ingress"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "ingress"
+	short = "Add an ingress to a classic cluster"
+	long  = "Add an additional ingress (application router) to a classic cluster, for example a private " +
+		"router that only exposes the routes matching its route selector."
+	example = `  # Add a private ingress exposing the routes labeled 'route=internal' on a cluster named 'mycluster'
+  rosa create ingress --private --label-match=route=internal --cluster=mycluster
+
+  # Add a public ingress with a network load balancer that doesn't expose the 'stage' namespace
+  rosa create ingress --lb-type=nlb --route-selector=route=external --excluded-namespaces=stage \
+    --cluster=mycluster`
+
+	privateFlag                  = "private"
+	labelMatchFlag               = "label-match"
+	routeSelectorFlag            = "route-selector"
+	lbTypeFlag                   = "lb-type"
+	excludedNamespacesFlag       = "excluded-namespaces"
+	wildcardPolicyFlag           = "wildcard-policy"
+	namespaceOwnershipPolicyFlag = "namespace-ownership-policy"
+
+	ingressV2DocLink = "https://access.redhat.com/articles/7028653"
+)
+
+var aliases = []string{"route", "ingresses"}
+
+// Attributes that can only be set on clusters without legacy ingress support
+var ingressV2Flags = []string{excludedNamespacesFlag, wildcardPolicyFlag, namespaceOwnershipPolicyFlag}
+
+type CreateIngressOptions struct {
+	Private                  bool
+	RouteSelector            string
+	LbType                   string
+	ExcludedNamespaces       string
+	WildcardPolicy           string
+	NamespaceOwnershipPolicy string
+}
+
+func NewCreateIngressCommand() *cobra.Command {
+	options := &CreateIngressOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateIngressRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.BoolVar(
+		&options.Private,
+		privateFlag,
+		false,
+		"Restrict application route to direct, private connectivity.",
+	)
+	flags.StringVar(
+		&options.RouteSelector,
+		labelMatchFlag,
+		"",
+		fmt.Sprintf("Alias to '%s' flag.", routeSelectorFlag),
+	)
+	flags.StringVar(
+		&options.RouteSelector,
+		routeSelectorFlag,
+		"",
+		"Route Selector for ingress. Format should be a comma-separated list of 'key=value'. "+
+			"If no label is specified, all routes will be exposed on both routers.",
+	)
+	flags.StringVar(
+		&options.LbType,
+		lbTypeFlag,
+		"",
+		fmt.Sprintf("Type of Load Balancer. Options are %s.", strings.Join(pkgingress.ValidLbTypes, ",")),
+	)
+	flags.StringVar(
+		&options.ExcludedNamespaces,
+		excludedNamespacesFlag,
+		"",
+		"Excluded namespaces for ingress. Format should be a comma-separated list 'value1, value2...'. "+
+			"If no values are specified, all namespaces will be exposed.",
+	)
+	flags.StringVar(
+		&options.WildcardPolicy,
+		wildcardPolicyFlag,
+		"",
+		fmt.Sprintf("Wildcard Policy for ingress. Options are %s. Default is '%s'.",
+			strings.Join(pkgingress.ValidWildcardPolicies, ","), pkgingress.DefaultWildcardPolicy),
+	)
+	flags.StringVar(
+		&options.NamespaceOwnershipPolicy,
+		namespaceOwnershipPolicyFlag,
+		"",
+		fmt.Sprintf("Namespace Ownership Policy for ingress. Options are %s. Default is '%s'.",
+			strings.Join(pkgingress.ValidNamespaceOwnershipPolicies, ","), pkgingress.DefaultNamespaceOwnershipPolicy),
+	)
+	output.AddFlag(cmd)
+
+	cmd.RegisterFlagCompletionFunc(lbTypeFlag, completion(pkgingress.ValidLbTypes))
+	cmd.RegisterFlagCompletionFunc(wildcardPolicyFlag, completion(pkgingress.ValidWildcardPolicies))
+	cmd.RegisterFlagCompletionFunc(namespaceOwnershipPolicyFlag,
+		completion(pkgingress.ValidNamespaceOwnershipPolicies))
+	return cmd
+}
+
+func completion(options []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return options, cobra.ShellCompDirectiveDefault
+	}
+}
+
+func CreateIngressRunner(options *CreateIngressOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if err := options.validate(); err != nil {
+			return err
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		if ocm.IsHyperShiftCluster(cluster) {
+			return fmt.Errorf("Adding ingresses is not supported for Hosted Control Plane clusters")
+		}
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+
+		hasLegacyIngressSupport, err := r.OCMClient.HasLegacyIngressSupport(cluster)
+		if err != nil {
+			return fmt.Errorf("There was a problem checking version compatibility: %v", err)
+		}
+		if hasLegacyIngressSupport {
+			if options.usesIngressV2() {
+				return fmt.Errorf("New ingress attributes %s can't be supplied for legacy supported clusters."+
+					" For more information on how to be supported please check: %s",
+					helper.SliceToSortedString(ingressV2Flags), ingressV2DocLink)
+			}
+			if cluster.AWS().PrivateLink() {
+				return fmt.Errorf("Classic cluster '%s' is PrivateLink on legacy ingress support and does not "+
+					"allow adding ingresses", clusterKey)
+			}
+		}
+
+		ingress, err := options.build()
+		if err != nil {
+			return fmt.Errorf("Failed to create ingress for cluster '%s': %v", clusterKey, err)
+		}
+
+		r.Reporter.Debugf("Adding ingress to cluster '%s'", clusterKey)
+		ingress, err = r.OCMClient.CreateIngress(cluster.ID(), ingress)
+		if err != nil {
+			return fmt.Errorf("Failed to add ingress to cluster '%s': %v", clusterKey, err)
+		}
+		if output.HasFlag() {
+			return output.Print(ingress)
+		}
+		r.Reporter.Infof("Ingress '%s' has been added to cluster '%s'. "+
+			"To view its status, run 'rosa describe ingress %s -c %s'", ingress.ID(), clusterKey, ingress.ID(),
+			clusterKey)
+		return nil
+	}
+}
+
+// validate checks the options locally before the cluster is loaded
+func (o *CreateIngressOptions) validate() error {
+	if err := pkgingress.ValidateRouteSelector(o.RouteSelector); err != nil {
+		return err
+	}
+	if err := pkgingress.ValidateExcludedNamespaces(o.ExcludedNamespaces); err != nil {
+		return err
+	}
+	if o.LbType != "" {
+		if err := pkgingress.ValidateLbType(o.LbType); err != nil {
+			return err
+		}
+	}
+	if o.WildcardPolicy != "" {
+		if err := pkgingress.ValidateWildcardPolicy(o.WildcardPolicy); err != nil {
+			return err
+		}
+	}
+	if o.NamespaceOwnershipPolicy != "" {
+		if err := pkgingress.ValidateNamespaceOwnershipPolicy(o.NamespaceOwnershipPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *CreateIngressOptions) usesIngressV2() bool {
+	return o.ExcludedNamespaces != "" || o.WildcardPolicy != "" || o.NamespaceOwnershipPolicy != ""
+}
+
+func (o *CreateIngressOptions) build() (*cmv1.Ingress, error) {
+	ingressBuilder := cmv1.NewIngress()
+	if o.Private {
+		ingressBuilder = ingressBuilder.Listening(cmv1.ListeningMethodInternal)
+	} else {
+		ingressBuilder = ingressBuilder.Listening(cmv1.ListeningMethodExternal)
+	}
+	if o.RouteSelector != "" {
+		routeSelectors, err := pkgingress.GetRouteSelector(o.RouteSelector)
+		if err != nil {
+			return nil, err
+		}
+		ingressBuilder = ingressBuilder.RouteSelectors(routeSelectors)
+	}
+	if o.LbType != "" {
+		ingressBuilder = ingressBuilder.LoadBalancerType(cmv1.LoadBalancerFlavor(o.LbType))
+	}
+	if o.ExcludedNamespaces != "" {
+		ingressBuilder = ingressBuilder.ExcludedNamespaces(
+			pkgingress.GetExcludedNamespaces(o.ExcludedNamespaces)...)
+	}
+	if o.WildcardPolicy != "" {
+		ingressBuilder = ingressBuilder.RouteWildcardPolicy(cmv1.WildcardPolicy(o.WildcardPolicy))
+	}
+	if o.NamespaceOwnershipPolicy != "" {
+		ingressBuilder = ingressBuilder.RouteNamespaceOwnershipPolicy(
+			cmv1.NamespaceOwnershipPolicy(o.NamespaceOwnershipPolicy))
+	}
+	return ingressBuilder.Build()
+}
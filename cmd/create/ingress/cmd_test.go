@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	legacyIngressLabels = `{
+  "kind": "LabelList",
+  "page": 1,
+  "size": 1,
+  "total": 1,
+  "items": [
+    {
+      "kind": "Label",
+      "key": "ext-managed.openshift.io/legacy-ingress-support",
+      "value": "%s"
+    }
+  ]
+}`
+)
+
+var _ = Describe("create ingress", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewCreateIngressCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Aliases).To(Equal(aliases))
+		for _, flag := range []string{"cluster", privateFlag, labelMatchFlag, routeSelectorFlag, lbTypeFlag,
+			excludedNamespacesFlag, wildcardPolicyFlag, namespaceOwnershipPolicyFlag, "output"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil(), flag)
+		}
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+		var options *CreateIngressOptions
+		var received *cmv1.Ingress
+
+		labels := func(legacy string) http.HandlerFunc {
+			return RespondWithJSON(http.StatusOK, fmt.Sprintf(legacyIngressLabels, legacy))
+		}
+		add := func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := io.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			received, err = cmv1.UnmarshalIngress(body)
+			Expect(err).NotTo(HaveOccurred())
+			created, err := cmv1.NewIngress().Copy(received).ID("a1b2").Build()
+			Expect(err).NotTo(HaveOccurred())
+			var buf bytes.Buffer
+			Expect(cmv1.MarshalIngress(created, &buf)).To(Succeed())
+			RespondWithJSON(http.StatusCreated, buf.String())(w, req)
+		}
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			options = &CreateIngressOptions{}
+			received = nil
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			}))
+		})
+
+		It("Adds a private ingress with route selectors and ingress v2 attributes", func() {
+			t.ApiServer.AppendHandlers(labels("false"), add)
+			options.Private = true
+			options.RouteSelector = "route=internal, tier=front"
+			options.LbType = "nlb"
+			options.ExcludedNamespaces = "stage, dev"
+			options.WildcardPolicy = string(cmv1.WildcardPolicyWildcardsAllowed)
+
+			t.StdOutReader.Record()
+			err := CreateIngressRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: Ingress 'a1b2' has been added to cluster 'cluster'. " +
+				"To view its status, run 'rosa describe ingress a1b2 -c cluster'\n"))
+
+			Expect(received.Listening()).To(Equal(cmv1.ListeningMethodInternal))
+			Expect(received.RouteSelectors()).To(Equal(map[string]string{"route": "internal", "tier": "front"}))
+			Expect(received.LoadBalancerType()).To(Equal(cmv1.LoadBalancerFlavorNlb))
+			Expect(received.ExcludedNamespaces()).To(Equal([]string{"stage", "dev"}))
+			Expect(received.RouteWildcardPolicy()).To(Equal(cmv1.WildcardPolicyWildcardsAllowed))
+			_, ok := received.GetRouteNamespaceOwnershipPolicy()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Adds a public ingress on a legacy ingress cluster", func() {
+			t.ApiServer.AppendHandlers(labels("true"), add)
+			options.RouteSelector = "route=external"
+
+			err := CreateIngressRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(received.Listening()).To(Equal(cmv1.ListeningMethodExternal))
+			_, ok := received.GetLoadBalancerType()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Rejects ingress v2 attributes on legacy ingress clusters", func() {
+			t.ApiServer.AppendHandlers(labels("true"))
+			options.WildcardPolicy = string(cmv1.WildcardPolicyWildcardsAllowed)
+
+			err := CreateIngressRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("can't be supplied for legacy supported clusters")))
+		})
+
+		It("Rejects Hosted Control Plane clusters", func() {
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			}))
+
+			err := CreateIngressRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Adding ingresses is not supported for Hosted Control Plane clusters"))
+		})
+
+		DescribeTable("Validates the options before loading the cluster",
+			func(modify func(*CreateIngressOptions), message string) {
+				modify(options)
+				err := CreateIngressRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("route selector", func(o *CreateIngressOptions) { o.RouteSelector = "route:internal" },
+				"Expected key=value format for route selector 'route:internal'"),
+			Entry("route selector key", func(o *CreateIngressOptions) { o.RouteSelector = "-route=internal" },
+				"Invalid route selector key '-route'"),
+			Entry("excluded namespaces", func(o *CreateIngressOptions) { o.ExcludedNamespaces = "Stage" },
+				"Invalid excluded namespace 'Stage'"),
+			Entry("LB type", func(o *CreateIngressOptions) { o.LbType = "alb" },
+				"Invalid Load Balancer type 'alb'. Options are classic,nlb"),
+			Entry("wildcard policy", func(o *CreateIngressOptions) { o.WildcardPolicy = "Allowed" },
+				"Invalid Wildcard Policy 'Allowed'"),
+			Entry("namespace ownership policy", func(o *CreateIngressOptions) { o.NamespaceOwnershipPolicy = "Open" },
+				"Invalid Namespace Ownership Policy 'Open'"),
+		)
+	})
+})
@@ -35,6 +35,8 @@ const (
 	long    = short
 	example = `  # Create a custom kubeletconfig with a pod-pids-limit of 5000
   rosa create kubeletconfig --cluster=mycluster --pod-pids-limit=5000
+  # Create a kubeletconfig named 'bar' with the settings of the 'high-density' preset of a presets file
+  rosa create kubeletconfig --cluster=mycluster --name=bar --preset=high-density --presets-file=presets.yaml
   `
 )
 
@@ -61,6 +63,11 @@ func CreateKubeletConfigRunner(options *KubeletConfigOptions) rosa.CommandRunner
 	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
 
 		options.BindFromArgs(args)
+		err := options.ApplyPreset()
+		if err != nil {
+			return err
+		}
+
 		clusterKey := r.GetClusterKey()
 		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
 		if err != nil {
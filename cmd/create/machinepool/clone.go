@@ -0,0 +1,432 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	interactiveSgs "github.com/openshift/rosa/pkg/interactive/securitygroups"
+	"github.com/openshift/rosa/pkg/machinepool"
+	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/poolmigration"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// scalingFlags size the machine pool together, the source machine pool is either autoscaled or has a
+// fixed number of replicas
+var scalingFlags = []string{"enable-autoscaling", "min-replicas", "max-replicas", "replicas"}
+
+// cloneSource holds the settings of the machine pool referenced by '--from', whether it is a classic
+// machine pool or a hosted control plane node pool
+type cloneSource struct {
+	instanceType      string
+	labels            map[string]string
+	taints            []*cmv1.Taint
+	autoscaling       bool
+	replicas          int
+	minReplicas       int
+	maxReplicas       int
+	diskSize          int
+	spot              bool
+	spotMaxPrice      string
+	tags              map[string]string
+	securityGroups    []string
+	subnets           []string
+	availabilityZones []string
+
+	// nodePool holds the settings that only exist for node pools, it is nil for classic machine pools
+	nodePool *cmv1.NodePool
+}
+
+func newMachinePoolCloneSource(machinePool *cmv1.MachinePool) *cloneSource {
+	source := &cloneSource{
+		instanceType:      machinePool.InstanceType(),
+		labels:            machinePool.Labels(),
+		taints:            machinePool.Taints(),
+		replicas:          machinePool.Replicas(),
+		tags:              machinePool.AWS().Tags(),
+		securityGroups:    machinePool.AWS().AdditionalSecurityGroupIds(),
+		subnets:           machinePool.Subnets(),
+		availabilityZones: machinePool.AvailabilityZones(),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		source.autoscaling = true
+		source.minReplicas = autoscaling.MinReplicas()
+		source.maxReplicas = autoscaling.MaxReplicas()
+	}
+	if size, ok := machinePool.RootVolume().AWS().GetSize(); ok {
+		source.diskSize = size
+	}
+	if spot, ok := machinePool.AWS().GetSpotMarketOptions(); ok {
+		source.spot = true
+		if maxPrice, ok := spot.GetMaxPrice(); ok {
+			source.spotMaxPrice = strconv.FormatFloat(maxPrice, 'f', -1, 64)
+		}
+	}
+	return source
+}
+
+func newNodePoolCloneSource(nodePool *cmv1.NodePool) *cloneSource {
+	aws := nodePool.AWSNodePool()
+	source := &cloneSource{
+		instanceType:   aws.InstanceType(),
+		labels:         nodePool.Labels(),
+		taints:         nodePool.Taints(),
+		replicas:       nodePool.Replicas(),
+		tags:           aws.Tags(),
+		securityGroups: aws.AdditionalSecurityGroupIds(),
+		nodePool:       nodePool,
+	}
+	if subnet := nodePool.Subnet(); subnet != "" {
+		source.subnets = []string{subnet}
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		source.autoscaling = true
+		source.minReplicas = autoscaling.MinReplica()
+		source.maxReplicas = autoscaling.MaxReplica()
+	}
+	if size, ok := aws.RootVolume().GetSize(); ok {
+		source.diskSize = size
+	}
+	if spot, ok := aws.GetSpotMarketOptions(); ok {
+		source.spot = true
+		source.spotMaxPrice = spot.MaxPrice()
+	}
+	return source
+}
+
+// createClonedMachinePool creates a copy of the machine pool referenced by '--from'. The machine pool is
+// built from the flags as usual, so that the flags given by the user are validated, with the instance type
+// and the scaling of the source machine pool as defaults. The other settings of the source machine pool
+// are then copied to it unless the user gave the flags that set them. Subnets, zones, security groups and
+// configs belong to the cluster and are only copied within the same cluster.
+func createClonedMachinePool(r *rosa.Runtime, cmd *cobra.Command, service machinepool.MachinePoolService,
+	clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
+	args *mpOpts.CreateMachinepoolUserOptions) error {
+	for _, flag := range []string{spreadFlag, spotPercentageFlag, spotInstanceTypesFlag} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("'--%s' can't be used with '--from'", flag)
+		}
+	}
+	source, sameCluster, err := getCloneSource(r, clusterKey, cluster, args.From)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Flags().Changed("instance-type") {
+		args.InstanceType = source.instanceType
+	}
+	scalingSet := false
+	for _, flag := range scalingFlags {
+		scalingSet = scalingSet || cmd.Flags().Changed(flag)
+	}
+	if !scalingSet {
+		args.AutoscalingEnabled = source.autoscaling
+		args.Replicas = source.replicas
+		args.MinReplicas = source.minReplicas
+		args.MaxReplicas = source.maxReplicas
+	}
+
+	var created interface{}
+	var name string
+	var skipped []string
+	if cluster.Hypershift().Enabled() {
+		built, err := service.BuildNodePool(r, cmd, clusterKey, cluster, clusterAutoscaler, args)
+		if err != nil {
+			return err
+		}
+		var nodePool *cmv1.NodePool
+		nodePool, skipped, err = source.cloneNodePool(cmd, built, sameCluster)
+		if err != nil {
+			return fmt.Errorf("Failed to copy machine pool '%s': %v", args.From, err)
+		}
+		for _, setting := range skipped {
+			r.Reporter.Warnf("The %s of machine pool '%s' can't be carried over to cluster '%s'",
+				setting, args.From, clusterKey)
+		}
+		name = nodePool.ID()
+		created, err = r.OCMClient.CreateNodePool(cluster.ID(), nodePool)
+		if err != nil {
+			return fmt.Errorf("Failed to add machine pool to hosted cluster '%s': %v", clusterKey, err)
+		}
+	} else {
+		built, err := service.BuildMachinePool(r, cmd, clusterKey, cluster, args)
+		if err != nil {
+			return err
+		}
+		var machinePool *cmv1.MachinePool
+		machinePool, skipped, err = source.cloneMachinePool(cmd, built, sameCluster)
+		if err != nil {
+			return fmt.Errorf("Failed to copy machine pool '%s': %v", args.From, err)
+		}
+		for _, setting := range skipped {
+			r.Reporter.Warnf("The %s of machine pool '%s' can't be carried over to cluster '%s'",
+				setting, args.From, clusterKey)
+		}
+		name = machinePool.ID()
+		created, err = r.OCMClient.CreateMachinePool(cluster.ID(), machinePool)
+		if err != nil {
+			return fmt.Errorf("Failed to add machine pool to cluster '%s': %v", clusterKey, err)
+		}
+	}
+
+	if output.HasFlag() {
+		return output.Print(created)
+	}
+	r.Reporter.Infof("Machine pool '%s' was copied from machine pool '%s', run "+
+		"'rosa describe machinepool --cluster %s --machinepool %s' to see it", name, args.From, clusterKey, name)
+	return nil
+}
+
+// getCloneSource returns the settings of the machine pool referenced by '--from' and whether it belongs
+// to the target cluster
+func getCloneSource(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	from string) (*cloneSource, bool, error) {
+	reference, err := machinepool.ParseCloneSource(from, clusterKey)
+	if err != nil {
+		return nil, false, err
+	}
+	sourceCluster := cluster
+	sameCluster := reference.ClusterKey == clusterKey || reference.ClusterKey == cluster.ID() ||
+		reference.ClusterKey == cluster.Name()
+	if !sameCluster {
+		sourceCluster, err = r.OCMClient.GetCluster(reference.ClusterKey, r.Creator)
+		if err != nil {
+			return nil, false, fmt.Errorf("Failed to get cluster '%s': %v", reference.ClusterKey, err)
+		}
+		sameCluster = sourceCluster.ID() == cluster.ID()
+	}
+
+	if sourceCluster.Hypershift().Enabled() {
+		nodePool, exists, err := r.OCMClient.GetNodePool(sourceCluster.ID(), reference.MachinePoolID)
+		if err != nil {
+			return nil, false, fmt.Errorf("Failed to get machine pool '%s' of cluster '%s': %v",
+				reference.MachinePoolID, reference.ClusterKey, err)
+		}
+		if !exists {
+			return nil, false, fmt.Errorf("Machine pool '%s' does not exist for cluster '%s'",
+				reference.MachinePoolID, reference.ClusterKey)
+		}
+		return newNodePoolCloneSource(nodePool), sameCluster, nil
+	}
+	machinePool, exists, err := r.OCMClient.GetMachinePool(sourceCluster.ID(), reference.MachinePoolID)
+	if err != nil {
+		return nil, false, fmt.Errorf("Failed to get machine pool '%s' of cluster '%s': %v",
+			reference.MachinePoolID, reference.ClusterKey, err)
+	}
+	if !exists {
+		return nil, false, fmt.Errorf("Machine pool '%s' does not exist for cluster '%s'",
+			reference.MachinePoolID, reference.ClusterKey)
+	}
+	return newMachinePoolCloneSource(machinePool), sameCluster, nil
+}
+
+// cloneNodePool copies the settings of the source machine pool to the node pool built from the flags,
+// and returns the settings that can't be carried over to the cluster
+func (s *cloneSource) cloneNodePool(cmd *cobra.Command, built *cmv1.NodePool,
+	sameCluster bool) (*cmv1.NodePool, []string, error) {
+	changed := cmd.Flags().Changed
+	skipped := s.skippedNetwork(sameCluster, true)
+	builder := cmv1.NewNodePool().Copy(built)
+	if len(s.labels) > 0 && !changed("labels") {
+		builder.Labels(s.labels)
+	}
+	if len(s.taints) > 0 && !changed("taints") {
+		builder.Taints(poolmigration.CloneTaints(s.taints)...)
+	}
+
+	awsBuilder := cmv1.NewAWSNodePool().Copy(built.AWSNodePool())
+	if s.diskSize > 0 && !changed("disk-size") {
+		awsBuilder.RootVolume(cmv1.NewAWSVolume().Size(s.diskSize))
+	}
+	if s.spot && !changed("use-spot-instances") && !changed("spot-max-price") {
+		spotBuilder := cmv1.NewAwsNodePoolSpotMarketOptions()
+		if s.spotMaxPrice != "" {
+			spotBuilder.MaxPrice(s.spotMaxPrice)
+		}
+		awsBuilder.SpotMarketOptions(spotBuilder)
+	}
+	if len(s.tags) > 0 && !changed("tags") {
+		awsBuilder.Tags(s.tags)
+	}
+	if sameCluster {
+		if len(s.securityGroups) > 0 && !changed(interactiveSgs.MachinePoolSecurityGroupFlag) {
+			awsBuilder.AdditionalSecurityGroupIds(s.securityGroups...)
+		}
+		if len(s.subnets) == 1 && !changed("subnet") && !changed("availability-zone") {
+			builder.Subnet(s.subnets[0])
+		}
+	}
+
+	source := s.nodePool
+	if source == nil {
+		builder.AWSNodePool(awsBuilder)
+		nodePool, err := builder.Build()
+		return nodePool, skipped, err
+	}
+	if capacityReservation, ok := source.AWSNodePool().GetCapacityReservation(); ok {
+		switch {
+		case !sameCluster:
+			skipped = append(skipped, fmt.Sprintf("capacity reservation '%s'", capacityReservation.Id()))
+		case built.AWSNodePool().InstanceType() != s.instanceType:
+			skipped = append(skipped, fmt.Sprintf("capacity reservation '%s', it is reserved for instance "+
+				"type '%s'", capacityReservation.Id(), s.instanceType))
+		case !changed("capacity-reservation-id") && !changed("capacity-reservation-preference"):
+			awsBuilder.CapacityReservation(cmv1.NewAWSCapacityReservation().Copy(capacityReservation))
+		}
+	}
+	builder.AWSNodePool(awsBuilder)
+
+	if sameCluster {
+		if len(source.TuningConfigs()) > 0 && !changed("tuning-configs") {
+			builder.TuningConfigs(source.TuningConfigs()...)
+		}
+		if len(source.KubeletConfigs()) > 0 && !changed("kubelet-configs") {
+			builder.KubeletConfigs(source.KubeletConfigs()...)
+		}
+	} else {
+		// Configs are defined per cluster, those of the target cluster can be selected with flags
+		if len(source.TuningConfigs()) > 0 {
+			skipped = append(skipped, fmt.Sprintf("tuning configs '%s', use '--tuning-configs' to select "+
+				"those of the target cluster", strings.Join(source.TuningConfigs(), ", ")))
+		}
+		if len(source.KubeletConfigs()) > 0 {
+			skipped = append(skipped, fmt.Sprintf("kubelet configs '%s', use '--kubelet-configs' to select "+
+				"those of the target cluster", strings.Join(source.KubeletConfigs(), ", ")))
+		}
+	}
+	if gracePeriod, ok := source.GetNodeDrainGracePeriod(); ok && !changed("node-drain-grace-period") {
+		builder.NodeDrainGracePeriod(cmv1.NewValue().Value(gracePeriod.Value()).Unit(gracePeriod.Unit()))
+	}
+	if imageType, ok := source.GetImageType(); ok && imageType != "" && !changed("type") {
+		builder.ImageType(imageType)
+	}
+	if upgrade, ok := source.GetManagementUpgrade(); ok && !changed("max-surge") && !changed("max-unavailable") {
+		builder.ManagementUpgrade(cmv1.NewNodePoolManagementUpgrade().Copy(upgrade))
+	}
+	if autoRepair, ok := source.GetAutoRepair(); ok && !changed("autorepair") {
+		builder.AutoRepair(autoRepair)
+	}
+	nodePool, err := builder.Build()
+	return nodePool, skipped, err
+}
+
+// cloneMachinePool copies the settings of the source machine pool to the classic machine pool built from
+// the flags, and returns the settings that can't be carried over to the cluster
+func (s *cloneSource) cloneMachinePool(cmd *cobra.Command, built *cmv1.MachinePool,
+	sameCluster bool) (*cmv1.MachinePool, []string, error) {
+	changed := cmd.Flags().Changed
+	skipped := s.skippedNetwork(sameCluster, false)
+	builder := cmv1.NewMachinePool().Copy(built)
+	if len(s.labels) > 0 && !changed("labels") {
+		builder.Labels(s.labels)
+	}
+	if len(s.taints) > 0 && !changed("taints") {
+		builder.Taints(poolmigration.CloneTaints(s.taints)...)
+	}
+
+	if s.diskSize > 0 && !changed("disk-size") {
+		builder.RootVolume(cmv1.NewRootVolume().AWS(cmv1.NewAWSVolume().Size(s.diskSize)))
+	}
+	awsBuilder := cmv1.NewAWSMachinePool().Copy(built.AWS())
+	if s.spot && !changed("use-spot-instances") && !changed("spot-max-price") {
+		spotBuilder := cmv1.NewAWSSpotMarketOptions()
+		if s.spotMaxPrice != "" {
+			maxPrice, err := strconv.ParseFloat(s.spotMaxPrice, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("expected a valid spot max price: %v", err)
+			}
+			spotBuilder.MaxPrice(maxPrice)
+		}
+		awsBuilder.SpotMarketOptions(spotBuilder)
+	}
+	if len(s.tags) > 0 && !changed("tags") {
+		awsBuilder.Tags(s.tags)
+	}
+	if sameCluster {
+		if len(s.securityGroups) > 0 && !changed(interactiveSgs.MachinePoolSecurityGroupFlag) {
+			awsBuilder.AdditionalSecurityGroupIds(s.securityGroups...)
+		}
+		if !changed("subnet") && !changed("availability-zone") && !changed("multi-availability-zone") {
+			if len(s.subnets) == 1 {
+				builder.Subnets(s.subnets[0])
+			} else if len(s.subnets) == 0 && len(s.availabilityZones) == 1 {
+				builder.AvailabilityZones(s.availabilityZones[0])
+			}
+		}
+	}
+	builder.AWS(awsBuilder)
+
+	if source := s.nodePool; source != nil {
+		// Node pool settings without a classic equivalent
+		if len(source.TuningConfigs()) > 0 {
+			skipped = append(skipped, fmt.Sprintf("tuning configs '%s'",
+				strings.Join(source.TuningConfigs(), ", ")))
+		}
+		if len(source.KubeletConfigs()) > 0 {
+			skipped = append(skipped, fmt.Sprintf("kubelet configs '%s', classic clusters use a cluster wide "+
+				"kubelet config", strings.Join(source.KubeletConfigs(), ", ")))
+		}
+		if gracePeriod, ok := source.GetNodeDrainGracePeriod(); ok && gracePeriod.Value() > 0 {
+			skipped = append(skipped, "node drain grace period")
+		}
+		if imageType, ok := source.GetImageType(); ok && imageType != "" {
+			skipped = append(skipped, fmt.Sprintf("image type '%s'", imageType))
+		}
+		if _, ok := source.GetManagementUpgrade(); ok {
+			skipped = append(skipped, "upgrade max surge and max unavailable")
+		}
+		if autoRepair, ok := source.GetAutoRepair(); ok && !autoRepair {
+			skipped = append(skipped, "disabled auto-repair")
+		}
+		if id := source.AWSNodePool().CapacityReservation().Id(); id != "" {
+			skipped = append(skipped, fmt.Sprintf("capacity reservation '%s'", id))
+		}
+	}
+	machinePool, err := builder.Build()
+	return machinePool, skipped, err
+}
+
+// skippedNetwork returns the network settings of the source machine pool that belong to the VPC of
+// another cluster
+func (s *cloneSource) skippedNetwork(sameCluster bool, hostedTarget bool) []string {
+	skipped := []string{}
+	if sameCluster {
+		return skipped
+	}
+	if len(s.securityGroups) > 0 {
+		skipped = append(skipped, fmt.Sprintf("security groups '%s'", strings.Join(s.securityGroups, ", ")))
+	}
+	switch {
+	case len(s.subnets) == 1 && s.nodePool != nil:
+		skipped = append(skipped, fmt.Sprintf("subnet '%s'", s.subnets[0]))
+	case len(s.subnets) > 0 && hostedTarget:
+		skipped = append(skipped, fmt.Sprintf("subnets '%s', the subnet of a hosted machine pool is selected "+
+			"with '--subnet'", strings.Join(s.subnets, ", ")))
+	case len(s.subnets) > 0:
+		skipped = append(skipped, fmt.Sprintf("subnets '%s'", strings.Join(s.subnets, ", ")))
+	case len(s.availabilityZones) == 1:
+		skipped = append(skipped, fmt.Sprintf("availability zone '%s'", s.availabilityZones[0]))
+	}
+	return skipped
+}
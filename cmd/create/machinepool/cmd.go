@@ -90,6 +90,21 @@ func CreateMachinepoolRunner(userOptions *mpOpts.CreateMachinepoolUserOptions) r
 			return fmt.Errorf("failed to create awsClient: %s", err)
 		}
 
+		if userOptions.From != "" {
+			return createClonedMachinePool(r, cmd, newService.service, clusterKey, cluster, clusterAutoscaler,
+				options.Machinepool())
+		}
+
+		if userOptions.SpreadAcrossSubnets {
+			return createSpreadMachinePools(r, cmd, newService.service, clusterKey, cluster, clusterAutoscaler,
+				options.Machinepool())
+		}
+
+		if cmd.Flags().Changed(spotPercentageFlag) || cmd.Flags().Changed(spotInstanceTypesFlag) {
+			return createSpotMachinePools(r, cmd, newService.service, clusterKey, cluster, clusterAutoscaler,
+				options.Machinepool())
+		}
+
 		return newService.service.CreateMachinePoolBasedOnClusterType(r,
 			cmd, clusterKey, cluster, clusterAutoscaler, options.Machinepool())
 	}
@@ -1,13 +1,22 @@
 package machinepool
 
 import (
+	"fmt"
+	"net/http"
+
 	"go.uber.org/mock/gomock"
 
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/machinepool"
 	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -110,3 +119,443 @@ var _ = Describe("Validation functions", func() {
 		})
 	})
 })
+
+var _ = Describe("createClonedMachinePool", func() {
+	var (
+		t           *test.TestingRuntime
+		ctrl        *gomock.Controller
+		serviceMock *machinepool.MockMachinePoolService
+	)
+
+	hostedCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.Name("mycluster")
+		c.State(cmv1.ClusterStateReady)
+		c.Hypershift(cmv1.NewHypershift().Enabled(true))
+	})
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		ctrl = gomock.NewController(GinkgoT())
+		serviceMock = machinepool.NewMockMachinePoolService(ctrl)
+	})
+
+	appendCreatedNodePool := func(created **cmv1.NodePool) {
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			VerifyRequest(http.MethodPost,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/node_pools", hostedCluster.ID())),
+			func(_ http.ResponseWriter, r *http.Request) {
+				nodePool, err := cmv1.UnmarshalNodePool(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				*created = nodePool
+			},
+			RespondWithJSON(http.StatusCreated, "{}"),
+		))
+	}
+
+	It("Copies a node pool of the same cluster, keeping the flags set by the user", func() {
+		source, err := cmv1.NewNodePool().ID("workers").Replicas(3).Subnet("subnet-1").
+			Labels(map[string]string{"team": "a"}).
+			TuningConfigs("tuned-1").
+			AutoRepair(false).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge").
+				RootVolume(cmv1.NewAWSVolume().Size(200)).
+				CapacityReservation(cmv1.NewAWSCapacityReservation().Id("cr-1"))).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatResource(source)))
+		var created *cmv1.NodePool
+		appendCreatedNodePool(&created)
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "copy")).To(Succeed())
+		Expect(cmd.Flags().Set("from", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("instance-type", "r5.2xlarge")).To(Succeed())
+		Expect(cmd.Flags().Set("labels", "team=b")).To(Succeed())
+		built, err := cmv1.NewNodePool().ID("copy").Replicas(3).Subnet("subnet-2").AutoRepair(true).
+			Labels(map[string]string{"team": "b"}).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("r5.2xlarge")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		serviceMock.EXPECT().BuildNodePool(gomock.Any(), cmd, "mycluster", hostedCluster, gomock.Any(), options).
+			Return(built, nil)
+
+		err = createClonedMachinePool(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.InstanceType).To(Equal("r5.2xlarge"))
+		Expect(options.Replicas).To(Equal(3))
+		Expect(cmd.Flags().Changed("replicas")).To(BeFalse())
+		Expect(created.ID()).To(Equal("copy"))
+		Expect(created.AWSNodePool().InstanceType()).To(Equal("r5.2xlarge"))
+		Expect(created.Labels()).To(Equal(map[string]string{"team": "b"}))
+		Expect(created.Subnet()).To(Equal("subnet-1"))
+		Expect(created.TuningConfigs()).To(Equal([]string{"tuned-1"}))
+		Expect(created.AutoRepair()).To(BeFalse())
+		Expect(created.AWSNodePool().RootVolume().Size()).To(Equal(200))
+		// The capacity reservation is bought for the instance type of the source machine pool
+		_, ok := created.AWSNodePool().GetCapacityReservation()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Copies a classic machine pool of another cluster without its network settings", func() {
+		classicCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.ID("other-id")
+			c.Name("other")
+			c.Hypershift(cmv1.NewHypershift().Enabled(false))
+		})
+		source, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(5)).
+			Taints(cmv1.NewTaint().Key("dedicated").Value("gpu").Effect("NoSchedule")).
+			Subnets("subnet-1").
+			AWS(cmv1.NewAWSMachinePool().
+				AdditionalSecurityGroupIds("sg-1").
+				Tags(map[string]string{"note": "has space"}).
+				SpotMarketOptions(cmv1.NewAWSSpotMarketOptions().MaxPrice(0.25))).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			test.FormatClusterList([]*cmv1.Cluster{classicCluster})))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatResource(source)))
+		var created *cmv1.NodePool
+		appendCreatedNodePool(&created)
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "copy")).To(Succeed())
+		Expect(cmd.Flags().Set("from", "other/workers")).To(Succeed())
+		built, err := cmv1.NewNodePool().ID("copy").Subnet("subnet-2").
+			Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(5)).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		serviceMock.EXPECT().BuildNodePool(gomock.Any(), cmd, "mycluster", hostedCluster, gomock.Any(), options).
+			Return(built, nil)
+
+		err = createClonedMachinePool(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(options.InstanceType).To(Equal("m5.xlarge"))
+		Expect(options.AutoscalingEnabled).To(BeTrue())
+		Expect(options.MinReplicas).To(Equal(2))
+		Expect(options.MaxReplicas).To(Equal(5))
+		Expect(created.Subnet()).To(Equal("subnet-2"))
+		Expect(created.AWSNodePool().AdditionalSecurityGroupIds()).To(BeEmpty())
+		Expect(created.AWSNodePool().Tags()).To(Equal(map[string]string{"note": "has space"}))
+		Expect(created.AWSNodePool().SpotMarketOptions().MaxPrice()).To(Equal("0.25"))
+		Expect(created.Taints()).To(HaveLen(1))
+		Expect(created.Taints()[0].Key()).To(Equal("dedicated"))
+	})
+
+	It("Fails when the machine pool does not exist", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, "{}"))
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("from", "mycluster/missing")).To(Succeed())
+		err := createClonedMachinePool(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).To(MatchError("Machine pool 'missing' does not exist for cluster 'mycluster'"))
+	})
+
+	It("Fails when the machine pool is spread across subnets", func() {
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("from", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set(spreadFlag, "true")).To(Succeed())
+		err := createClonedMachinePool(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).To(MatchError("'--spread-across-subnets' can't be used with '--from'"))
+		Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("createSpreadMachinePools", func() {
+	var (
+		t           *test.TestingRuntime
+		ctrl        *gomock.Controller
+		serviceMock *machinepool.MockMachinePoolService
+		awsClient   *aws.MockClient
+	)
+
+	hostedCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.State(cmv1.ClusterStateReady)
+		c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		c.AWS(cmv1.NewAWS().SubnetIDs("subnet-1", "subnet-2"))
+	})
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		ctrl = gomock.NewController(GinkgoT())
+		serviceMock = machinepool.NewMockMachinePoolService(ctrl)
+		awsClient = aws.NewMockClient(ctrl)
+		t.RosaRuntime.AWSClient = awsClient
+	})
+
+	appendSubnets := func() {
+		subnets := []ec2types.Subnet{
+			{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{}, nil)
+	}
+
+	appendCreatedNodePool := func(created *[]string, status int) {
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			VerifyRequest(http.MethodPost,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/node_pools", hostedCluster.ID())),
+			func(_ http.ResponseWriter, r *http.Request) {
+				nodePool, err := cmv1.UnmarshalNodePool(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				*created = append(*created, fmt.Sprintf("%s %s %d %v", nodePool.ID(), nodePool.Subnet(),
+					nodePool.Replicas(), nodePool.Labels()))
+			},
+			RespondWithJSON(status, "{}"),
+		))
+	}
+
+	groupNodePool := func(cmd *cobra.Command, options *mpOpts.CreateMachinepoolUserOptions,
+		labels map[string]string) {
+		nodePool, err := cmv1.NewNodePool().ID("workers").Subnet("subnet-1").Replicas(options.Replicas).
+			Labels(labels).AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		serviceMock.EXPECT().BuildNodePool(gomock.Any(), cmd, "mycluster", hostedCluster, gomock.Any(), options).
+			Return(nodePool, nil)
+	}
+
+	It("Creates one machine pool per private subnet", func() {
+		appendSubnets()
+		created := []string{}
+		appendCreatedNodePool(&created, http.StatusCreated)
+		appendCreatedNodePool(&created, http.StatusCreated)
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "5")).To(Succeed())
+		groupNodePool(cmd, options, map[string]string{})
+
+		err := createSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(Equal([]string{
+			"workers-a subnet-1 3 map[" + machinepool.SpreadGroupLabel + ":workers]",
+			"workers-b subnet-2 2 map[" + machinepool.SpreadGroupLabel + ":workers]",
+		}))
+		Expect(cmd.Flags().Changed("labels")).To(BeFalse())
+		Expect(options.Subnet).To(Equal("subnet-1"))
+	})
+
+	It("Deletes the machine pools already created when one fails", func() {
+		appendSubnets()
+		created := []string{}
+		appendCreatedNodePool(&created, http.StatusCreated)
+		appendCreatedNodePool(&created, http.StatusBadRequest)
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			VerifyRequest(http.MethodDelete,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/node_pools/workers-a", hostedCluster.ID())),
+			RespondWithJSON(http.StatusNoContent, ""),
+		))
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("labels", "team=a")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "4")).To(Succeed())
+		groupNodePool(cmd, options, map[string]string{"team": "a"})
+
+		err := createSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).To(MatchError(And(
+			ContainSubstring("Failed to add machine pool 'workers-b' to hosted cluster 'mycluster'"),
+			HaveSuffix("machine pools 'workers-a' that were already created have been deleted"))))
+		Expect(created).To(Equal([]string{
+			"workers-a subnet-1 2 map[" + machinepool.SpreadGroupLabel + ":workers team:a]",
+			"workers-b subnet-2 2 map[" + machinepool.SpreadGroupLabel + ":workers team:a]",
+		}))
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(3))
+	})
+
+	It("Doesn't create any machine pool when the flags are not valid", func() {
+		appendSubnets()
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "-1")).To(Succeed())
+		serviceMock.EXPECT().BuildNodePool(gomock.Any(), cmd, "mycluster", hostedCluster, gomock.Any(), options).
+			Return(nil, fmt.Errorf("the number of machine pool replicas needs to be a non-negative integer"))
+
+		err := createSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).To(MatchError("the number of machine pool replicas needs to be a non-negative integer"))
+		Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+	})
+
+	It("Fails for classic clusters", func() {
+		classicCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Hypershift(cmv1.NewHypershift().Enabled(false))
+		})
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		err := createSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", classicCluster, nil, options)
+		Expect(err).To(MatchError(ContainSubstring("'--spread-across-subnets' is only supported for Hosted " +
+			"Control Planes")))
+	})
+
+	It("Fails when a subnet is selected", func() {
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("subnet", "subnet-1")).To(Succeed())
+		err := createSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).To(MatchError("'--subnet' can't be used with '--spread-across-subnets'"))
+	})
+})
+
+var _ = Describe("createSpotMachinePools", func() {
+	var (
+		t           *test.TestingRuntime
+		ctrl        *gomock.Controller
+		serviceMock *machinepool.MockMachinePoolService
+	)
+
+	classicCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.State(cmv1.ClusterStateReady)
+		c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+		c.Nodes(cmv1.NewClusterNodes().AvailabilityZones("us-east-1a"))
+		c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().RoleARN("arn:aws:iam::123456789012:role/installer")))
+	})
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		ctrl = gomock.NewController(GinkgoT())
+		serviceMock = machinepool.NewMockMachinePoolService(ctrl)
+	})
+
+	appendMachineTypes := func(ids ...string) {
+		machineTypes := []*cmv1.MachineType{}
+		for _, id := range ids {
+			machineType, err := cmv1.NewMachineType().ID(id).Name(id).Build()
+			Expect(err).NotTo(HaveOccurred())
+			machineTypes = append(machineTypes, machineType)
+		}
+		account, err := amsv1.NewAccount().ID("123456789012").Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatMachineTypeList(machineTypes)))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatResource(account)))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			test.FormatQuotaCostList([]*amsv1.QuotaCost{})))
+	}
+
+	appendCreatedMachinePool := func(created *[]string, status int) {
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			VerifyRequest(http.MethodPost,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools", classicCluster.ID())),
+			func(_ http.ResponseWriter, r *http.Request) {
+				machinePool, err := cmv1.UnmarshalMachinePool(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				*created = append(*created, fmt.Sprintf("%s %s %t %d %v", machinePool.ID(),
+					machinePool.InstanceType(), machinePool.AWS().SpotMarketOptions() != nil,
+					machinePool.Replicas(), machinePool.AWS().SpotMarketOptions().MaxPrice()))
+			},
+			RespondWithJSON(status, "{}"),
+		))
+	}
+
+	groupMachinePool := func(cmd *cobra.Command, options *mpOpts.CreateMachinepoolUserOptions) {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").Replicas(6).
+			Labels(map[string]string{"team": "a"}).Build()
+		Expect(err).NotTo(HaveOccurred())
+		serviceMock.EXPECT().BuildMachinePool(gomock.Any(), cmd, "mycluster", classicCluster, options).
+			Return(machinePool, nil)
+	}
+
+	It("Creates the spot machine pools and the on-demand machine pool", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			test.FormatMachinePoolList([]*cmv1.MachinePool{})))
+		appendMachineTypes("m5.xlarge", "m5a.xlarge")
+		created := []string{}
+		for range 3 {
+			appendCreatedMachinePool(&created, http.StatusCreated)
+		}
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "6")).To(Succeed())
+		Expect(cmd.Flags().Set("instance-type", "m5.xlarge")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-max-price", "0.5")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-percentage", "67")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-instance-types", "m5a.xlarge")).To(Succeed())
+		groupMachinePool(cmd, options)
+
+		err := createSpotMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", classicCluster, nil, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(Equal([]string{
+			"workers m5.xlarge true 2 0.5",
+			"workers-spot-1 m5a.xlarge true 2 0.5",
+			"workers-on-demand m5.xlarge false 2 0",
+		}))
+		Expect(cmd.Flags().Changed("use-spot-instances")).To(BeFalse())
+		Expect(options.Name).To(Equal("workers"))
+	})
+
+	It("Deletes the machine pools already created when one fails", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			test.FormatMachinePoolList([]*cmv1.MachinePool{})))
+		appendMachineTypes("m5.xlarge", "m5a.xlarge")
+		created := []string{}
+		appendCreatedMachinePool(&created, http.StatusCreated)
+		appendCreatedMachinePool(&created, http.StatusBadRequest)
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			VerifyRequest(http.MethodDelete,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools/workers", classicCluster.ID())),
+			RespondWithJSON(http.StatusNoContent, ""),
+		))
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "6")).To(Succeed())
+		Expect(cmd.Flags().Set("instance-type", "m5.xlarge")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-percentage", "67")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-instance-types", "m5a.xlarge")).To(Succeed())
+		groupMachinePool(cmd, options)
+
+		err := createSpotMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", classicCluster, nil, options)
+		Expect(err).To(MatchError(And(
+			ContainSubstring("Failed to add machine pool 'workers-spot-1' to cluster 'mycluster'"),
+			HaveSuffix("machine pools 'workers' that were already created have been deleted"))))
+		Expect(created).To(HaveLen(2))
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(7))
+	})
+
+	It("Fails when a spot instance type is not available", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			test.FormatMachinePoolList([]*cmv1.MachinePool{})))
+		appendMachineTypes("m5.xlarge")
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "4")).To(Succeed())
+		Expect(cmd.Flags().Set("instance-type", "m5.xlarge")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-percentage", "50")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-instance-types", "m5a.xlarge")).To(Succeed())
+		err := createSpotMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", classicCluster, nil, options)
+		Expect(err).To(MatchError("spot instance type 'm5a.xlarge' is not available in availability zones " +
+			"'us-east-1a'"))
+	})
+
+	It("Fails when a machine pool of the group exists", func() {
+		existing, err := cmv1.NewMachinePool().ID("workers-on-demand").Build()
+		Expect(err).NotTo(HaveOccurred())
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			test.FormatMachinePoolList([]*cmv1.MachinePool{existing})))
+
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("name", "workers")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "4")).To(Succeed())
+		Expect(cmd.Flags().Set("spot-percentage", "50")).To(Succeed())
+		err = createSpotMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", classicCluster, nil, options)
+		Expect(err).To(MatchError("Machine pool 'workers-on-demand' already exists for cluster 'mycluster'"))
+	})
+
+	It("Fails without a spot percentage", func() {
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("spot-instance-types", "m5a.xlarge")).To(Succeed())
+		err := createSpotMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", classicCluster, nil, options)
+		Expect(err).To(MatchError("'--spot-instance-types' requires '--spot-percentage'"))
+	})
+
+	It("Fails for hosted clusters", func() {
+		hostedCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+		cmd, options := mpOpts.BuildMachinePoolCreateCommandWithOptions()
+		Expect(cmd.Flags().Set("spot-percentage", "50")).To(Succeed())
+		err := createSpotMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, nil, options)
+		Expect(err).To(MatchError("'--spot-percentage' is only supported for classic clusters"))
+	})
+})
@@ -0,0 +1,147 @@
+package machinepool
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/machinepool"
+	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	spotPercentageFlag    = "spot-percentage"
+	spotInstanceTypesFlag = "spot-instance-types"
+)
+
+// createSpotMachinePools creates the machine pools of a classic cluster that mix spot and on-demand
+// instances: one spot machine pool per instance type and an on-demand machine pool with the same labels and
+// taints that they fall back to. The flags are validated once for the whole group and every machine pool
+// is built from the result. The machine pools already created are deleted when one of them fails.
+func createSpotMachinePools(r *rosa.Runtime, cmd *cobra.Command, service machinepool.MachinePoolService,
+	clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
+	args *mpOpts.CreateMachinepoolUserOptions) error {
+	if cluster.Hypershift().Enabled() {
+		return fmt.Errorf("'--%s' is only supported for classic clusters", spotPercentageFlag)
+	}
+	if !cmd.Flags().Changed(spotPercentageFlag) {
+		return fmt.Errorf("'--%s' requires '--%s'", spotInstanceTypesFlag, spotPercentageFlag)
+	}
+	if interactive.Enabled() {
+		return fmt.Errorf("'--%s' can't be used in interactive mode", spotPercentageFlag)
+	}
+	if cmd.Flags().Changed("use-spot-instances") && !args.UseSpotInstances {
+		return fmt.Errorf("'--%s' can't be used with '--use-spot-instances=false'", spotPercentageFlag)
+	}
+	if args.Name == "" {
+		return fmt.Errorf("'--name' is required with '--%s'", spotPercentageFlag)
+	}
+	if args.InstanceType == "" {
+		return fmt.Errorf("'--instance-type' is required with '--%s'", spotPercentageFlag)
+	}
+	err := machinepool.ValidateSpotPercentage(args.SpotPercentage)
+	if err != nil {
+		return err
+	}
+
+	instanceTypes := []string{args.InstanceType}
+	for _, instanceType := range args.SpotInstanceTypes {
+		instanceType = strings.TrimSpace(instanceType)
+		if instanceType == "" {
+			return fmt.Errorf("expected a comma-separated list of instance types for '--%s'", spotInstanceTypesFlag)
+		}
+		if !slices.Contains(instanceTypes, instanceType) {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+
+	name := args.Name
+	names := []string{}
+	for i := range instanceTypes {
+		names = append(names, machinepool.SpotMachinePoolID(name, i))
+	}
+	names = append(names, machinepool.OnDemandMachinePoolID(name))
+	existing, err := r.OCMClient.GetMachinePools(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+	}
+	for _, machinePool := range existing {
+		if slices.Contains(names, machinePool.ID()) {
+			return fmt.Errorf("Machine pool '%s' already exists for cluster '%s'", machinePool.ID(), clusterKey)
+		}
+	}
+	for _, id := range names {
+		if !machinepool.MachinePoolKeyRE.MatchString(id) {
+			return fmt.Errorf("expected a valid name for machine pool '%s'", id)
+		}
+	}
+
+	// Multi-AZ machine pools are split in steps of the zones of the cluster
+	multiAZMachinePool := cluster.MultiAZ() && args.MultiAvailabilityZone &&
+		!cmd.Flags().Changed("availability-zone") && !cmd.Flags().Changed("subnet")
+	zones := 1
+	if multiAZMachinePool {
+		zones = len(cluster.Nodes().AvailabilityZones())
+	}
+	for _, flag := range []string{"replicas", "min-replicas", "max-replicas"} {
+		value, _ := strconv.Atoi(cmd.Flags().Lookup(flag).Value.String())
+		if value%zones != 0 {
+			return fmt.Errorf("'--%s' must be a multiple of %d to split a multi-AZ machine pool", flag, zones)
+		}
+	}
+
+	err = machinepool.ValidateSpotInstanceTypes(r, cluster, instanceTypes, multiAZMachinePool,
+		args.AvailabilityZone, args.Subnet)
+	if err != nil {
+		return err
+	}
+
+	replicas := args.Replicas
+	if args.AutoscalingEnabled {
+		replicas = args.MaxReplicas
+	}
+	split := machinepool.SplitSpotReplicas(replicas, args.SpotPercentage, len(instanceTypes), zones)
+	minSplit := machinepool.SplitSpotReplicas(args.MinReplicas, args.SpotPercentage, len(instanceTypes), zones)
+	if split.OnDemand == 0 || slices.Contains(split.Spot, 0) {
+		return fmt.Errorf("%d replicas can't be split into %d spot machine pools and an on-demand machine pool "+
+			"with a spot percentage of %d%%", replicas, len(instanceTypes), args.SpotPercentage)
+	}
+
+	// The machine pool of the group validates the flags, its machine pools are built from it
+	groupMachinePool, err := service.BuildMachinePool(r, cmd, clusterKey, cluster, args)
+	if err != nil {
+		return err
+	}
+	machinePools, err := machinepool.BuildSpotGroup(groupMachinePool, instanceTypes, args.SpotMaxPrice, split,
+		minSplit)
+	if err != nil {
+		return fmt.Errorf("Failed to build the machine pools of '%s': %v", name, err)
+	}
+
+	created := []string{}
+	createdMachinePools := []*cmv1.MachinePool{}
+	for _, machinePool := range machinePools {
+		createdMachinePool, err := r.OCMClient.CreateMachinePool(cluster.ID(), machinePool)
+		if err != nil {
+			err = fmt.Errorf("Failed to add machine pool '%s' to cluster '%s': %v", machinePool.ID(),
+				clusterKey, err)
+			return machinepool.RollbackSpotMachinePools(r.OCMClient, cluster.ID(), created, err)
+		}
+		created = append(created, machinePool.ID())
+		createdMachinePools = append(createdMachinePools, createdMachinePool)
+	}
+	if output.HasFlag() {
+		return output.Print(createdMachinePools)
+	}
+	r.Reporter.Infof("Machine pool '%s' was split into %d spot machine pools and on-demand machine pool '%s', "+
+		"run 'rosa describe machinepool -c %s --machinepool %s' to see the split", name, len(instanceTypes),
+		machinepool.OnDemandMachinePoolID(name), clusterKey, name)
+	return nil
+}
@@ -0,0 +1,90 @@
+package machinepool
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/machinepool"
+	mpOpts "github.com/openshift/rosa/pkg/options/machinepool"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const spreadFlag = "spread-across-subnets"
+
+// createSpreadMachinePools creates one node pool per private subnet of a hosted cluster, dividing the
+// replicas or autoscaling limits between them. The flags are validated once for the whole group and every
+// node pool is built from the result. The node pools already created are deleted when one of them fails.
+func createSpreadMachinePools(r *rosa.Runtime, cmd *cobra.Command, service machinepool.MachinePoolService,
+	clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
+	args *mpOpts.CreateMachinepoolUserOptions) error {
+	if !cluster.Hypershift().Enabled() {
+		return fmt.Errorf("'--%s' is only supported for Hosted Control Planes, use "+
+			"'--multi-availability-zone' to spread a classic machine pool", spreadFlag)
+	}
+	if interactive.Enabled() {
+		return fmt.Errorf("'--%s' can't be used in interactive mode", spreadFlag)
+	}
+	for _, flag := range []string{"subnet", "availability-zone"} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("'--%s' can't be used with '--%s'", flag, spreadFlag)
+		}
+	}
+	if args.Name == "" {
+		return fmt.Errorf("'--name' is required with '--%s'", spreadFlag)
+	}
+
+	subnets, skipped, err := machinepool.GetSpreadSubnets(r.AWSClient, cluster)
+	if err != nil {
+		return err
+	}
+	err = machinepool.ValidateSpreadGroupName(args.Name, subnets)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range skipped {
+		r.Reporter.Warnf("Private subnet '%s' is not used, its availability zone already has a machine pool",
+			subnet)
+	}
+
+	// The node pool of the group validates the flags in the first subnet, its node pools are built from it
+	args.Subnet = subnets[0].ID
+	groupNodePool, err := service.BuildNodePool(r, cmd, clusterKey, cluster, clusterAutoscaler, args)
+	if err != nil {
+		return err
+	}
+	name := groupNodePool.ID()
+	autoscaling, isAutoscaling := groupNodePool.GetAutoscaling()
+	shares := machinepool.ShareReplicas(isAutoscaling, groupNodePool.Replicas(), autoscaling.MinReplica(),
+		autoscaling.MaxReplica(), len(subnets))
+	if isAutoscaling && shares[len(shares)-1].MaxReplicas == 0 {
+		return fmt.Errorf("'--max-replicas' must be at least %d to spread the machine pool across %d subnets",
+			len(subnets), len(subnets))
+	}
+	nodePools, err := machinepool.BuildSpreadGroup(groupNodePool, subnets, shares)
+	if err != nil {
+		return fmt.Errorf("Failed to build the machine pools of '%s': %v", name, err)
+	}
+
+	created := []string{}
+	createdNodePools := []*cmv1.NodePool{}
+	for _, nodePool := range nodePools {
+		createdNodePool, err := r.OCMClient.CreateNodePool(cluster.ID(), nodePool)
+		if err != nil {
+			err = fmt.Errorf("Failed to add machine pool '%s' to hosted cluster '%s': %v", nodePool.ID(),
+				clusterKey, err)
+			return machinepool.RollbackSpreadNodePools(r.OCMClient, cluster.ID(), created, err)
+		}
+		created = append(created, nodePool.ID())
+		createdNodePools = append(createdNodePools, createdNodePool)
+	}
+	if output.HasFlag() {
+		return output.Print(createdNodePools)
+	}
+	r.Reporter.Infof("Machine pool '%s' was spread across %d subnets, run 'rosa list machinepools -c %s' "+
+		"to see it", name, len(subnets), clusterKey)
+	return nil
+}
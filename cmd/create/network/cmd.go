@@ -20,7 +20,7 @@ import (
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
-const defaultTemplate = "rosa-quickstart-default-vpc"
+const defaultTemplate = helper.DefaultTemplateName
 
 func NewNetworkCommand() *cobra.Command {
 	cmd, options := opts.BuildNetworkCommandWithOptions()
@@ -149,6 +149,17 @@ func NetworkRunner(userOptions *opts.NetworkUserOptions) rosa.CommandRunner {
 		if err != nil {
 			return err
 		}
+		if _, ok := parsedTags[helper.TemplateTagKey]; !ok {
+			parsedTags[helper.TemplateTagKey] = templateCommand
+		}
+		template, err := helper.ParseTemplate(templateCommand, templateFile)
+		if err != nil {
+			return err
+		}
+		err = template.ValidateParams(parsedParams)
+		if err != nil {
+			return err
+		}
 		service := helper.NewNetworkService()
 
 		mode, err := interactive.GetMode()
@@ -200,6 +211,12 @@ func extractTemplateCommand(r *rosa.Runtime, argv []string, options *opts.Networ
 	}
 	if *templateCommand == defaultTemplate {
 		*templateFile = CloudFormationTemplateFile
+	} else if helper.IsBuiltInTemplate(*templateCommand) {
+		template, err := helper.GetTemplate("", *templateCommand)
+		if err != nil {
+			return err
+		}
+		*templateFile = template.Body
 	} else {
 		if options.TemplateDir == opts.DefaultTemplateDir {
 			return fmt.Errorf("when using a custom template please use `--template-dir` to specify the template directory")
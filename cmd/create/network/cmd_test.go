@@ -147,5 +147,20 @@ var _ = Describe("Validation functions", func() {
 			Expect(templateCommand).To(Equal("rosa-quickstart-default-vpc"))
 			Expect(templateFile).To(Equal(CloudFormationTemplateFile))
 		})
+
+		It("should use a built-in template without a template directory", func() {
+			argv := []string{"rosa-quickstart-private-vpc"}
+			r := rosa.NewRuntime()
+			templateCommand := ""
+			templateFile := ""
+			options := &opts.NetworkUserOptions{}
+			options.TemplateDir = "cmd/create/network/templates"
+
+			err := extractTemplateCommand(r, argv, options, &templateCommand, &templateFile)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(templateCommand).To(Equal("rosa-quickstart-private-vpc"))
+			Expect(templateFile).To(Equal(network.PrivateTemplateBody))
+		})
 	})
 })
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/tuningconfig"
 )
 
 var args struct {
@@ -134,6 +135,10 @@ func buildTuningConfigFromInputFile(specPath string, name string, clusterKey str
 	if err != nil {
 		return nil, fmt.Errorf("Expected a valid TuneD spec file: %v", err)
 	}
+	err = tuningconfig.ValidateSpec(specJson)
+	if err != nil {
+		return nil, fmt.Errorf("Expected a valid TuneD spec file '%s': %v", specPath, err)
+	}
 
 	tuningConfigBuilder := cmv1.NewTuningConfig().Name(name).Spec(specJson)
 
@@ -1,6 +1,10 @@
 package tuningconfigs
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -23,5 +27,15 @@ var _ = Describe("TuningConfigs Create Tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(tuningConfig.Name()).To(Equal(name))
 		})
+
+		It("KO: Should fail for an invalid TuneD spec", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "spec.yaml")
+			err := os.WriteFile(path, []byte("profile:\n- name: tuned-1-profile\n  data: \"[main]\"\n"+
+				"recommend:\n- priority: 20\n  profile: tuned-2-profile\n"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = buildTuningConfigFromInputFile(path, name, clusterKey)
+			Expect(err).To(MatchError(fmt.Sprintf("Expected a valid TuneD spec file '%s': recommend[0].profile: "+
+				"profile 'tuned-2-profile' is not defined in 'profile'", path)))
+		})
 	})
 })
@@ -18,7 +18,12 @@ const (
 	long  = "Describes the configuration for cluster's Cluster Auto-scaler. Supported on ROSA clusters " +
 		"service-hosted (HCP) with self-hosted (Classic) control planes."
 	example = ` # Describe the autoscaler for cluster 'foo'
-rosa describe autoscaler --cluster foo`
+rosa describe autoscaler --cluster foo
+
+ # Describe the autoscaler for cluster 'foo' with the defaults and the effect of each setting
+rosa describe autoscaler --cluster foo --explain`
+
+	explainFlag = "explain"
 )
 
 var aliases = []string{"cluster-autoscaler"}
@@ -34,13 +39,18 @@ func NewDescribeAutoscalerCommand() *cobra.Command {
 		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DescribeAutoscalerRunner()),
 	}
 
+	cmd.Flags().Bool(
+		explainFlag,
+		false,
+		"Annotate each setting with its default value and its effect on scaling.",
+	)
 	output.AddFlag(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
 }
 
 func DescribeAutoscalerRunner() rosa.CommandRunner {
-	return func(_ context.Context, runtime *rosa.Runtime, _ *cobra.Command, _ []string) error {
+	return func(_ context.Context, runtime *rosa.Runtime, command *cobra.Command, _ []string) error {
 		cluster, err := runtime.OCMClient.GetCluster(runtime.GetClusterKey(), runtime.Creator)
 		if err != nil {
 			return err
@@ -60,8 +70,18 @@ func DescribeAutoscalerRunner() rosa.CommandRunner {
 			return fmt.Errorf("No autoscaler exists for cluster '%s'", runtime.ClusterKey)
 		}
 
+		explain := false
+		if command != nil {
+			explain, _ = command.Flags().GetBool(explainFlag)
+		}
 		if output.HasFlag() {
 			output.Print(autoscaler)
+		} else if explain {
+			fmt.Print(explainAutoscaler(autoscaler, &clusterautoscaler.AutoscalerValidationArgs{
+				ClusterVersion: cluster.OpenshiftVersion(),
+				MultiAz:        cluster.MultiAZ(),
+				IsHostedCp:     cluster.Hypershift().Enabled(),
+			}))
 		} else {
 			if cluster.Hypershift().Enabled() {
 				fmt.Print(clusterautoscaler.PrintHypershiftAutoscaler(autoscaler))
@@ -136,6 +136,36 @@ var _ = Describe("rosa describe autoscaler", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("Explains the autoscaler settings", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.OpenshiftVersion("4.14.0")
+			})
+
+			cmd := NewDescribeAutoscalerCommand()
+			Expect(cmd.Flags().Set("explain", "true")).To(Succeed())
+
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+
+			autoscaler := MockAutoscaler(func(a *cmv1.ClusterAutoscalerBuilder) {
+				a.ScaleDown(cmv1.NewAutoscalerScaleDownConfig().Enabled(true).UnneededTime("5m"))
+			})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResource(autoscaler)))
+
+			runner := DescribeAutoscalerRunner()
+			err := t.StdOutReader.Record()
+			Expect(err).NotTo(HaveOccurred())
+			err = runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			stdout, err := t.StdOutReader.Read()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("Scale Down Enabled: Yes (default: No)\n"))
+			Expect(stdout).To(ContainSubstring("Scale Down Node Unneeded Time: 5m (default: 10m)\n" +
+				"    Time a node must be underutilized before it's removed."))
+		})
+
 		It("Prints the autoscaler in JSON", func() {
 			output.SetOutput("json")
 			Expect(output.HasFlag()).To(BeTrue())
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"fmt"
+	"strconv"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/clusterautoscaler"
+	"github.com/openshift/rosa/pkg/output"
+)
+
+// Defaults of the cluster autoscaler for the settings that have no default value in the flags
+const (
+	defaultScaleDownUnneededTime      = "10m"
+	defaultScaleDownDelayAfterAdd     = "10m"
+	defaultScaleDownDelayAfterDelete  = "0s"
+	defaultScaleDownDelayAfterFailure = "3m"
+)
+
+// explainedSetting is a setting of the autoscaler with its default and its effect on scaling
+type explainedSetting struct {
+	name         string
+	value        string
+	defaultValue string
+	effect       string
+}
+
+// explainAutoscaler returns the settings of an autoscaler annotated with their default values and their
+// effect on scaling
+func explainAutoscaler(a *cmv1.ClusterAutoscaler, validationArgs *clusterautoscaler.AutoscalerValidationArgs) string {
+	maxNodesTotal := strconv.Itoa(clusterautoscaler.MaxNodesTotalDefault(validationArgs))
+	settings := []explainedSetting{
+		{"Maximum Node Provision Time", a.MaxNodeProvisionTime(), "15m",
+			"A node that doesn't become ready within this time is removed and scale up is retried with " +
+				"another machine pool."},
+		{"Maximum Pod Grace Period", strconv.Itoa(a.MaxPodGracePeriod()), "600",
+			"Seconds given to pods to terminate when their node is scaled down."},
+		{"Pod Priority Threshold", strconv.Itoa(a.PodPriorityThreshold()), "-10",
+			"Pending pods with a lower priority don't trigger a scale up, and don't prevent a scale down."},
+		{"Maximum Nodes", strconv.Itoa(a.ResourceLimits().MaxNodesTotal()), maxNodesTotal,
+			"No nodes are added once the cluster has this many nodes, including the nodes that aren't " +
+				"autoscaled."},
+	}
+	if !validationArgs.IsHostedCp {
+		settings = append(settings, []explainedSetting{
+			{"Balance Similar Node Groups", output.PrintBool(a.BalanceSimilarNodeGroups()), output.No,
+				"Machine pools with the same instance type and labels are scaled up together to keep their " +
+					"sizes balanced, for example across availability zones."},
+			{"Labels Ignored For Node Balancing", output.PrintStringSlice(a.BalancingIgnoredLabels()),
+				output.EmptySlice, "Labels that don't prevent machine pools from being considered similar."},
+			{"Skip Nodes With Local Storage", output.PrintBool(a.SkipNodesWithLocalStorage()), output.No,
+				"Nodes running pods with EmptyDir or HostPath volumes are never scaled down."},
+			{"Ignore DaemonSets Utilization", output.PrintBool(a.IgnoreDaemonsetsUtilization()), output.No,
+				"DaemonSet pods aren't counted in the utilization of a node, so more nodes can be scaled down."},
+			{"Log Verbosity", strconv.Itoa(a.LogVerbosity()), "1",
+				"Log level of the autoscaler, 4 is a good option when debugging scaling decisions."},
+			{"Minimum Number of Cores", strconv.Itoa(a.ResourceLimits().Cores().Min()), "0",
+				"Nodes aren't scaled down below this number of cores in the cluster."},
+			{"Maximum Number of Cores", strconv.Itoa(a.ResourceLimits().Cores().Max()), strconv.Itoa(180 * 64),
+				"Nodes aren't added above this number of cores in the cluster."},
+			{"Minimum Memory (GiB)", strconv.Itoa(a.ResourceLimits().Memory().Min()), "0",
+				"Nodes aren't scaled down below this amount of memory in the cluster."},
+			{"Maximum Memory (GiB)", strconv.Itoa(a.ResourceLimits().Memory().Max()), strconv.Itoa(180 * 64 * 20),
+				"Nodes aren't added above this amount of memory in the cluster."},
+			{"Scale Down Enabled", output.PrintBool(a.ScaleDown().Enabled()), output.No,
+				"Underutilized nodes are removed. When disabled the cluster only grows."},
+			{"Scale Down Node Unneeded Time", a.ScaleDown().UnneededTime(), defaultScaleDownUnneededTime,
+				"Time a node must be underutilized before it's removed. Lower values save costs, higher " +
+					"values keep capacity for bursts of pods."},
+			{"Scale Down Node Utilization Threshold", a.ScaleDown().UtilizationThreshold(), "0.5",
+				"Nodes whose requested resources are below this share of their capacity are underutilized. " +
+					"Higher values remove more nodes."},
+			{"Scale Down Delay After Node Added", a.ScaleDown().DelayAfterAdd(), defaultScaleDownDelayAfterAdd,
+				"Scale down is paused for this time after a scale up."},
+			{"Scale Down Delay After Node Deleted", a.ScaleDown().DelayAfterDelete(),
+				defaultScaleDownDelayAfterDelete, "Scale down is paused for this time after a node is removed."},
+			{"Scale Down Delay After Deletion Failure", a.ScaleDown().DelayAfterFailure(),
+				defaultScaleDownDelayAfterFailure, "Scale down is paused for this time after a node failed to " +
+					"be removed."},
+		}...)
+		for _, limitation := range a.ResourceLimits().GPUS() {
+			settings = append(settings, explainedSetting{
+				fmt.Sprintf("GPU Limitation '%s'", limitation.Type()),
+				fmt.Sprintf("%d-%d", limitation.Range().Min(), limitation.Range().Max()), "",
+				"Nodes aren't scaled outside of this range of GPUs of this type.",
+			})
+		}
+	}
+
+	out := "\n"
+	for _, setting := range settings {
+		out += fmt.Sprintf("%s: %s\n", setting.name, explainValue(setting.value, setting.defaultValue))
+		out += fmt.Sprintf("    %s\n", setting.effect)
+	}
+	return out
+}
+
+func explainValue(value string, defaultValue string) string {
+	switch {
+	case defaultValue == "":
+		return value
+	case value == "":
+		return fmt.Sprintf("%s (not set, the default applies)", defaultValue)
+	case sameValue(value, defaultValue):
+		return fmt.Sprintf("%s (default)", value)
+	}
+	return fmt.Sprintf("%s (default: %s)", value, defaultValue)
+}
+
+// sameValue compares the values numerically when both are numbers, '0.50' is the same as '0.5'
+func sameValue(value string, defaultValue string) bool {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return value == defaultValue
+	}
+	defaultNumber, err := strconv.ParseFloat(defaultValue, 64)
+	return err == nil && number == defaultNumber
+}
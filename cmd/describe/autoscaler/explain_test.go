@@ -0,0 +1,49 @@
+package autoscaler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/clusterautoscaler"
+)
+
+var _ = Describe("Explain Autoscaler", func() {
+	It("Annotates the settings with their defaults", func() {
+		autoscaler, err := cmv1.NewClusterAutoscaler().
+			MaxNodeProvisionTime("15m").
+			MaxPodGracePeriod(300).
+			PodPriorityThreshold(-10).
+			LogVerbosity(1).
+			ResourceLimits(cmv1.NewAutoscalerResourceLimits().MaxNodesTotal(100).
+				Cores(cmv1.NewResourceRange().Min(0).Max(11520)).
+				Memory(cmv1.NewResourceRange().Min(0).Max(230400)).
+				GPUS(cmv1.NewAutoscalerResourceLimitsGPULimit().Type("nvidia.com/gpu").
+					Range(cmv1.NewResourceRange().Min(0).Max(4)))).
+			ScaleDown(cmv1.NewAutoscalerScaleDownConfig().Enabled(true).UnneededTime("5m").
+				UtilizationThreshold("0.700000")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		out := explainAutoscaler(autoscaler, &clusterautoscaler.AutoscalerValidationArgs{ClusterVersion: "4.14.0", MultiAz: true})
+		Expect(out).To(ContainSubstring("Maximum Node Provision Time: 15m (default)\n" +
+			"    A node that doesn't become ready within this time is removed"))
+		Expect(out).To(ContainSubstring("Maximum Pod Grace Period: 300 (default: 600)\n"))
+		Expect(out).To(ContainSubstring("Maximum Nodes: 100 (default: 186)\n"))
+		Expect(out).To(ContainSubstring("Balance Similar Node Groups: No (default)\n"))
+		Expect(out).To(ContainSubstring("Scale Down Enabled: Yes (default: No)\n"))
+		Expect(out).To(ContainSubstring("Scale Down Node Utilization Threshold: 0.700000 (default: 0.5)\n"))
+		Expect(out).To(ContainSubstring("Scale Down Delay After Node Added: 10m (not set, the default applies)\n"))
+		Expect(out).To(ContainSubstring("GPU Limitation 'nvidia.com/gpu': 0-4\n"))
+	})
+
+	It("Only explains the settings of Hosted Control Plane autoscalers", func() {
+		autoscaler, err := cmv1.NewClusterAutoscaler().MaxPodGracePeriod(600).
+			ResourceLimits(cmv1.NewAutoscalerResourceLimits().MaxNodesTotal(500)).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		out := explainAutoscaler(autoscaler, &clusterautoscaler.AutoscalerValidationArgs{IsHostedCp: true})
+		Expect(out).To(ContainSubstring("Maximum Nodes: 500 (default)\n"))
+		Expect(out).NotTo(ContainSubstring("Scale Down"))
+	})
+})
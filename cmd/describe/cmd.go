@@ -32,6 +32,8 @@ import (
 	"github.com/openshift/rosa/cmd/describe/kubeletconfig"
 	"github.com/openshift/rosa/cmd/describe/logforwarders"
 	"github.com/openshift/rosa/cmd/describe/machinepool"
+	"github.com/openshift/rosa/cmd/describe/network"
+	"github.com/openshift/rosa/cmd/describe/networktemplate"
 	"github.com/openshift/rosa/cmd/describe/service"
 	"github.com/openshift/rosa/cmd/describe/tuningconfigs"
 	"github.com/openshift/rosa/cmd/describe/upgrade"
@@ -50,6 +52,7 @@ func init() {
 	ingressCommand := ingress.NewDescribeIngressCommand()
 	kubeletconfig := kubeletconfig.NewDescribeKubeletConfigCommand()
 	accessrequestCommand := accessrequest.NewDescribeAccessRequestCommand()
+	networkTemplateCommand := networktemplate.NewDescribeNetworkTemplateCommand()
 	cmds := []*cobra.Command{
 		addon.Cmd, admin.Cmd, cluster.Cmd, iamserviceaccount.Cmd, service.Cmd,
 		installation.Cmd, upgrade.Cmd, tuningconfigs.Cmd,
@@ -57,6 +60,7 @@ func init() {
 		autoscaler.NewDescribeAutoscalerCommand(), ingressCommand,
 		externalauthprovider.Cmd, breakglasscredential.Cmd,
 		accessrequestCommand, logforwarders.NewDescribeLogForwarderCommand(),
+		networkTemplateCommand, network.NewDescribeNetworkCommand(),
 	}
 	for _, cmd := range cmds {
 		Cmd.AddCommand(cmd)
@@ -72,7 +76,7 @@ func init() {
 		admin.Cmd, breakglasscredential.Cmd,
 		externalauthprovider.Cmd, installation.Cmd,
 		kubeletconfig, upgrade.Cmd, ingressCommand,
-		accessrequestCommand,
+		accessrequestCommand, networkTemplateCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -19,9 +19,12 @@ package installation
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	asv1 "github.com/openshift-online/ocm-sdk-go/addonsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -31,7 +34,9 @@ var Cmd = &cobra.Command{
 	Use:     "addon-installation clusterID AddonInstallationID",
 	Aliases: []string{"add-on-installation"},
 	Short:   "Show details of an add-on installation",
-	Long:    "Show details of an add-on installation",
+	Long: "Show details of an add-on installation: its state and status conditions, the installed " +
+		"version and the versions it can be upgraded to, the operator roles created for the add-on and " +
+		"the values of its parameters.",
 	Example: `  # Describe the 'bar' add-on installation on cluster 'foo'
   rosa describe addon-installation --cluster foo --addon bar`,
 	Run:  run,
@@ -101,6 +106,63 @@ func describeAddonInstallation(r *rosa.Runtime, installationKey string) error {
 		"Href:", installation.HREF(),
 		"Addon state:", installation.State(),
 	)
+	if installation.StateDescription() != "" {
+		fmt.Printf("%-28s %s\n", "State description:", installation.StateDescription())
+	}
+
+	// The installation only links to its version, the available upgrades are part of the version
+	versionID := installation.AddonVersion().ID()
+	if versionID != "" {
+		fmt.Printf("%-28s %s\n", "Version:", versionID)
+		if desired := installation.DesiredVersion(); desired != "" && desired != versionID {
+			fmt.Printf("%-28s %s\n", "Desired version:", desired)
+		}
+		version, err := r.OCMClient.GetAddOnVersion(installation.Addon().ID(), versionID)
+		if err != nil {
+			return fmt.Errorf("failed to get version '%s': %v", versionID, err)
+		}
+		upgrades := "None"
+		if len(version.AvailableUpgrades()) > 0 {
+			upgrades = strings.Join(version.AvailableUpgrades(), ", ")
+		}
+		fmt.Printf("%-28s %s\n", "Available upgrades:", upgrades)
+	}
+
+	if !installation.CreationTimestamp().IsZero() {
+		fmt.Printf("%-28s %s\n", "Created:", installation.CreationTimestamp().Format("Jan _2 2006 15:04:05 MST"))
+	}
+	if !installation.UpdatedTimestamp().IsZero() {
+		fmt.Printf("%-28s %s\n", "Updated:", installation.UpdatedTimestamp().Format("Jan _2 2006 15:04:05 MST"))
+	}
+
+	status, err := r.OCMClient.GetAddOnStatus(cluster.ID(), installation.ID())
+	if err != nil && errors.GetType(err) != errors.NotFound {
+		return fmt.Errorf("failed to get status: %v", err)
+	}
+	if status != nil && len(status.StatusConditions()) > 0 {
+		fmt.Println("Status conditions:")
+		for _, condition := range status.StatusConditions() {
+			fmt.Printf("\t%-28s %s", condition.StatusType()+":", condition.StatusValue())
+			if condition.Reason() != "" {
+				fmt.Printf(" (%s)", condition.Reason())
+			}
+			if condition.Message() != "" {
+				fmt.Printf(": %s", condition.Message())
+			}
+			fmt.Println()
+		}
+	}
+
+	operatorRoles, err := addonOperatorRoles(r, cluster, installation)
+	if err != nil {
+		return err
+	}
+	if len(operatorRoles) > 0 {
+		fmt.Println("Operator IAM Roles:")
+		for _, operatorRole := range operatorRoles {
+			fmt.Printf("\t- %s\n", operatorRole.RoleARN())
+		}
+	}
 
 	parameters := installation.Parameters()
 	if parameters.Len() > 0 {
@@ -113,3 +175,25 @@ func describeAddonInstallation(r *rosa.Runtime, installationKey string) error {
 
 	return nil
 }
+
+// addonOperatorRoles returns the operator roles of the cluster that were added for the credentials requests of
+// the add-on when it was installed
+func addonOperatorRoles(r *rosa.Runtime, cluster *cmv1.Cluster,
+	installation *asv1.AddonInstallation) ([]*cmv1.OperatorIAMRole, error) {
+	if cluster.AWS().STS().RoleARN() == "" {
+		return nil, nil
+	}
+	addOn, err := r.OCMClient.GetAddOn(installation.Addon().ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get add-on '%s': %v", installation.Addon().ID(), err)
+	}
+	operatorRoles := []*cmv1.OperatorIAMRole{}
+	for _, cr := range addOn.CredentialsRequests() {
+		for _, operatorRole := range cluster.AWS().STS().OperatorIAMRoles() {
+			if operatorRole.Namespace() == cr.Namespace() && operatorRole.Name() == cr.Name() {
+				operatorRoles = append(operatorRoles, operatorRole)
+			}
+		}
+	}
+	return operatorRoles, nil
+}
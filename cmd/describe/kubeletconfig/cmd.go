@@ -30,9 +30,10 @@ import (
 )
 
 const (
-	use     = "kubeletconfig"
-	short   = "Show details of a kubeletconfig for a cluster"
-	long    = short
+	use   = "kubeletconfig"
+	short = "Show details of a kubeletconfig for a cluster"
+	long  = "Show details of a kubeletconfig for a cluster, the machine pools that use it and how an edit " +
+		"of the kubeletconfig rolls out to their nodes"
 	example = `  # Describe the custom kubeletconfig for ROSA Classic cluster 'foo'
   rosa describe kubeletconfig --cluster foo
   # Describe the custom kubeletconfig named 'bar' for cluster 'foo'
@@ -113,7 +114,12 @@ func DescribeKubeletConfigRunner(options *KubeletConfigOptions) rosa.CommandRunn
 			fmt.Print(PrintKubeletConfigForHcp(kubeletconfig, nodePools))
 
 		} else {
-			fmt.Print(PrintKubeletConfigForClassic(kubeletconfig))
+			// The KubeletConfig of a Classic cluster applies to all of its machine pools
+			machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+			if err != nil {
+				return err
+			}
+			fmt.Print(PrintKubeletConfigForClassic(kubeletconfig) + PrintMachinePoolsForClassic(machinePools))
 		}
 		return nil
 	}
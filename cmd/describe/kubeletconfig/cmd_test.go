@@ -279,16 +279,24 @@ var _ = Describe("Describe KubeletConfig", func() {
 					http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
 			t.ApiServer.AppendHandlers(
 				RespondWithJSON(http.StatusOK, FormatResource(config)))
+			machinePool, err := cmv1.NewMachinePool().ID("worker").Replicas(2).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{machinePool})))
 			t.SetCluster("cluster", cluster)
 
 			runner := DescribeKubeletConfigRunner(NewKubeletConfigOptions())
 			t.StdOutReader.Record()
 
-			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			err = runner(context.Background(), t.RosaRuntime, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			stdOut, _ := t.StdOutReader.Read()
-			Expect(stdOut).To(Equal(PrintKubeletConfigForClassic(config)))
+			Expect(stdOut).To(Equal(PrintKubeletConfigForClassic(config) +
+				"MachinePools Using This KubeletConfig:\n" +
+				" - worker\n" +
+				"Editing This KubeletConfig Will:\n" +
+				" - Reboot the 2 nodes of machine pool 'worker'\n"))
 		})
 
 		It("Prints the KubeletConfig for HCP", func() {
@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// describeMachinePoolCapacity shows the desired and actual size of a machine pool, its distribution across
+// availability zones and its status. Node pools spread across subnets are described together.
+func describeMachinePoolCapacity(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string,
+	machinePoolId string) error {
+	var capacity *machinepool.Capacity
+	var err error
+	if cluster.Hypershift().Enabled() {
+		r.Reporter.Debugf("Fetching node pool '%s' for cluster '%s'", machinePoolId, clusterKey)
+		capacity, err = machinepool.GetNodePoolCapacity(r.OCMClient, cluster, clusterKey, machinePoolId)
+	} else {
+		// Classic machine pools don't report their nodes, their EC2 instances are counted instead
+		if r.AWSClient == nil {
+			r.WithAWS()
+		}
+		r.Reporter.Debugf("Fetching machine pool '%s' for cluster '%s'", machinePoolId, clusterKey)
+		capacity, err = machinepool.GetMachinePoolCapacity(r.OCMClient, r.AWSClient, cluster, machinePoolId)
+	}
+	if err != nil {
+		return err
+	}
+
+	if output.HasFlag() {
+		return output.Print(capacity)
+	}
+	fmt.Print(capacityOutput(capacity))
+	return nil
+}
+
+var capacityOutputString = "\n" +
+	"ID:                                    %s\n" +
+	"Cluster ID:                            %s\n" +
+	"Autoscaling:                           %s\n" +
+	"Desired replicas:                      %s\n" +
+	"Current replicas:                      %d\n" +
+	"Availability zones:                    %s\n"
+
+func capacityOutput(capacity *machinepool.Capacity) string {
+	autoscaling := "No"
+	if capacity.Autoscaling {
+		autoscaling = "Yes"
+	}
+	zones := ""
+	if len(capacity.Zones) == 0 {
+		zones = "-"
+	}
+	for _, zone := range capacity.Zones {
+		line := fmt.Sprintf("%d", zone.Current)
+		if zone.Desired != "" {
+			line = fmt.Sprintf("%d/%s", zone.Current, zone.Desired)
+		}
+		name := zone.AvailabilityZone
+		if zone.Subnet != "" {
+			name = fmt.Sprintf("%s (%s)", name, zone.Subnet)
+		}
+		zones += fmt.Sprintf("\n  - %-35s%s", name+":", line)
+	}
+	result := fmt.Sprintf(capacityOutputString,
+		capacity.ID,
+		capacity.ClusterID,
+		autoscaling,
+		capacity.DesiredReplicas(),
+		capacity.CurrentReplicas,
+		zones,
+	)
+	if capacity.Spot {
+		interruptions := "unknown"
+		if capacity.SpotInterruptions != nil {
+			interruptions = fmt.Sprintf("%d", *capacity.SpotInterruptions)
+		}
+		result += fmt.Sprintf("Spot interruptions (last hour):        %s\n", interruptions)
+	}
+	if capacity.State != "" {
+		result += fmt.Sprintf("State:                                 %s\n", capacity.State)
+	}
+	if capacity.Message != "" {
+		result += fmt.Sprintf("Message:                               %s\n", capacity.Message)
+	}
+	if capacity.ScheduledUpgrade != "" {
+		result += fmt.Sprintf("Scheduled upgrade:                     %s\n", capacity.ScheduledUpgrade)
+	}
+	return result
+}
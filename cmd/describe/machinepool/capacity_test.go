@@ -0,0 +1,47 @@
+package machinepool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/machinepool"
+)
+
+var _ = Describe("Machine pool capacity output", func() {
+	It("Shows the nodes of each subnet of a spread group", func() {
+		out := capacityOutput(&machinepool.Capacity{
+			ID:              "workers",
+			ClusterID:       "cluster-1",
+			Replicas:        3,
+			CurrentReplicas: 2,
+			Zones: []machinepool.ZoneCapacity{
+				{AvailabilityZone: "us-east-1a", Subnet: "subnet-workers-a", Current: 2, Desired: "2"},
+				{AvailabilityZone: "us-east-1b", Subnet: "subnet-workers-b", Current: 0, Desired: "1"},
+			},
+			State:   "ready",
+			Message: "workers-b: Waiting for capacity",
+		})
+		Expect(out).To(ContainSubstring("Desired replicas:                      3\n"))
+		Expect(out).To(ContainSubstring("  - us-east-1b (subnet-workers-b):     0/1"))
+		Expect(out).To(ContainSubstring("Message:                               workers-b: Waiting for capacity\n"))
+		Expect(out).NotTo(ContainSubstring("Spot interruptions"))
+	})
+
+	It("Shows the spot interruptions of a classic machine pool", func() {
+		interruptions := 2
+		out := capacityOutput(&machinepool.Capacity{
+			ID:              "workers",
+			ClusterID:       "cluster-1",
+			Replicas:        5,
+			CurrentReplicas: 3,
+			Zones: []machinepool.ZoneCapacity{
+				{AvailabilityZone: "us-east-1c", Current: 0, Desired: "1"},
+			},
+			Spot:              true,
+			SpotInterruptions: &interruptions,
+		})
+		Expect(out).To(ContainSubstring("Current replicas:                      3\n"))
+		Expect(out).To(ContainSubstring("  - us-east-1c:                        0/1"))
+		Expect(out).To(ContainSubstring("Spot interruptions (last hour):        2\n"))
+	})
+})
@@ -35,7 +35,10 @@ const (
 	short   = "Show details of a machine pool on a cluster"
 	long    = "Show details of a machine pool on a cluster."
 	example = `  # Show details of a machine pool named "mymachinepool" on a cluster named "mycluster"
-  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool`
+  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool
+
+  # Show the desired and current replicas of each availability zone of a machine pool
+  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool --capacity`
 )
 
 func NewDescribeMachinePoolCommand() *cobra.Command {
@@ -57,6 +60,13 @@ func NewDescribeMachinePoolCommand() *cobra.Command {
 		"",
 		"Machine pool of the cluster to target",
 	)
+	flags.BoolVar(
+		&options.capacity,
+		"capacity",
+		false,
+		"Show the desired and current replicas per availability zone, the status and the scheduled upgrade "+
+			"of the machine pool instead of its configuration",
+	)
 
 	output.AddFlag(cmd)
 	ocm.AddClusterFlag(cmd)
@@ -80,6 +90,9 @@ func DescribeMachinePoolRunner(userOptions *DescribeMachinepoolUserOptions) rosa
 
 		service := machinepool.NewMachinePoolService()
 
+		if options.Capacity() {
+			return describeMachinePoolCapacity(runtime, cluster, clusterKey, options.Machinepool())
+		}
 		return service.DescribeMachinePool(runtime, cluster, clusterKey, options.Machinepool())
 	}
 }
@@ -486,6 +486,7 @@ var _ = Describe("Upgrade machine pool", func() {
 			It("Pass a machine pool name through parameter and it is found", func() {
 				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, classicClusterReady))
 				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, mpResponse))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{})))
 				args := NewDescribeMachinepoolUserOptions()
 				args.machinepool = nodePoolName
 				runner := DescribeMachinePoolRunner(args)
@@ -8,6 +8,7 @@ import (
 
 type DescribeMachinepoolUserOptions struct {
 	machinepool string
+	capacity    bool
 }
 
 type DescribeMachinepoolOptions struct {
@@ -31,6 +32,10 @@ func (m *DescribeMachinepoolOptions) Machinepool() string {
 	return m.args.machinepool
 }
 
+func (m *DescribeMachinepoolOptions) Capacity() bool {
+	return m.args.capacity
+}
+
 func (m *DescribeMachinepoolOptions) Bind(args *DescribeMachinepoolUserOptions, argv []string) error {
 	m.args = args
 	if m.args.machinepool == "" {
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "network"
+	short = "Show details of a network stack"
+	long  = "Show the VPC, subnets and gateways created by a 'rosa create network' stack. The subnet IDs " +
+		"can be passed to 'rosa create cluster --subnet-ids'."
+	example = `  # Describe the network stack 'my-network'
+  rosa describe network my-network
+
+  # Create a cluster in the subnets of the network stack 'my-network'
+  rosa create cluster --cluster-name mycluster --subnet-ids $(rosa describe network my-network --subnet-ids-only)
+
+  # Create a private cluster in the private subnets of the network stack 'my-network'
+  rosa create cluster --cluster-name mycluster --private \
+    --subnet-ids $(rosa describe network my-network --subnet-ids-only --private-only)`
+
+	subnetIDsOnlyFlag = "subnet-ids-only"
+	privateOnlyFlag   = "private-only"
+)
+
+var aliases = []string{"networks"}
+
+type DescribeNetworkOptions struct {
+	SubnetIDsOnly bool
+	PrivateOnly   bool
+}
+
+func NewDescribeNetworkCommand() *cobra.Command {
+	options := &DescribeNetworkOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.ExactArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithAWS(), DescribeNetworkRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(
+		&options.SubnetIDsOnly,
+		subnetIDsOnlyFlag,
+		false,
+		"Print only the comma separated subnet IDs, in the format expected by 'rosa create cluster --subnet-ids'.",
+	)
+	flags.BoolVar(
+		&options.PrivateOnly,
+		privateOnlyFlag,
+		false,
+		"Only include the private subnets, as needed by private clusters.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeNetworkRunner(options *DescribeNetworkOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		if options.SubnetIDsOnly && output.HasFlag() {
+			return fmt.Errorf("Option '--%s' can't be used together with '--output'", subnetIDsOnlyFlag)
+		}
+		stack, err := network.GetStack(ctx, r.AWSClient, argv[0])
+		if err != nil {
+			return fmt.Errorf("Failed to describe network stack: %v", err)
+		}
+		subnetIDs := strings.Join(stack.SubnetIDs(options.PrivateOnly), ",")
+
+		if options.SubnetIDsOnly {
+			fmt.Println(subnetIDs)
+			return nil
+		}
+		if output.HasFlag() {
+			return output.Print(stack)
+		}
+
+		fmt.Printf("%-28s%s\n", "Name:", stack.Name)
+		fmt.Printf("%-28s%s\n", "Status:", stack.Status)
+		fmt.Printf("%-28s%s\n", "Template:", stack.Template)
+		fmt.Printf("%-28s%s\n", "Region:", r.AWSClient.GetRegion())
+		fmt.Printf("%-28s%s\n", "Created:", stack.CreationTime.Format(time.RFC3339))
+		fmt.Printf("%-28s%s\n", "VPC ID:", stack.VpcID)
+		if stack.InternetGateway != "" {
+			fmt.Printf("%-28s%s\n", "Internet gateway:", stack.InternetGateway)
+		}
+		if len(stack.NatGateways) > 0 {
+			fmt.Printf("%-28s%s\n", "NAT gateways:", strings.Join(stack.NatGateways, ", "))
+		}
+		if stack.TransitGatewayAttachment != "" {
+			fmt.Printf("%-28s%s\n", "Transit gateway attachment:", stack.TransitGatewayAttachment)
+		}
+		if len(stack.Subnets) == 0 {
+			fmt.Printf("%-28s%s\n", "Subnets:", "none")
+			return nil
+		}
+
+		fmt.Printf("\nSubnets:\n")
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "AVAILABILITY ZONE\tVISIBILITY\tID\tCIDR\n")
+		for _, subnet := range stack.Subnets {
+			if options.PrivateOnly && subnet.Public {
+				continue
+			}
+			visibility := "private"
+			if subnet.Public {
+				visibility = "public"
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", subnet.AvailabilityZone, visibility, subnet.ID, subnet.CidrBlock)
+		}
+		err = writer.Flush()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\nTo create a cluster in this network run:\n  rosa create cluster --subnet-ids %s\n", subnetIDs)
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package network
+
+import (
+	"context"
+
+	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Describe network", func() {
+	It("Creates the command correctly", func() {
+		cmd := NewDescribeNetworkCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup(subnetIDsOnlyFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(privateOnlyFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("Command Runner", func() {
+		var (
+			t         *TestingRuntime
+			awsClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			output.SetOutput("")
+
+			stack := cfTypes.Stack{
+				StackName:   awsSdk.String("my-network"),
+				StackStatus: cfTypes.StackStatusCreateComplete,
+				Tags: []cfTypes.Tag{
+					{Key: awsSdk.String(network.TemplateTagKey), Value: awsSdk.String(network.DefaultTemplateName)},
+				},
+			}
+			subnets := []ec2types.Subnet{
+				{SubnetId: awsSdk.String("subnet-public"), AvailabilityZone: awsSdk.String("us-east-1a"),
+					CidrBlock: awsSdk.String("10.0.0.0/24")},
+				{SubnetId: awsSdk.String("subnet-private"), AvailabilityZone: awsSdk.String("us-east-1a"),
+					CidrBlock: awsSdk.String("10.0.1.0/24")},
+			}
+			awsClient.EXPECT().GetCFStack(gomock.Any(), "my-network").Return(&stack, nil)
+			awsClient.EXPECT().DescribeCFStackResources(gomock.Any(), "my-network").Return(&[]cfTypes.StackResource{
+				{ResourceType: awsSdk.String("AWS::EC2::VPC"), PhysicalResourceId: awsSdk.String("vpc-1")},
+				{ResourceType: awsSdk.String("AWS::EC2::Subnet"), PhysicalResourceId: awsSdk.String("subnet-public")},
+				{ResourceType: awsSdk.String("AWS::EC2::Subnet"), PhysicalResourceId: awsSdk.String("subnet-private")},
+				{ResourceType: awsSdk.String("AWS::EC2::NatGateway"), PhysicalResourceId: awsSdk.String("nat-1")},
+			}, nil)
+			awsClient.EXPECT().ListSubnets("subnet-public", "subnet-private").Return(subnets, nil)
+			awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{"subnet-public": true}, nil)
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Describes the resources of the stack", func() {
+			awsClient.EXPECT().GetRegion().Return("us-east-1")
+
+			t.StdOutReader.Record()
+			err := DescribeNetworkRunner(&DescribeNetworkOptions{})(context.Background(), t.RosaRuntime, nil,
+				[]string{"my-network"})
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("VPC ID:                     vpc-1\n"))
+			Expect(stdOut).To(ContainSubstring("NAT gateways:               nat-1\n"))
+			Expect(stdOut).To(ContainSubstring(
+				"AVAILABILITY ZONE  VISIBILITY  ID              CIDR\n" +
+					"us-east-1a         public      subnet-public   10.0.0.0/24\n" +
+					"us-east-1a         private     subnet-private  10.0.1.0/24\n"))
+			Expect(stdOut).To(ContainSubstring("rosa create cluster --subnet-ids subnet-public,subnet-private\n"))
+		})
+
+		It("Prints only the private subnet IDs", func() {
+			t.StdOutReader.Record()
+			err := DescribeNetworkRunner(&DescribeNetworkOptions{SubnetIDsOnly: true, PrivateOnly: true})(
+				context.Background(), t.RosaRuntime, nil, []string{"my-network"})
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("subnet-private\n"))
+		})
+	})
+})
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networktemplate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/network"
+	opts "github.com/openshift/rosa/pkg/options/network"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "network-template"
+	short = "Show details of a network template"
+	long  = "Show the description and the parameters of a CloudFormation template used by " +
+		"'rosa create network', including their types, default values and constraints."
+	example = `  # Describe the built-in template for private clusters
+  rosa describe network-template rosa-quickstart-private-vpc
+
+  # Describe a custom template
+  rosa describe network-template my-vpc --template-dir ./templates`
+
+	templateDirFlag = "template-dir"
+)
+
+var aliases = []string{"networktemplate"}
+
+func NewDescribeNetworkTemplateCommand() *cobra.Command {
+	options := opts.NewNetworkUserOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.ExactArgs(1),
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), DescribeNetworkTemplateRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.TemplateDir,
+		templateDirFlag,
+		options.TemplateDir,
+		"Directory of the custom templates, overriding the OCM_TEMPLATE_DIR environment variable.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func DescribeNetworkTemplateRunner(options *opts.NetworkUserOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		template, err := network.GetTemplate(options.CustomTemplateDir(), argv[0])
+		if err != nil {
+			return fmt.Errorf("Failed to get network template: %v", err)
+		}
+
+		if output.HasFlag() {
+			return output.Print(template)
+		}
+
+		fmt.Printf("%-14s%s\n", "Name:", template.Name)
+		fmt.Printf("%-14s%s\n", "Source:", template.Source)
+		if template.Path != "" {
+			fmt.Printf("%-14s%s\n", "Path:", template.Path)
+		}
+		fmt.Printf("%-14s%s\n", "Description:", strings.Join(strings.Fields(template.Description), " "))
+		fmt.Printf("\nParameters:\n")
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "NAME\tTYPE\tDEFAULT\tALLOWED\tDESCRIPTION\n")
+		for _, parameter := range template.Parameters {
+			defaultValue := "<required>"
+			if parameter.Default != nil {
+				defaultValue = fmt.Sprintf("%q", *parameter.Default)
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", parameter.Name, parameter.Type, defaultValue,
+				constraints(parameter), parameter.Description)
+		}
+		err = writer.Flush()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\nStack tags can be set with '--param Tags=key1=value1,key2=value2'.\n")
+		fmt.Printf("Create the network with 'rosa create network %s", template.Name)
+		for _, parameter := range template.Parameters {
+			if parameter.Default == nil {
+				fmt.Printf(" --param %s=<value>", parameter.Name)
+			}
+		}
+		if template.Source == network.TemplateSourceCustom {
+			fmt.Printf(" --template-dir %s", options.CustomTemplateDir())
+		}
+		fmt.Printf("'.\n")
+		return nil
+	}
+}
+
+// constraints summarizes the values accepted by a parameter
+func constraints(parameter network.TemplateParameter) string {
+	values := []string{}
+	if len(parameter.AllowedValues) > 0 {
+		values = append(values, strings.Join(parameter.AllowedValues, "|"))
+	}
+	if parameter.MinValue != nil || parameter.MaxValue != nil {
+		values = append(values, fmt.Sprintf("%s..%s", formatLimit(parameter.MinValue), formatLimit(parameter.MaxValue)))
+	}
+	if parameter.MinLength != nil || parameter.MaxLength != nil {
+		values = append(values, fmt.Sprintf("length %s..%s", formatIntLimit(parameter.MinLength),
+			formatIntLimit(parameter.MaxLength)))
+	}
+	if parameter.ConstraintDescription != "" {
+		values = append(values, parameter.ConstraintDescription)
+	} else if parameter.AllowedPattern != "" {
+		values = append(values, fmt.Sprintf("pattern %s", parameter.AllowedPattern))
+	}
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
+
+func formatLimit(limit *float64) string {
+	if limit == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", *limit)
+}
+
+func formatIntLimit(limit *int) string {
+	if limit == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d", *limit)
+}
@@ -0,0 +1,73 @@
+package networktemplate
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/network"
+	opts "github.com/openshift/rosa/pkg/options/network"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Describe network template", func() {
+	Context("Create Command", func() {
+		It("Creates the command correctly", func() {
+			cmd := NewDescribeNetworkTemplateCommand()
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Args).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup(templateDirFlag)).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Command Runner", func() {
+		var t *TestingRuntime
+		var options *opts.NetworkUserOptions
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			options = &opts.NetworkUserOptions{TemplateDir: opts.DefaultTemplateDir}
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Describes the parameters of a built-in template", func() {
+			t.StdOutReader.Record()
+			err := DescribeNetworkTemplateRunner(options)(context.Background(), t.RosaRuntime, nil,
+				[]string{network.TransitGatewayTemplateName})
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("Name:         rosa-quickstart-tgw-egress-vpc\n"))
+			Expect(stdOut).To(ContainSubstring("Source:       built-in\n"))
+			Expect(stdOut).To(MatchRegexp(`AvailabilityZoneCount\s+Number\s+"1"\s+1\.\.4\s+Number of Availability Zones`))
+			Expect(stdOut).To(MatchRegexp(`TransitGatewayId\s+String\s+<required>\s+must be the ID`))
+			Expect(stdOut).To(ContainSubstring("Create the network with 'rosa create network " +
+				"rosa-quickstart-tgw-egress-vpc --param Name=<value> --param TransitGatewayId=<value>'."))
+		})
+
+		It("Prints the template as JSON", func() {
+			output.SetOutput("json")
+			t.StdOutReader.Record()
+			err := DescribeNetworkTemplateRunner(options)(context.Background(), t.RosaRuntime, nil,
+				[]string{network.PrivateTemplateName})
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring(`"name": "rosa-quickstart-private-vpc"`))
+			Expect(stdOut).To(ContainSubstring(`"allowedPattern"`))
+		})
+
+		It("Fails for an unknown template", func() {
+			err := DescribeNetworkTemplateRunner(options)(context.Background(), t.RosaRuntime, nil,
+				[]string{"my-vpc"})
+			Expect(err).To(MatchError("Failed to get network template: template 'my-vpc' is not a built-in " +
+				"template, use `--template-dir` to specify the directory of custom templates"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package networktemplate
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDescribeNetworkTemplate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Describe Network Template Suite")
+}
@@ -20,6 +20,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -33,7 +35,7 @@ var Cmd = &cobra.Command{
 	Use:     "tuning-configs",
 	Aliases: []string{"tuningconfig", "tuningconfigs", "tuning-config"},
 	Short:   "Show details of tuning config",
-	Long:    "Show details of a tuning config for a cluster.",
+	Long:    "Show details of a tuning config for a cluster, including the node pools that use it.",
 	Example: `  # Describe the 'tuned1' tuned config on cluster 'foo'
   rosa describe tuning-config --cluster foo tuned1`,
 	Run: run,
@@ -87,13 +89,28 @@ func run(_ *cobra.Command, argv []string) {
 		os.Exit(1)
 	}
 
+	// Find the node pools that use the tuning config
+	r.Reporter.Debugf("Loading node pools for cluster '%s'", clusterKey)
+	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+		os.Exit(1)
+	}
+	nodePoolIDs := []string{}
+	for _, nodePool := range nodePools {
+		if slices.Contains(nodePool.TuningConfigs(), tuningConfig.Name()) {
+			nodePoolIDs = append(nodePoolIDs, nodePool.ID())
+		}
+	}
+
 	r.Reporter.Debugf("Describing tuning config '%s' on cluster '%s'", tuningConfig.Name(), clusterKey)
 	// Prepare string
 	tuningConfigOutput := fmt.Sprintf("\n"+
 		"Name:                       %s\n"+
 		"ID:                         %s\n"+
+		"Node pools:                 %s\n"+
 		"Spec:                       %s\n",
-		tuningConfig.Name(), tuningConfig.ID(), tuningConfigSpec,
+		tuningConfig.Name(), tuningConfig.ID(), strings.Join(nodePoolIDs, ", "), tuningConfigSpec,
 	)
 	fmt.Print(tuningConfigOutput)
 }
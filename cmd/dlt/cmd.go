@@ -32,10 +32,12 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/kubeletconfig"
 	"github.com/openshift/rosa/cmd/dlt/logforwarder"
 	"github.com/openshift/rosa/cmd/dlt/machinepool"
+	"github.com/openshift/rosa/cmd/dlt/network"
 	"github.com/openshift/rosa/cmd/dlt/ocmrole"
 	"github.com/openshift/rosa/cmd/dlt/oidcconfig"
 	"github.com/openshift/rosa/cmd/dlt/oidcprovider"
 	"github.com/openshift/rosa/cmd/dlt/operatorrole"
+	"github.com/openshift/rosa/cmd/dlt/orphans"
 	"github.com/openshift/rosa/cmd/dlt/service"
 	"github.com/openshift/rosa/cmd/dlt/tuningconfigs"
 	"github.com/openshift/rosa/cmd/dlt/upgrade"
@@ -79,6 +81,9 @@ func init() {
 	logForwarderCommand := logforwarder.NewDeleteLogForwarderCommand()
 	Cmd.AddCommand(logForwarderCommand)
 	Cmd.AddCommand(externalauthprovider.Cmd)
+	orphansCommand := orphans.NewDeleteOrphansCommand()
+	Cmd.AddCommand(orphansCommand)
+	Cmd.AddCommand(network.NewDeleteNetworkCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
@@ -92,6 +97,7 @@ func init() {
 		service.Cmd, autoscalerCommand, iamserviceaccount.Cmd, idp.Cmd,
 		imageMirrorCommand, cluster.Cmd, dnsdomains.Cmd, externalauthprovider.Cmd,
 		kubeletconfig, machinepoolCommand, tuningconfigs.Cmd, logForwarderCommand,
+		orphansCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
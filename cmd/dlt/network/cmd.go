@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "network"
+	short = "Delete a network stack"
+	long  = "Delete a CloudFormation stack created by 'rosa create network', along with the VPC, subnets " +
+		"and gateways it created. The stack is only deleted when no cluster uses its subnets."
+	example = `  # Delete the network stack 'my-network'
+  rosa delete network my-network
+
+  # Print the AWS command that deletes the network stack 'my-network'
+  rosa delete network my-network --mode manual`
+)
+
+var aliases = []string{"networks"}
+
+func NewDeleteNetworkCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.ExactArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), DeleteNetworkRunner()),
+	}
+
+	interactive.AddModeFlag(cmd)
+	return cmd
+}
+
+func DeleteNetworkRunner() rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, argv []string) error {
+		stackName := argv[0]
+		mode, err := interactive.GetMode()
+		if err != nil {
+			return err
+		}
+
+		stack, err := network.GetStack(ctx, r.AWSClient, stackName)
+		if err != nil {
+			return fmt.Errorf("Failed to get network stack: %v", err)
+		}
+		clusters, err := r.OCMClient.GetClusters(r.Creator, 0)
+		if err != nil {
+			return fmt.Errorf("Failed to get clusters: %v", err)
+		}
+		users := network.ClustersUsingSubnets(clusters, stack.SubnetIDs(false))
+		if len(users) > 0 {
+			names := []string{}
+			for _, cluster := range users {
+				names = append(names, cluster.Name())
+			}
+			return fmt.Errorf("Network stack '%s' can't be deleted, its subnets are used by clusters: %s",
+				stackName, strings.Join(names, ", "))
+		}
+
+		if mode == "" {
+			mode, err = interactive.GetOptionMode(command, interactive.ModeAuto, "Network stack deletion mode")
+			if err != nil {
+				return fmt.Errorf("Expected a valid deletion mode: %v", err)
+			}
+		}
+		switch mode {
+		case interactive.ModeAuto:
+			r.OCMClient.LogEvent("ROSADeleteNetworkModeAuto", nil)
+			if !confirm.Prompt(true, "Delete network stack '%s' and VPC '%s'?", stackName, stack.VpcID) {
+				return nil
+			}
+			err = r.AWSClient.DeleteCFStack(ctx, stackName)
+			if err != nil {
+				return fmt.Errorf("Failed to delete network stack '%s': %v", stackName, err)
+			}
+			r.Reporter.Infof("Network stack '%s' is being deleted, run 'rosa list networks' to follow "+
+				"the deletion", stackName)
+		case interactive.ModeManual:
+			r.OCMClient.LogEvent("ROSADeleteNetworkModeManual", nil)
+			if r.Reporter.IsTerminal() {
+				r.Reporter.Infof("Run the following command to delete the network stack:\n")
+			}
+			fmt.Printf("aws cloudformation delete-stack --stack-name %s --region %s\n",
+				stackName, r.AWSClient.GetRegion())
+		default:
+			return fmt.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
+		}
+		return nil
+	}
+}
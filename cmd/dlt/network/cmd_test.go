@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+	"net/http"
+
+	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/pflag"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/network"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Delete network", func() {
+	It("Creates the command correctly", func() {
+		cmd := NewDeleteNetworkCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Flags().Lookup("mode")).NotTo(BeNil())
+	})
+
+	Context("Command Runner", func() {
+		var (
+			t         *TestingRuntime
+			awsClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			interactive.SetModeKey("")
+
+			stack := cfTypes.Stack{
+				StackName: awsSdk.String("my-network"),
+				Tags: []cfTypes.Tag{
+					{Key: awsSdk.String(network.TemplateTagKey), Value: awsSdk.String(network.DefaultTemplateName)},
+				},
+			}
+			subnets := []ec2types.Subnet{{SubnetId: awsSdk.String("subnet-1")}}
+			awsClient.EXPECT().GetCFStack(gomock.Any(), "my-network").Return(&stack, nil)
+			awsClient.EXPECT().DescribeCFStackResources(gomock.Any(), "my-network").Return(&[]cfTypes.StackResource{
+				{ResourceType: awsSdk.String("AWS::EC2::VPC"), PhysicalResourceId: awsSdk.String("vpc-1")},
+				{ResourceType: awsSdk.String("AWS::EC2::Subnet"), PhysicalResourceId: awsSdk.String("subnet-1")},
+			}, nil)
+			awsClient.EXPECT().ListSubnets("subnet-1").Return(subnets, nil)
+			awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{}, nil)
+		})
+
+		AfterEach(func() {
+			interactive.SetModeKey("")
+		})
+
+		It("Refuses to delete a stack whose subnets are used by a cluster", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Name("my-cluster")
+				c.AWS(cmv1.NewAWS().SubnetIDs("subnet-1"))
+			})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			awsClient.EXPECT().DeleteCFStack(gomock.Any(), gomock.Any()).Times(0)
+
+			cmd := NewDeleteNetworkCommand()
+			interactive.SetModeKey(interactive.ModeAuto)
+			err := DeleteNetworkRunner()(context.Background(), t.RosaRuntime, cmd, []string{"my-network"})
+			Expect(err).To(MatchError("Network stack 'my-network' can't be deleted, its subnets are used by " +
+				"clusters: my-cluster"))
+		})
+
+		It("Prints the delete command in manual mode", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+			awsClient.EXPECT().GetRegion().Return("us-east-1")
+
+			cmd := NewDeleteNetworkCommand()
+			interactive.SetModeKey(interactive.ModeManual)
+			t.StdOutReader.Record()
+			err := DeleteNetworkRunner()(context.Background(), t.RosaRuntime, cmd, []string{"my-network"})
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("aws cloudformation delete-stack --stack-name my-network --region us-east-1\n"))
+		})
+
+		It("Deletes the stack in auto mode", func() {
+			flags := pflag.NewFlagSet("confirm", pflag.ContinueOnError)
+			confirm.AddFlag(flags)
+			Expect(flags.Parse([]string{"--yes"})).To(Succeed())
+			DeferCleanup(func() {
+				Expect(flags.Parse([]string{"--yes=false"})).To(Succeed())
+			})
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+			awsClient.EXPECT().DeleteCFStack(gomock.Any(), "my-network").Return(nil)
+
+			cmd := NewDeleteNetworkCommand()
+			interactive.SetModeKey(interactive.ModeAuto)
+			t.StdOutReader.Record()
+			err := DeleteNetworkRunner()(context.Background(), t.RosaRuntime, cmd, []string{"my-network"})
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("INFO: Network stack 'my-network' is being deleted"))
+		})
+	})
+})
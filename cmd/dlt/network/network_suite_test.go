@@ -0,0 +1,13 @@
+package network
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeleteNetwork(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Delete Network Suite")
+}
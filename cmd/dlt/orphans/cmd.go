@@ -0,0 +1,349 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/briandowns/spinner"
+	"github.com/spf13/cobra"
+
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/orphans"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "orphans"
+	short = "Delete orphaned operator roles and OIDC resources"
+	long  = "Finds operator roles, OIDC providers and OIDC configurations in the current AWS account " +
+		"that are no longer used by any cluster, and deletes them."
+	example = `  # List the resources that would be deleted without deleting anything
+  rosa delete orphans --dry-run
+
+  # Delete orphaned resources that have not been used for at least 30 days
+  rosa delete orphans --older-than 720h --mode auto
+
+  # Print the AWS commands needed to delete the orphaned resources
+  rosa delete orphans --mode manual`
+
+	olderThanFlag = "older-than"
+	dryRunFlag    = "dry-run"
+)
+
+var aliases = []string{"orphan", "orphaned-resources"}
+
+type DeleteOrphansOptions struct {
+	OlderThan time.Duration
+	DryRun    bool
+}
+
+func NewDeleteOrphansCommand() *cobra.Command {
+	options := &DeleteOrphansOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), DeleteOrphansRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.DurationVar(
+		&options.OlderThan,
+		olderThanFlag,
+		0,
+		"Only consider resources created (or, for OIDC configurations, last used) at least this long ago, "+
+			"for example '720h'.",
+	)
+	flags.BoolVar(
+		&options.DryRun,
+		dryRunFlag,
+		false,
+		"List the orphaned resources without deleting them.",
+	)
+	interactive.AddModeFlag(cmd)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func DeleteOrphansRunner(options *DeleteOrphansOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, command *cobra.Command, _ []string) error {
+		mode, err := interactive.GetMode()
+		if err != nil {
+			return err
+		}
+		if options.OlderThan < 0 {
+			return fmt.Errorf("Expected a positive value for '--%s'", olderThanFlag)
+		}
+		if !options.DryRun && mode == "" {
+			mode, err = interactive.GetOptionMode(command, interactive.ModeAuto, "Orphaned resources deletion mode")
+			if err != nil {
+				return fmt.Errorf("Expected a valid deletion mode: %v", err)
+			}
+		}
+
+		var spin *spinner.Spinner
+		if r.Reporter.IsTerminal() {
+			spin = spinner.New(spinner.CharSets[9], 100*time.Millisecond)
+			r.Reporter.Infof("Looking for orphaned operator roles and OIDC resources")
+			spin.Start()
+		}
+		report, err := orphans.NewFinder(r.AWSClient, r.OCMClient, r.Creator.AccountID, options.OlderThan).Find()
+		if spin != nil {
+			spin.Stop()
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to find orphaned resources: %v", err)
+		}
+		if report.IsEmpty() {
+			r.Reporter.Infof("No orphaned resources found")
+			return nil
+		}
+
+		printReport(report)
+
+		if options.DryRun {
+			r.Reporter.Infof("Dry run: no resources were deleted")
+			return nil
+		}
+
+		switch mode {
+		case interactive.ModeAuto:
+			r.OCMClient.LogEvent("ROSADeleteOrphansModeAuto", nil)
+			return deleteAuto(r, report)
+		case interactive.ModeManual:
+			r.OCMClient.LogEvent("ROSADeleteOrphansModeManual", nil)
+			commands, err := buildCommands(r, report)
+			if err != nil {
+				return err
+			}
+			if len(commands) > 0 {
+				if r.Reporter.IsTerminal() {
+					r.Reporter.Infof("Run the following commands to delete the orphaned AWS resources:\n")
+				}
+				fmt.Println(awscb.JoinCommands(commands))
+			}
+			return unregisterOidcConfigs(r, report.OidcConfigs)
+		default:
+			return fmt.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
+		}
+	}
+}
+
+func printReport(report *orphans.Result) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "TYPE\tRESOURCE\tCLUSTER ID\tSINCE\n")
+	for _, group := range report.OperatorRoles {
+		for _, role := range group.Roles {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+				"operator-role", role.RoleName, group.ClusterID, formatTime(group.CreatedAt))
+		}
+	}
+	for _, provider := range report.OidcProviders {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+			"oidc-provider", provider.Arn, provider.ClusterID, formatTime(provider.CreatedAt))
+	}
+	for _, config := range report.OidcConfigs {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+			"oidc-config", config.ID, "", formatTime(config.CreatedAt))
+	}
+	writer.Flush()
+}
+
+func formatTime(timestamp time.Time) string {
+	if timestamp.IsZero() {
+		return ""
+	}
+	return timestamp.Format(time.RFC3339)
+}
+
+func deleteAuto(r *rosa.Runtime, report *orphans.Result) error {
+	failed := false
+	for _, group := range report.OperatorRoles {
+		if !confirm.Prompt(true, "Delete the operator roles with prefix '%s'?", group.Prefix) {
+			continue
+		}
+		for _, role := range group.Roles {
+			r.Reporter.Infof("Deleting operator role '%s'", role.RoleName)
+			_, err := r.AWSClient.DeleteOperatorRole(role.RoleName, role.ManagedPolicy, false)
+			if err != nil {
+				r.Reporter.Warnf("There was an error deleting operator role '%s': %v", role.RoleName, err)
+				failed = true
+			}
+		}
+	}
+	for _, provider := range report.OidcProviders {
+		if !confirm.Prompt(true, "Delete the OIDC provider '%s'?", provider.Arn) {
+			continue
+		}
+		r.Reporter.Infof("Deleting OIDC provider '%s'", provider.Arn)
+		err := r.AWSClient.DeleteOpenIDConnectProvider(provider.Arn)
+		if err != nil {
+			r.Reporter.Warnf("There was an error deleting OIDC provider '%s': %v", provider.Arn, err)
+			failed = true
+		}
+	}
+	for _, config := range report.OidcConfigs {
+		if !confirm.Prompt(true, "Delete the OIDC configuration '%s'?", config.ID) {
+			continue
+		}
+		if !config.Managed && config.SecretArn != "" {
+			r.Reporter.Infof("Deleting OIDC configuration '%s' resources", config.BucketName)
+			err := r.AWSClient.DeleteSecretInSecretsManager(config.SecretArn)
+			if err != nil {
+				r.Reporter.Warnf("There was an error deleting private key secret '%s': %v", config.SecretArn, err)
+				failed = true
+				continue
+			}
+			err = r.AWSClient.DeleteS3Bucket(config.BucketName)
+			if err != nil {
+				r.Reporter.Warnf("There was an error deleting S3 bucket '%s': %v", config.BucketName, err)
+				failed = true
+				continue
+			}
+		}
+		err := r.OCMClient.DeleteOidcConfig(config.ID)
+		if err != nil {
+			r.Reporter.Warnf("There was an error unregistering OIDC configuration '%s': %v", config.ID, err)
+			failed = true
+		}
+	}
+	if failed {
+		return fmt.Errorf("Some orphaned resources could not be deleted")
+	}
+	r.Reporter.Infof("Successfully deleted the orphaned resources")
+	return nil
+}
+
+// unregisterOidcConfigs removes orphaned OIDC configurations from OCM, which can't be done by
+// AWS commands and is also what 'rosa delete oidc-config --mode manual' does
+func unregisterOidcConfigs(r *rosa.Runtime, configs []orphans.OidcConfig) error {
+	for _, config := range configs {
+		if !confirm.Prompt(true, "Unregister the OIDC configuration '%s' from OCM?", config.ID) {
+			continue
+		}
+		err := r.OCMClient.DeleteOidcConfig(config.ID)
+		if err != nil {
+			return fmt.Errorf("There was an error unregistering OIDC configuration '%s': %v", config.ID, err)
+		}
+		r.Reporter.Infof("Registered OIDC Config ID '%s' has been removed from OCM", config.ID)
+	}
+	return nil
+}
+
+func buildCommands(r *rosa.Runtime, report *orphans.Result) ([]string, error) {
+	commands := []string{}
+	for _, group := range report.OperatorRoles {
+		roleNames := group.RoleNames()
+		policyMap, arbitraryPolicyMap, err := r.AWSClient.GetOperatorRolePolicies(roleNames)
+		if err != nil {
+			return nil, fmt.Errorf("There was an error getting the policies of operator roles "+
+				"with prefix '%s': %v", group.Prefix, err)
+		}
+		for _, role := range group.Roles {
+			roleCommands, err := buildOperatorRoleCommands(r, role.RoleName, role.ManagedPolicy,
+				policyMap[role.RoleName], arbitraryPolicyMap[role.RoleName])
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, roleCommands...)
+		}
+	}
+	for _, provider := range report.OidcProviders {
+		commands = append(commands, awscb.NewIAMCommandBuilder().
+			SetCommand(awscb.DeleteOpenIdConnectProvider).
+			AddParam(awscb.OpenIdConnectProviderArn, provider.Arn).
+			Build())
+	}
+	for _, config := range report.OidcConfigs {
+		if config.Managed || config.SecretArn == "" {
+			continue
+		}
+		deleteSecret := awscb.NewSecretsManagerCommandBuilder().
+			SetCommand(awscb.DeleteSecret).
+			AddParam(awscb.SecretID, config.SecretArn)
+		if parsedArn, err := arn.Parse(config.SecretArn); err == nil {
+			deleteSecret.AddParam(awscb.Region, parsedArn.Region)
+		}
+		commands = append(commands, deleteSecret.Build())
+		commands = append(commands, awscb.NewS3CommandBuilder().
+			SetCommand(awscb.Remove).
+			AddValueNoParam(fmt.Sprintf("s3://%s", config.BucketName)).
+			AddParamNoValue(awscb.Recursive).
+			Build())
+		commands = append(commands, awscb.NewS3CommandBuilder().
+			SetCommand(awscb.RemoveBucket).
+			AddValueNoParam(fmt.Sprintf("s3://%s", config.BucketName)).
+			Build())
+	}
+	return commands, nil
+}
+
+func buildOperatorRoleCommands(r *rosa.Runtime, roleName string, managedPolicies bool,
+	policyARNs []string, arbitraryPolicyARNs []string) ([]string, error) {
+	commands := []string{}
+	for _, policyARN := range policyARNs {
+		commands = append(commands, awscb.NewIAMCommandBuilder().
+			SetCommand(awscb.DetachRolePolicy).
+			AddParam(awscb.RoleName, roleName).
+			AddParam(awscb.PolicyArn, policyARN).
+			Build())
+		if managedPolicies {
+			continue
+		}
+		policyVersions, err := r.AWSClient.ListPolicyVersions(policyARN)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list policy versions for '%s': %v", policyARN, err)
+		}
+		for _, version := range policyVersions {
+			if version.IsDefaultVersion {
+				continue
+			}
+			commands = append(commands, awscb.NewIAMCommandBuilder().
+				SetCommand(awscb.DeletePolicyVersion).
+				AddParam(awscb.PolicyArn, policyARN).
+				AddParam(awscb.VersionID, version.VersionID).
+				Build())
+		}
+		commands = append(commands, awscb.NewIAMCommandBuilder().
+			SetCommand(awscb.DeletePolicy).
+			AddParam(awscb.PolicyArn, policyARN).
+			Build())
+	}
+	for _, policyARN := range arbitraryPolicyARNs {
+		commands = append(commands, awscb.NewIAMCommandBuilder().
+			SetCommand(awscb.DetachRolePolicy).
+			AddParam(awscb.RoleName, roleName).
+			AddParam(awscb.PolicyArn, policyARN).
+			Build())
+	}
+	commands = append(commands, awscb.NewIAMCommandBuilder().
+		SetCommand(awscb.DeleteRole).
+		AddParam(awscb.RoleName, roleName).
+		Build())
+	return commands, nil
+}
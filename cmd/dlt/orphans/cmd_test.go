@@ -0,0 +1,116 @@
+package orphans
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/interactive"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("delete orphans", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewDeleteOrphansCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+
+		Expect(cmd.Flags().Lookup(olderThanFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(dryRunFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("mode")).NotTo(BeNil())
+	})
+
+	Context("Delete Orphans Runner", func() {
+		var (
+			t         *TestingRuntime
+			awsClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			interactive.SetModeKey("")
+		})
+
+		AfterEach(func() {
+			interactive.SetModeKey("")
+		})
+
+		It("Reports when there is nothing to delete", func() {
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{}, nil)
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+
+			t.StdOutReader.Record()
+			runner := DeleteOrphansRunner(&DeleteOrphansOptions{DryRun: true})
+			err := runner(context.Background(), t.RosaRuntime, NewDeleteOrphansCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: No orphaned resources found\n"))
+		})
+
+		It("Lists the orphaned resources without deleting them in dry run", func() {
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+				"foo": {{RoleName: "foo-openshift-ingress-operator-cloud-credentials", ClusterID: "abc"}},
+			}, nil)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+			awsClient.EXPECT().DeleteOperatorRole(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			t.StdOutReader.Record()
+			runner := DeleteOrphansRunner(&DeleteOrphansOptions{DryRun: true})
+			err := runner(context.Background(), t.RosaRuntime, NewDeleteOrphansCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("operator-role  foo-openshift-ingress-operator-cloud-credentials  abc"))
+			Expect(stdOut).To(ContainSubstring("INFO: Dry run: no resources were deleted"))
+		})
+
+		It("Prints the AWS commands in manual mode", func() {
+			roleName := "foo-openshift-ingress-operator-cloud-credentials"
+			policyArn := "arn:aws:iam::123:policy/foo-openshift-ingress-operator-cloud-credentials"
+			providerArn := "arn:aws:iam::123:oidc-provider/oidc.example.com/foo"
+			cmd := NewDeleteOrphansCommand()
+			interactive.SetModeKey(interactive.ModeManual)
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+				"foo": {{RoleName: roleName}},
+			}, nil)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{{Arn: providerArn}}, nil)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+			awsClient.EXPECT().GetOperatorRolePolicies([]string{roleName}).Return(
+				map[string][]string{roleName: {policyArn}}, map[string][]string{}, nil)
+			awsClient.EXPECT().ListPolicyVersions(policyArn).Return([]aws.PolicyVersion{
+				{VersionID: "v1", IsDefaultVersion: true},
+			}, nil)
+
+			t.StdOutReader.Record()
+			runner := DeleteOrphansRunner(&DeleteOrphansOptions{})
+			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("aws iam detach-role-policy \\\n" +
+				"\t--policy-arn " + policyArn + " \\\n\t--role-name " + roleName))
+			Expect(stdOut).To(ContainSubstring("aws iam delete-policy \\\n\t--policy-arn " + policyArn))
+			Expect(stdOut).To(ContainSubstring("aws iam delete-role \\\n\t--role-name " + roleName))
+			Expect(stdOut).To(ContainSubstring("aws iam delete-open-id-connect-provider \\\n" +
+				"\t--open-id-connect-provider-arn " + providerArn))
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/addon"
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -33,13 +34,22 @@ import (
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
+const paramsFileFlag = "params-file"
+
+var args struct {
+	paramsFile string
+}
+
 var Cmd = &cobra.Command{
 	Use:     "addon ID",
 	Aliases: []string{"addons", "add-on", "add-ons"},
 	Short:   "Edit add-on installation parameters on cluster",
 	Long:    "Edit the parameters on installed Red Hat managed add-ons on a cluster",
 	Example: `  # Edit the parameters of the Red Hat OpenShift logging operator add-on installation
-  rosa edit addon --cluster=mycluster cluster-logging-operator`,
+  rosa edit addon --cluster=mycluster cluster-logging-operator
+
+  # Edit the parameters with the values in a file, the parameters missing from the file keep their values
+  rosa edit addon --cluster=mycluster cluster-logging-operator --params-file=params.yaml`,
 	Run:                run,
 	DisableFlagParsing: true,
 	Args: func(cmd *cobra.Command, argv []string) error {
@@ -60,6 +70,13 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
+	Cmd.Flags().StringVar(
+		&args.paramsFile,
+		paramsFileFlag,
+		"",
+		"Path to a YAML file with the values of the add-on parameters by parameter ID. "+
+			"The values are validated against the parameters of the add-on before updating it.",
+	)
 	ocm.AddClusterFlag(Cmd)
 }
 
@@ -97,6 +114,17 @@ func run(cmd *cobra.Command, argv []string) {
 		os.Exit(1)
 	}
 
+	if args.paramsFile != "" {
+		addonArguments, err := addon.ParamsFromFile(cmd.Flags(), addonParameters, args.paramsFile,
+			addon.InstallationParams(addOnInstallation))
+		if err != nil {
+			r.Reporter.Errorf("Invalid parameters for add-on '%s': %v", addOnID, err)
+			os.Exit(1)
+		}
+		updateAddOnInstallation(r, cluster.ID(), clusterKey, addOnID, addonArguments)
+		return
+	}
+
 	// Determine if all required parameters have already been set as flags and ensure
 	// that interactive mode is enabled if they have not. If there are no parameters
 	// set as flags, then we also ensure that interactive mode is enabled so that the
@@ -179,8 +207,13 @@ func run(cmd *cobra.Command, argv []string) {
 		return true
 	})
 
+	updateAddOnInstallation(r, cluster.ID(), clusterKey, addOnID, addonArguments)
+}
+
+func updateAddOnInstallation(r *rosa.Runtime, clusterID, clusterKey, addOnID string,
+	addonArguments []ocm.AddOnParam) {
 	r.Reporter.Debugf("Updating add-on parameters for '%s' on cluster '%s'", addOnID, clusterKey)
-	err = r.OCMClient.UpdateAddOnInstallation(cluster.ID(), addOnID, addonArguments)
+	err := r.OCMClient.UpdateAddOnInstallation(clusterID, addOnID, addonArguments)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update add-on installation '%s' for cluster '%s': %v", addOnID, clusterKey, err)
 		os.Exit(1)
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	createautoscaler "github.com/openshift/rosa/cmd/create/autoscaler"
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
@@ -46,7 +47,10 @@ const (
   rosa edit autoscaler --cluster=mycluster --log-verbosity 3
 
   # Edit a cluster-autoscaler with total CPU constraints
-  rosa edit autoscaler --cluster=mycluster --min-cores 10 --max-cores 100`
+  rosa edit autoscaler --cluster=mycluster --min-cores 10 --max-cores 100
+
+  # Edit a cluster-autoscaler to add nodes quickly and keep them around for bursts of pods
+  rosa edit autoscaler --cluster=mycluster --autoscaler-profile responsive`
 )
 
 var aliases = []string{"cluster-autoscaler"}
@@ -67,6 +71,7 @@ func NewEditAutoscalerCommand() *cobra.Command {
 	ocm.AddClusterFlag(cmd)
 	interactive.AddFlag(flags)
 	autoscalerArgs := clusterautoscaler.AddClusterAutoscalerFlags(cmd, argsPrefix)
+	createautoscaler.AddProfileFlag(cmd)
 	cmd.Run = rosa.DefaultRunner(rosa.RuntimeWithOCM(), EditAutoscalerRunner(autoscalerArgs))
 	return cmd
 }
@@ -88,6 +93,10 @@ func EditAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) rosa
 				return errors.UserErrorf("Editing a Hosted Control Plane cluster autoscaler does not support " +
 					"interactive mode")
 			}
+			err = validateProfileForHostedCp(command)
+			if err != nil {
+				return err
+			}
 			ok, err := clusterautoscaler.ValidateAutoscalerFlagsForHostedCp(argsPrefix, command)
 			if !ok || err != nil {
 				return err
@@ -105,7 +114,8 @@ func EditAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) rosa
 				"You should first create it via 'rosa create autoscaler'", clusterKey)
 		}
 
-		if !clusterautoscaler.IsAutoscalerSetViaCLI(command.Flags(), argsPrefix) && !interactive.Enabled() &&
+		if !clusterautoscaler.IsAutoscalerSetViaCLI(command.Flags(), argsPrefix) &&
+			!command.Flags().Changed(createautoscaler.ProfileFlag) && !interactive.Enabled() &&
 			!cluster.Hypershift().Enabled() {
 			interactive.Enable()
 			r.Reporter.Infof("Enabling interactive mode")
@@ -119,6 +129,11 @@ func EditAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) rosa
 				cluster.ID(), err)
 		}
 
+		err = createautoscaler.ApplyProfile(command.Flags(), argsPrefix, autoscalerArgs)
+		if err != nil {
+			return err
+		}
+
 		autoscalerValidationArgs := &clusterautoscaler.AutoscalerValidationArgs{
 			ClusterVersion: cluster.OpenshiftVersion(),
 			MultiAz:        cluster.MultiAZ(),
@@ -132,6 +147,13 @@ func EditAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) rosa
 				cluster.ID(), err)
 		}
 
+		if !cluster.Hypershift().Enabled() {
+			err = createautoscaler.CheckResourceLimits(r, cluster, autoscalerArgs.ResourceLimits)
+			if err != nil {
+				return err
+			}
+		}
+
 		autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(autoscalerArgs)
 		if err != nil {
 			return fmt.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
@@ -148,3 +170,13 @@ func EditAutoscalerRunner(autoscalerArgs *clusterautoscaler.AutoscalerArgs) rosa
 		return nil
 	}
 }
+
+// validateProfileForHostedCp rejects the autoscaler profile for Hosted Control Plane clusters, the
+// settings of the profiles aren't supported by their autoscaler
+func validateProfileForHostedCp(command *cobra.Command) error {
+	if command.Flags().Changed(createautoscaler.ProfileFlag) {
+		return errors.UserErrorf(clusterautoscaler.HcpError, createautoscaler.ProfileFlag, "max-nodes-total",
+			"max-pod-grace-period", "max-node-provision-time", "pod-priority-threshold")
+	}
+	return nil
+}
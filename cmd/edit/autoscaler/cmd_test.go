@@ -148,6 +148,18 @@ var _ = Describe("edit autoscaler", func() {
 			t.ApiServer.RouteToHandler(http.MethodGet,
 				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
 				RespondWithJSON(http.StatusOK, FormatResource(autoscaler)))
+			machinePool, err := cmv1.NewMachinePool().ID("worker").InstanceType("m5.xlarge").
+				Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(10)).Build()
+			Expect(err).NotTo(HaveOccurred())
+			machineType, err := cmv1.NewMachineType().ID("m5.xlarge").
+				CPU(cmv1.NewValue().Value(4).Unit("vCPU")).
+				Memory(cmv1.NewValue().Value(16 * (1 << 30)).Unit("B")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.RouteToHandler(http.MethodGet,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/machine_pools", cluster.ID()),
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{machinePool})))
+			t.ApiServer.RouteToHandler(http.MethodGet, "/api/clusters_mgmt/v1/machine_types",
+				RespondWithJSON(http.StatusOK, FormatMachineTypeList([]*cmv1.MachineType{machineType})))
 			t.ApiServer.RouteToHandler(http.MethodPatch,
 				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/autoscaler", cluster.ID()),
 				CombineHandlers(
@@ -164,7 +176,7 @@ var _ = Describe("edit autoscaler", func() {
 			cmd.Flags().Set("log-verbosity", "1")
 			cmd.Flags().Set("max-nodes-total", "20")
 			t.SetCluster("cluster", nil)
-			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
+			err = runner(context.Background(), t.RosaRuntime, cmd, nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -192,6 +204,14 @@ var _ = Describe("edit autoscaler", func() {
 			Expect(err.Error()).To(Equal(fmt.Sprintf(clusterautoscaler.HcpError, "max-cores",
 				"max-nodes-total", "max-pod-grace-period", "max-node-provision-time",
 				"pod-priority-threshold")))
+
+			cmd = NewEditAutoscalerCommand()
+			Expect(cmd.ParseFlags([]string{"--autoscaler-profile", "responsive"})).To(Succeed())
+
+			err = validateProfileForHostedCp(cmd)
+			Expect(err.Error()).To(Equal(fmt.Sprintf(clusterautoscaler.HcpError, "autoscaler-profile",
+				"max-nodes-total", "max-pod-grace-period", "max-node-provision-time",
+				"pod-priority-threshold")))
 		})
 
 		It("Supported flags work for Hosted CP cluster autoscaler", func() {
@@ -40,8 +40,6 @@ import (
 // user is safe and that it there is no risk of SQL injection:
 var ingressKeyRE = regexp.MustCompile(`^[a-z0-9]{3,5}$`)
 
-var validLbTypes = []string{string(cmv1.LoadBalancerFlavorClassic), string(cmv1.LoadBalancerFlavorNlb)}
-
 var Cmd = &cobra.Command{
 	Use:     "ingress ID",
 	Aliases: []string{"route"},
@@ -57,7 +55,10 @@ var Cmd = &cobra.Command{
   rosa edit ingress --private=false --cluster=mycluster apps
 
   # Update the load balancer type of the apps2 ingress 
-  rosa edit ingress --lb-type=nlb --cluster=mycluster apps2`,
+  rosa edit ingress --lb-type=nlb --cluster=mycluster apps2
+
+  # Show the changes to the default ingress without applying them
+  rosa edit ingress --route-selector=route=external --dry-run --cluster=mycluster apps`,
 	Run: run,
 	Args: func(_ *cobra.Command, argv []string) error {
 		if len(argv) != 1 {
@@ -89,6 +90,8 @@ var args struct {
 	clusterRoutesTlsSecretRef string
 
 	componentRoutes string
+
+	dryRun bool
 }
 
 const (
@@ -127,11 +130,18 @@ func init() {
 		&args.lbType,
 		lbTypeFlag,
 		"",
-		fmt.Sprintf("Type of Load Balancer. Options are %s.", strings.Join(validLbTypes, ",")),
+		fmt.Sprintf("Type of Load Balancer. Options are %s.", strings.Join(helper.ValidLbTypes, ",")),
 	)
 
 	addIngressV2Flags(flags)
 
+	flags.BoolVar(
+		&args.dryRun,
+		dryRunFlag,
+		false,
+		"Validate the changes and show them, and their impact on the exposed routes, without updating the ingress.",
+	)
+
 	Cmd.RegisterFlagCompletionFunc(lbTypeFlag, lbTypeCompletion)
 	Cmd.RegisterFlagCompletionFunc(wildcardPolicyFlag, wildcardPoliciesTypeCompletion)
 	Cmd.RegisterFlagCompletionFunc(namespaceOwnershipPolicyFlag, namespaceOwnershipPoliciesTypeCompletion)
@@ -139,7 +149,7 @@ func init() {
 
 // TODO: Generalize this functionality for type completion
 func lbTypeCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
-	return validLbTypes, cobra.ShellCompDirectiveDefault
+	return helper.ValidLbTypes, cobra.ShellCompDirectiveDefault
 }
 
 func namespaceOwnershipPoliciesTypeCompletion(cmd *cobra.Command,
@@ -228,6 +238,16 @@ func run(cmd *cobra.Command, argv []string) {
 			Private: private,
 		}
 
+		if args.dryRun {
+			if private == nil {
+				r.Reporter.Infof("Dry run: the API of cluster '%s' would not change", clusterKey)
+			} else {
+				r.Reporter.Infof("Dry run: the API of cluster '%s' would change from private=%t to private=%t",
+					clusterKey, cluster.API().Listening() == cmv1.ListeningMethodInternal, *private)
+			}
+			os.Exit(0)
+		}
+
 		err := r.OCMClient.UpdateCluster(clusterKey, r.Creator, clusterConfig)
 		if err != nil {
 			r.Reporter.Errorf("Failed to update cluster API on cluster '%s': %v", clusterKey, err)
@@ -253,6 +273,10 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Errorf("Updating route selectors for default ingress is not allowed for legacy ingress support")
 			os.Exit(1)
 		}
+		if err := helper.ValidateRouteSelector(args.routeSelector); err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(1)
+		}
 		routeSelector = &args.routeSelector
 	} else if interactive.Enabled() && !ocm.IsHyperShiftCluster(cluster) &&
 		(ingress.Default() && !hasLegacyIngressSupport || !ingress.Default()) {
@@ -262,8 +286,7 @@ func run(cmd *cobra.Command, argv []string) {
 			Default:  args.routeSelector,
 			Validators: []interactive.Validator{
 				func(routeSelector interface{}) error {
-					_, err := helper.GetRouteSelector(routeSelector.(string))
-					return err
+					return helper.ValidateRouteSelector(routeSelector.(string))
 				},
 			},
 		})
@@ -293,7 +316,7 @@ func run(cmd *cobra.Command, argv []string) {
 		}
 		lbTypeArg, err := interactive.GetOption(interactive.Input{
 			Question: "Type of Load Balancer",
-			Options:  validLbTypes,
+			Options:  helper.ValidLbTypes,
 			Required: true,
 			Default:  *lbType,
 		})
@@ -315,12 +338,21 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
 				os.Exit(1)
 			}
+			if err := helper.ValidateExcludedNamespaces(args.excludedNamespaces); err != nil {
+				r.Reporter.Errorf("%s", err)
+				os.Exit(1)
+			}
 			excludedNamespaces = &args.excludedNamespaces
 		} else if isInteractiveEnabledAndNotHcp {
 			excludedNamespacesArg, err := interactive.GetString(interactive.Input{
 				Question: "Excluded namespaces for ingress",
 				Help:     cmd.Flags().Lookup(excludedNamespacesFlag).Usage,
 				Default:  args.excludedNamespaces,
+				Validators: []interactive.Validator{
+					func(excludedNamespaces interface{}) error {
+						return helper.ValidateExcludedNamespaces(excludedNamespaces.(string))
+					},
+				},
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
@@ -469,12 +501,20 @@ func run(cmd *cobra.Command, argv []string) {
 		ingressBuilder = ingressBuilder.ComponentRoutes(componentRoutes)
 	}
 
+	currentIngress := ingress
 	ingress, err = ingressBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create ingress for cluster '%s': %v", clusterKey, err)
 		os.Exit(1)
 	}
 
+	impact := helper.NewEditImpact(currentIngress, ingress)
+	if args.dryRun {
+		fmt.Print(helper.ImpactOutput(impact))
+		r.Reporter.Infof("Dry run, ingress '%s' on cluster '%s' was not updated", ingress.ID(), clusterKey)
+		os.Exit(0)
+	}
+
 	sameRouteSelectors := routeSelector == nil || reflect.DeepEqual(curRouteSelectors, ingress.RouteSelectors())
 	// If private arg is nil no change to listening method will be made anyway
 	sameListeningMethod := private == nil || curListening == ingress.Listening()
@@ -498,6 +538,9 @@ func run(cmd *cobra.Command, argv []string) {
 		os.Exit(0)
 	}
 
+	for _, warning := range impact.Warnings {
+		r.Reporter.Warnf("%s", warning)
+	}
 	r.Reporter.Debugf("Updating ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
 	_, err = r.OCMClient.UpdateIngress(cluster.ID(), ingress)
 	if err != nil {
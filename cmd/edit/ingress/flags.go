@@ -26,6 +26,7 @@ const (
 	clusterRoutesHostnameFlag     = "cluster-routes-hostname"
 	clusterRoutesTlsSecretRefFlag = "cluster-routes-tls-secret-ref"
 	componentRoutesFlag           = "component-routes"
+	dryRunFlag                    = "dry-run"
 
 	expectedLengthOfParsedComponent = 2
 	hostnameParameter               = "hostname"
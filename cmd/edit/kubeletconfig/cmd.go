@@ -37,6 +37,8 @@ const (
   rosa edit kubeletconfig --cluster=mycluster --pod-pids-limit=10000
   # Edit a KubeletConfig named 'bar' to have a pod-pids-limit of 10000
   rosa edit kubeletconfig --cluster=mycluster --name=bar --pod-pids-limit=10000
+  # Show the machine pools whose nodes would roll if KubeletConfig 'bar' used the 'high-density' preset
+  rosa edit kubeletconfig --cluster=mycluster --name=bar --preset=high-density --dry-run
   `
 	kubeletNotExistingMessage = "The specified KubeletConfig does not exist for cluster '%s'." +
 		" You should first create it via 'rosa create kubeletconfig'"
@@ -63,12 +65,18 @@ func NewEditKubeletConfigCommand() *cobra.Command {
 	ocm.AddClusterFlag(cmd)
 	interactive.AddFlag(flags)
 	options.AddAllFlags(cmd)
+	options.AddDryRunFlag(cmd)
 	return cmd
 }
 
 func EditKubeletConfigRunner(options *KubeletConfigOptions) rosa.CommandRunner {
 	return func(ctx context.Context, r *rosa.Runtime, command *cobra.Command, args []string) error {
 		options.BindFromArgs(args)
+		err := options.ApplyPreset()
+		if err != nil {
+			return err
+		}
+
 		cluster, err := r.OCMClient.GetCluster(r.GetClusterKey(), r.Creator)
 		if err != nil {
 			return err
@@ -115,6 +123,10 @@ func EditKubeletConfigRunner(options *KubeletConfigOptions) rosa.CommandRunner {
 			return err
 		}
 
+		if options.DryRun {
+			return printEditImpact(r, cluster, kubeletconfig, requestedPids)
+		}
+
 		if !cluster.Hypershift().Enabled() {
 			// Classic clusters must prompt the user as edit will cause all worker nodes to reboot
 			if !PromptUserToAcceptWorkerNodeReboot(OperationEdit, r) {
@@ -135,3 +147,38 @@ func EditKubeletConfigRunner(options *KubeletConfigOptions) rosa.CommandRunner {
 		return nil
 	}
 }
+
+// printEditImpact shows the machine pools whose nodes an edit of the KubeletConfig rolls out to, without
+// applying it
+func printEditImpact(r *rosa.Runtime, cluster *cmv1.Cluster, kubeletconfig *cmv1.KubeletConfig,
+	requestedPids int) error {
+	if requestedPids == kubeletconfig.PodPidsLimit() {
+		r.Reporter.Infof("KubeletConfig '%s' of cluster '%s' already has a pod pids limit of %d, "+
+			"no nodes would be rolled", kubeletconfig.ID(), r.GetClusterKey(), requestedPids)
+		return nil
+	}
+
+	var impacts []*Impact
+	if cluster.Hypershift().Enabled() {
+		nodePools, err := r.OCMClient.FindNodePoolsUsingKubeletConfig(cluster.ID(), kubeletconfig.Name())
+		if err != nil {
+			return fmt.Errorf("Failed to get machine pools for cluster '%s': %s", r.GetClusterKey(), err)
+		}
+		impacts = NodePoolsImpact(nodePools)
+	} else {
+		machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get machine pools for cluster '%s': %s", r.GetClusterKey(), err)
+		}
+		impacts = MachinePoolsImpact(machinePools)
+	}
+
+	r.Reporter.Infof("Dry run: the pod pids limit of KubeletConfig '%s' of cluster '%s' would change from %d to %d",
+		kubeletconfig.ID(), r.GetClusterKey(), kubeletconfig.PodPidsLimit(), requestedPids)
+	if len(impacts) == 0 {
+		r.Reporter.Infof("No machine pools use the KubeletConfig, no nodes would be rolled")
+		return nil
+	}
+	fmt.Print(PrintImpact(impacts))
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -31,6 +33,9 @@ var _ = Describe("edit kubeletconfig", func() {
 		Expect(cmd.Flags().Lookup("interactive")).NotTo(BeNil())
 		Expect(cmd.Flags().Lookup(PodPidsLimitOption)).NotTo(BeNil())
 		Expect(cmd.Flags().Lookup(NameOption)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(PresetOption)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(PresetsFileOption)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(DryRunOption)).NotTo(BeNil())
 	})
 
 	Context("Edit KubeletConfig Runner", func() {
@@ -234,5 +239,54 @@ var _ = Describe("edit kubeletconfig", func() {
 			stdOut, _ := t.StdOutReader.Read()
 			Expect(stdOut).To(Equal("INFO: Successfully updated KubeletConfig for cluster 'cluster'\n"))
 		})
+
+		It("Shows the node pools that an edit of an HCP KubeletConfig rolls out to with --dry-run", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				b := cmv1.HypershiftBuilder{}
+				b.Enabled(true)
+				c.Hypershift(&b)
+			})
+
+			kubeletConfig := MockKubeletConfig(func(k *cmv1.KubeletConfigBuilder) {
+				k.ID("testing").PodPidsLimit(5000).Name("testing")
+			})
+			nodePool := MockNodePool(func(n *cmv1.NodePoolBuilder) {
+				n.ID("workers").Replicas(3).KubeletConfigs("testing")
+			})
+			otherNodePool := MockNodePool(func(n *cmv1.NodePoolBuilder) {
+				n.ID("other").Replicas(2)
+			})
+
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(
+					http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})))
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatKubeletConfigList([]*cmv1.KubeletConfig{kubeletConfig})))
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatNodePoolList([]*cmv1.NodePool{nodePool, otherNodePool})))
+			t.SetCluster("cluster", nil)
+
+			presetsFile := filepath.Join(GinkgoT().TempDir(), "presets.yaml")
+			Expect(os.WriteFile(presetsFile, []byte("presets:\n  batch:\n    podPidsLimit: 8192\n"), 0600)).
+				To(Succeed())
+			options := NewKubeletConfigOptions()
+			options.Name = "testing"
+			options.Preset = "batch"
+			options.PresetsFile = presetsFile
+			options.DryRun = true
+
+			runner := EditKubeletConfigRunner(options)
+			t.StdOutReader.Record()
+
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: Dry run: the pod pids limit of KubeletConfig 'testing' of cluster " +
+				"'cluster' would change from 5000 to 8192\n" +
+				"Editing This KubeletConfig Will:\n" +
+				" - Recreate the 3 nodes of machine pool 'workers'\n"))
+		})
 	})
 })
@@ -18,6 +18,7 @@ package machinepool
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -37,7 +38,16 @@ const (
 	# Enable autoscaling and Set 3-5 replicas on machine pool 'mp1' on cluster 'mycluster'
 	rosa edit machinepool --enable-autoscaling --min-replicas=3 --max-replicas=5 --cluster=mycluster mp1
 	# Set the node drain grace period to 1 hour on machine pool 'mp1' on cluster 'mycluster'
-	rosa edit machinepool --node-drain-grace-period="1 hour" --cluster=mycluster mp1`
+	rosa edit machinepool --node-drain-grace-period="1 hour" --cluster=mycluster mp1
+	# Set 9 replicas on machine pool 'mp1' spread across the subnets of hosted cluster 'mycluster'
+	rosa edit machinepool --spread --replicas=9 --cluster=mycluster mp1
+	# Preview the changes and the nodes disrupted by scaling down machine pool 'mp1' to 2 replicas
+	rosa edit machinepool --replicas=2 --dry-run --cluster=mycluster mp1
+	# Change the kubelet configs of machine pool 'mp1' only if at most 1 node is unavailable at a time
+	rosa edit machinepool --kubelet-configs=myconfig --max-disruption=1 --cluster=mycluster mp1`
+
+	dryRunFlag        = "dry-run"
+	maxDisruptionFlag = "max-disruption"
 )
 
 var (
@@ -175,6 +185,27 @@ func NewEditMachinePoolCommand() *cobra.Command {
 			"absolute number i.e. 1, or a percentage i.e. '20%'.",
 	)
 
+	flags.BoolVar(&options.spread,
+		spreadFlag,
+		false,
+		"Edit a machine pool spread across the private subnets of a hosted cluster. Machine pools are added "+
+			"for the subnets that don't have one and the replicas or autoscaling limits are divided between them.",
+	)
+
+	flags.BoolVar(&options.dryRun,
+		dryRunFlag,
+		false,
+		"Show the changes to the machine pool and their impact on the nodes without updating it.",
+	)
+
+	flags.StringVar(&options.maxDisruption,
+		maxDisruptionFlag,
+		"",
+		"Refuse the edit if it makes more nodes unavailable at the same time than this value, by scaling "+
+			"down or recreating nodes. It can be an absolute number i.e. 1, or a percentage of the current "+
+			"nodes i.e. '20%'.",
+	)
+
 	output.AddFlag(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
@@ -193,6 +224,35 @@ func EditMachinePoolRunner(userOptions *EditMachinepoolUserOptions) rosa.Command
 		cluster := runtime.FetchCluster()
 
 		service := machinepool.NewMachinePoolService()
-		return service.EditMachinePool(cmd, options.Machinepool(), clusterKey, cluster, runtime)
+		if userOptions.spread {
+			if userOptions.dryRun || userOptions.maxDisruption != "" {
+				return fmt.Errorf("'--%s' and '--%s' can't be used with '--%s'", dryRunFlag, maxDisruptionFlag,
+					spreadFlag)
+			}
+			return editSpreadMachinePools(runtime, cmd, service, clusterKey, cluster, options.args)
+		}
+		impactArgs := machinepool.EditImpactArgs{
+			DryRun:        userOptions.dryRun,
+			MaxDisruption: userOptions.maxDisruption,
+		}
+		impact, err := service.EditMachinePool(cmd, options.Machinepool(), clusterKey, cluster, runtime,
+			impactArgs)
+		if userOptions.dryRun && impact != nil {
+			// The impact is shown even if it exceeds the maximum disruption
+			if output.HasFlag() {
+				if printErr := output.Print(impact); printErr != nil {
+					return printErr
+				}
+			} else {
+				fmt.Print(machinepool.ImpactOutput(impact))
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if userOptions.dryRun {
+			runtime.Reporter.Infof("Dry run, machine pool '%s' was not updated", options.Machinepool())
+		}
+		return nil
 	}
 }
@@ -3,14 +3,23 @@ package machinepool
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"net/http"
 
+	"go.uber.org/mock/gomock"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
+	. "github.com/onsi/gomega/ghttp"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/machinepool"
 	. "github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/test"
 )
@@ -98,6 +107,28 @@ var _ = Describe("Edit Machinepool", func() {
 				Expect(cmd.Flags().Set("replicas", "1")).To(Succeed())
 				Expect(runner(context.Background(), t.RosaRuntime, cmd, []string{})).To(Succeed())
 			})
+			It("Shows the impact of the edit without updating the machine pool on a dry run", func() {
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, mpResponse))
+				t.SetCluster(clusterId, mockClassicClusterReady)
+				args := NewEditMachinepoolUserOptions()
+				args.machinepool = nodePoolId
+				args.dryRun = true
+				runner := EditMachinePoolRunner(args)
+				cmd := NewEditMachinePoolCommand()
+				Expect(cmd.Flag("cluster").Value.Set(clusterId)).To(Succeed())
+				Expect(cmd.Flags().Set("dry-run", "true")).To(Succeed())
+				Expect(cmd.Flags().Set("labels", "test=test")).To(Succeed())
+				Expect(cmd.Flags().Set("replicas", "3")).To(Succeed())
+				Expect(t.StdOutReader.Record()).To(Succeed())
+				Expect(runner(context.Background(), t.RosaRuntime, cmd, []string{})).To(Succeed())
+				stdout, err := t.StdOutReader.Read()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stdout).To(ContainSubstring(fmt.Sprintf("Changes to machine pool '%s':\n", nodePoolId)))
+				Expect(stdout).To(ContainSubstring("  Labels:                  - -> test=test\n"))
+				Expect(stdout).To(ContainSubstring(fmt.Sprintf("Dry run, machine pool '%s' was not updated",
+					nodePoolId)))
+				Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(1))
+			})
 			It("Machinepool ID passed in without flag in random location", func() {
 				// First get
 				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, mpResponse))
@@ -221,6 +252,193 @@ var _ = Describe("Edit Machinepool", func() {
 	})
 })
 
+var _ = Describe("editSpreadMachinePools", func() {
+	var (
+		t           *test.TestingRuntime
+		ctrl        *gomock.Controller
+		serviceMock *machinepool.MockMachinePoolService
+		awsClient   *aws.MockClient
+	)
+
+	hostedCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.State(cmv1.ClusterStateReady)
+		c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		c.AWS(cmv1.NewAWS().SubnetIDs("subnet-1", "subnet-2", "subnet-3"))
+	})
+
+	spreadNodePool := func(id string, zone string, subnet string, replicas int) *cmv1.NodePool {
+		nodePool, err := cmv1.NewNodePool().ID(id).AvailabilityZone(zone).Subnet(subnet).Replicas(replicas).
+			Labels(map[string]string{machinepool.SpreadGroupLabel: "workers"}).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).Build()
+		Expect(err).ToNot(HaveOccurred())
+		return nodePool
+	}
+
+	BeforeEach(func() {
+		t = test.NewTestRuntime()
+		ctrl = gomock.NewController(GinkgoT())
+		serviceMock = machinepool.NewMockMachinePoolService(ctrl)
+		awsClient = aws.NewMockClient(ctrl)
+		t.RosaRuntime.AWSClient = awsClient
+	})
+
+	appendNodePoolRequest := func(requests *[]string, status int) {
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			func(_ http.ResponseWriter, r *http.Request) {
+				nodePool, err := cmv1.UnmarshalNodePool(r.Body)
+				Expect(err).ToNot(HaveOccurred())
+				*requests = append(*requests, fmt.Sprintf("%s %s %d %v", r.Method, nodePool.ID(),
+					nodePool.Replicas(), nodePool.Labels()))
+			},
+			RespondWithJSON(status, "{}"),
+		))
+	}
+
+	groupEdit := func(cmd *cobra.Command, labels map[string]string) {
+		builder := cmv1.NewNodePool().ID("workers-a")
+		if labels != nil {
+			builder.Labels(labels)
+		}
+		edit, err := builder.Build()
+		Expect(err).ToNot(HaveOccurred())
+		serviceMock.EXPECT().BuildNodePoolEdit(t.RosaRuntime, cmd, gomock.Any(), hostedCluster).Return(edit, nil)
+	}
+
+	It("Adds the missing subnets and divides the replicas", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{
+			spreadNodePool("workers-a", "us-east-1a", "subnet-1", 2),
+			spreadNodePool("workers-b", "us-east-1b", "subnet-2", 1),
+		})))
+		requests := []string{}
+		for range 3 {
+			appendNodePoolRequest(&requests, http.StatusOK)
+		}
+		subnets := []ec2types.Subnet{
+			{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+			{SubnetId: awssdk.String("subnet-3"), AvailabilityZone: awssdk.String("us-east-1c")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2", "subnet-3").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{}, nil)
+
+		cmd := NewEditMachinePoolCommand()
+		Expect(cmd.Flag("yes").Value.Set("true")).To(Succeed())
+		Expect(cmd.Flags().Set("replicas", "7")).To(Succeed())
+		args := NewEditMachinepoolUserOptions()
+		args.machinepool = "workers"
+		args.replicas = 7
+		groupEdit(cmd, nil)
+
+		err := editSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, args)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal([]string{
+			"POST workers-c 2 map[" + machinepool.SpreadGroupLabel + ":workers]",
+			"PATCH workers-a 3 map[]",
+			"PATCH workers-b 2 map[]",
+		}))
+	})
+
+	It("Keeps the spread group label when the labels are edited", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{
+			spreadNodePool("workers-a", "us-east-1a", "subnet-1", 2),
+			spreadNodePool("workers-b", "us-east-1b", "subnet-2", 1),
+		})))
+		requests := []string{}
+		appendNodePoolRequest(&requests, http.StatusOK)
+		appendNodePoolRequest(&requests, http.StatusOK)
+		subnets := []ec2types.Subnet{
+			{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2", "subnet-3").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{}, nil)
+
+		cmd := NewEditMachinePoolCommand()
+		Expect(cmd.Flags().Set("labels", "team=a")).To(Succeed())
+		args := NewEditMachinepoolUserOptions()
+		args.machinepool = "workers"
+		args.labels = "team=a"
+		groupEdit(cmd, map[string]string{"team": "a"})
+
+		err := editSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, args)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal([]string{
+			"PATCH workers-a 2 map[" + machinepool.SpreadGroupLabel + ":workers team:a]",
+			"PATCH workers-b 1 map[" + machinepool.SpreadGroupLabel + ":workers team:a]",
+		}))
+	})
+
+	It("Deletes the machine pools added when one of them fails", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{
+			spreadNodePool("workers-a", "us-east-1a", "subnet-1", 2),
+		})))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusCreated,
+			formatNodePoolResource(spreadNodePool("workers-b", "us-east-1b", "subnet-2", 1))))
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusBadRequest, "{}"))
+		t.ApiServer.AppendHandlers(CombineHandlers(
+			VerifyRequest(http.MethodDelete,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/node_pools/workers-b", hostedCluster.ID())),
+			RespondWithJSON(http.StatusNoContent, ""),
+		))
+		subnets := []ec2types.Subnet{
+			{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+			{SubnetId: awssdk.String("subnet-3"), AvailabilityZone: awssdk.String("us-east-1c")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2", "subnet-3").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{}, nil)
+
+		cmd := NewEditMachinePoolCommand()
+		Expect(cmd.Flag("yes").Value.Set("true")).To(Succeed())
+		args := NewEditMachinepoolUserOptions()
+		args.machinepool = "workers"
+		groupEdit(cmd, nil)
+
+		err := editSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, args)
+		Expect(err).To(MatchError(ContainSubstring("Failed to create machine pool 'workers-c'")))
+		Expect(err).To(MatchError(HaveSuffix("machine pools 'workers-b' that were already created have been deleted")))
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	It("Doesn't change any machine pool when the flags are not valid", func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{
+			spreadNodePool("workers-a", "us-east-1a", "subnet-1", 2),
+		})))
+		subnets := []ec2types.Subnet{
+			{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2", "subnet-3").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{}, nil)
+
+		cmd := NewEditMachinePoolCommand()
+		Expect(cmd.Flag("yes").Value.Set("true")).To(Succeed())
+		Expect(cmd.Flags().Set("taints", "invalid")).To(Succeed())
+		args := NewEditMachinepoolUserOptions()
+		args.machinepool = "workers"
+		serviceMock.EXPECT().BuildNodePoolEdit(t.RosaRuntime, cmd, gomock.Any(), hostedCluster).
+			Return(nil, fmt.Errorf("invalid taint format"))
+
+		err := editSpreadMachinePools(t.RosaRuntime, cmd, serviceMock, "mycluster", hostedCluster, args)
+		Expect(err).To(MatchError("invalid taint format"))
+		Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("Fails when the machine pool is not spread", func() {
+		nodePool, err := cmv1.NewNodePool().ID("workers-a").AvailabilityZone("us-east-1a").Subnet("subnet-1").
+			Replicas(2).AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).Build()
+		Expect(err).ToNot(HaveOccurred())
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{
+			nodePool,
+		})))
+		args := NewEditMachinepoolUserOptions()
+		args.machinepool = "workers"
+		err = editSpreadMachinePools(t.RosaRuntime, NewEditMachinePoolCommand(), serviceMock, "mycluster",
+			hostedCluster, args)
+		Expect(err).To(MatchError("Machine pool 'workers' is not spread across subnets of cluster 'mycluster'"))
+	})
+})
+
 func formatNodePoolResource(nodePool *cmv1.NodePool) string {
 	var output bytes.Buffer
 	Expect(cmv1.MarshalNodePool(nodePool, &output)).To(Succeed())
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/machinepool"
 	"github.com/openshift/rosa/pkg/reporter"
 )
 
@@ -23,6 +24,9 @@ type EditMachinepoolUserOptions struct {
 	maxUnavailable       string
 	useSpotInstances     bool
 	spotMaxPrice         string
+	spread               bool
+	dryRun               bool
+	maxDisruption        string
 }
 
 type EditMachinepoolOptions struct {
@@ -80,5 +84,12 @@ func (m *EditMachinepoolOptions) Bind(args *EditMachinepoolUserOptions, argv []s
 		}
 	}
 
+	if m.args.maxDisruption != "" {
+		_, err := machinepool.ParseMaxDisruption(m.args.maxDisruption, 0)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
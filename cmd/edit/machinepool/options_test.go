@@ -75,5 +75,12 @@ var _ = Describe("Test edit machinepool options", func() {
 			err := options.Bind(args, []string{})
 			Expect(err).To(MatchError("max-replicas must be greater or equal to min-replicas"))
 		})
+		It("Test invalid max disruption (fail)", func() {
+			args.maxDisruption = "half"
+			args.machinepool = "test"
+			err := options.Bind(args, []string{})
+			Expect(err).To(MatchError(ContainSubstring("the maximum disruption 'half' must be a non-negative " +
+				"number of nodes or a percentage")))
+		})
 	})
 })
@@ -0,0 +1,198 @@
+package machinepool
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/kubeletconfig"
+	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const spreadFlag = "spread"
+
+// editSpreadMachinePools edits the node pools of a machine pool spread across subnets. Node pools are
+// added from an existing one in the private subnets that don't have one yet, the replicas or autoscaling
+// limits are divided between all of them and the other flags are applied to each node pool. The flags are
+// validated once for the whole group and every node pool is built before the first one is changed. The
+// node pools added are deleted when one of them fails.
+func editSpreadMachinePools(r *rosa.Runtime, cmd *cobra.Command, service machinepool.MachinePoolService,
+	clusterKey string, cluster *cmv1.Cluster, args *EditMachinepoolUserOptions) error {
+	if !cluster.Hypershift().Enabled() {
+		return fmt.Errorf("'--%s' is only supported for Hosted Control Planes", spreadFlag)
+	}
+	if cluster.State() != cmv1.ClusterStateReady {
+		return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+	}
+	for _, flag := range []string{"use-spot-instances", "spot-max-price"} {
+		if cmd.Flags().Changed(flag) {
+			return fmt.Errorf("'--%s' can't be used with '--%s'", flag, spreadFlag)
+		}
+	}
+
+	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+	}
+	group := machinepool.FindNodePoolGroup(nodePools, args.machinepool)
+	if group == nil {
+		return fmt.Errorf("Machine pool '%s' is not spread across subnets of cluster '%s'",
+			args.machinepool, clusterKey)
+	}
+	template := group.NodePools[0]
+
+	autoscaling := template.Autoscaling() != nil
+	if cmd.Flags().Changed("enable-autoscaling") {
+		autoscaling = args.autoscalingEnabled
+	}
+	replicas, minReplicas, maxReplicas := 0, 0, 0
+	for _, nodePool := range group.NodePools {
+		if nodePoolAutoscaling, ok := nodePool.GetAutoscaling(); ok {
+			minReplicas += nodePoolAutoscaling.MinReplica()
+			maxReplicas += nodePoolAutoscaling.MaxReplica()
+		} else {
+			replicas += nodePool.Replicas()
+			minReplicas += nodePool.Replicas()
+			maxReplicas += nodePool.Replicas()
+		}
+	}
+	if cmd.Flags().Changed("replicas") {
+		replicas = args.replicas
+	} else if !autoscaling && template.Autoscaling() != nil {
+		return fmt.Errorf("'--replicas' is required to disable autoscaling on machine pool '%s'",
+			args.machinepool)
+	}
+	if cmd.Flags().Changed("min-replicas") {
+		minReplicas = args.minReplicas
+	}
+	if cmd.Flags().Changed("max-replicas") {
+		maxReplicas = args.maxReplicas
+	}
+	if !autoscaling && replicas < 0 {
+		return fmt.Errorf("The number of machine pool replicas needs to be a non-negative integer")
+	}
+	if autoscaling && minReplicas < 0 {
+		return fmt.Errorf("'--min-replicas' must be a non-negative number when autoscaling is set")
+	}
+	if autoscaling && minReplicas > maxReplicas {
+		return fmt.Errorf("'--min-replicas' must not be greater than '--max-replicas'")
+	}
+
+	if r.AWSClient == nil {
+		val, ok := cluster.Properties()[properties.UseLocalCredentials]
+		r.AWSClient, err = aws.NewClient().
+			Region(cluster.Region().ID()).
+			Logger(r.Logger).
+			UseLocalCredentials(ok && val == "true").
+			Build()
+		if err != nil {
+			return fmt.Errorf("Failed to create AWS client: %v", err)
+		}
+	}
+	subnets, _, err := machinepool.GetSpreadSubnets(r.AWSClient, cluster)
+	if err != nil {
+		return err
+	}
+	zones := map[string]bool{}
+	for _, nodePool := range group.NodePools {
+		zones[nodePool.AvailabilityZone()] = true
+	}
+	missing := map[string]machinepool.SpreadSubnet{}
+	missingSubnets := []machinepool.SpreadSubnet{}
+	for _, subnet := range subnets {
+		if !zones[subnet.AvailabilityZone] {
+			missing[machinepool.SpreadNodePoolID(args.machinepool, subnet.AvailabilityZone)] = subnet
+			missingSubnets = append(missingSubnets, subnet)
+		}
+	}
+	err = machinepool.ValidateSpreadGroupName(args.machinepool, missingSubnets)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	for _, nodePool := range group.NodePools {
+		ids = append(ids, nodePool.ID())
+	}
+	for id := range missing {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	shares := machinepool.ShareReplicas(autoscaling, replicas, minReplicas, maxReplicas, len(ids))
+	if autoscaling && shares[len(shares)-1].MaxReplicas == 0 {
+		return fmt.Errorf("'--max-replicas' must be at least %d to spread the machine pool across %d subnets",
+			len(ids), len(ids))
+	}
+
+	// The edit of the group validates the flags, the node pools are built from it before any is changed
+	edit, err := service.BuildNodePoolEdit(r, cmd, template, cluster)
+	if err != nil {
+		return err
+	}
+	added := []*cmv1.NodePool{}
+	updates := []*cmv1.NodePool{}
+	for i, id := range ids {
+		var nodePool *cmv1.NodePool
+		if subnet, ok := missing[id]; ok {
+			nodePool, err = machinepool.AddSpreadNodePool(template, edit, args.machinepool, subnet, shares[i])
+			added = append(added, nodePool)
+		} else {
+			nodePool, err = machinepool.SpreadNodePoolUpdate(edit, id, args.machinepool, shares[i])
+			updates = append(updates, nodePool)
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to build machine pool '%s': %v", id, err)
+		}
+	}
+
+	if len(added) > 0 && !confirm.Prompt(true, "Add %d machine pools to machine pool '%s' for the subnets "+
+		"that don't have one?", len(added), args.machinepool) {
+		return nil
+	}
+	if kubeletConfigs, ok := edit.GetKubeletConfigs(); ok &&
+		!sameKubeletConfigs(template.KubeletConfigs(), kubeletConfigs) &&
+		!kubeletconfig.PromptToAcceptNodePoolNodeRecreate(r) {
+		return nil
+	}
+
+	created := []string{}
+	for _, nodePool := range added {
+		_, err = r.OCMClient.CreateNodePool(cluster.ID(), nodePool)
+		if err != nil {
+			return machinepool.RollbackSpreadNodePools(r.OCMClient, cluster.ID(), created,
+				fmt.Errorf("Failed to create machine pool '%s': %v", nodePool.ID(), err))
+		}
+		r.Reporter.Infof("Machine pool '%s' created in subnet '%s'", nodePool.ID(), nodePool.Subnet())
+		created = append(created, nodePool.ID())
+	}
+	for _, nodePool := range updates {
+		_, err = r.OCMClient.UpdateNodePool(cluster.ID(), nodePool)
+		if err != nil {
+			return fmt.Errorf("Failed to update machine pool '%s' on hosted cluster '%s': %v", nodePool.ID(),
+				clusterKey, err)
+		}
+	}
+	r.Reporter.Infof("Updated machine pool '%s' spread across %d subnets on hosted cluster '%s'",
+		args.machinepool, len(ids), clusterKey)
+	return nil
+}
+
+// sameKubeletConfigs returns whether the kubelet configs of a node pool are kept by an update
+func sameKubeletConfigs(original []string, update []string) bool {
+	if len(original) != len(update) {
+		return false
+	}
+	for _, kubeletConfig := range update {
+		if !slices.Contains(original, kubeletConfig) {
+			return false
+		}
+	}
+	return true
+}
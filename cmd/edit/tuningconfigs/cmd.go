@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/tuningconfig"
 )
 
 var args struct {
@@ -124,6 +125,10 @@ func buildPatchFromInputFile(specPath string, tuningConfig *cmv1.TuningConfig,
 	if err != nil {
 		return nil, fmt.Errorf("Expected a valid spec file: %v", err)
 	}
+	err = tuningconfig.ValidateSpec(specJson)
+	if err != nil {
+		return nil, fmt.Errorf("Expected a valid spec file '%s': %v", specPath, err)
+	}
 
 	tuningConfigPatchBuilder := cmv1.NewTuningConfig().ID(tuningConfig.ID()).Spec(specJson)
 	tuningConfigPatch, err := tuningConfigPatchBuilder.Build()
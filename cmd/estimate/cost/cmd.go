@@ -0,0 +1,322 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/costestimate"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cost"
+	short = "Estimate the monthly cost of a cluster"
+	long  = "Estimates the monthly cost of the nodes of a cluster from a local price catalogue. The cluster " +
+		"is either an existing cluster, a cluster spec file or a cluster described with flags. The control " +
+		"plane and infra nodes of classic clusters, the machine pools and the node pools are multiplied by " +
+		"their hourly prices and broken down by pool. Autoscaling pools are estimated for their minimum and " +
+		"maximum number of nodes.\n\n" + costestimate.CatalogueFormat + "\n\n" +
+		"The cluster spec file is a YAML or JSON file:\n\n" +
+		`  region: us-east-1
+  hosted_cp: false
+  multi_az: true
+  control_plane_instance_type: m5.2xlarge
+  infra_instance_type: r5.xlarge
+  machine_pools:
+  - name: workers
+    instance_type: m5.xlarge
+    replicas: 3
+  - name: batch
+    instance_type: c5.2xlarge
+    min_replicas: 0
+    max_replicas: 10
+    spot: true`
+	example = `  # Estimate the cost of an existing cluster
+  rosa estimate cost --cluster mycluster --price-catalogue prices.csv
+
+  # Estimate the cost of a new hosted control plane cluster with 6 workers
+  rosa estimate cost --price-catalogue prices.json --region us-east-1 --hosted-cp \
+  --compute-machine-type m5.2xlarge --replicas 6
+
+  # Estimate the cost of a cluster spec and print it as JSON
+  rosa estimate cost --price-catalogue prices.json --spec-file cluster.yaml -o json`
+
+	priceCatalogueFlag     = "price-catalogue"
+	specFileFlag           = "spec-file"
+	hostedCPFlag           = "hosted-cp"
+	multiAZFlag            = "multi-az"
+	computeMachineTypeFlag = "compute-machine-type"
+	replicasFlag           = "replicas"
+	minReplicasFlag        = "min-replicas"
+	maxReplicasFlag        = "max-replicas"
+	useSpotInstancesFlag   = "use-spot-instances"
+
+	defaultComputeMachineType = "m5.xlarge"
+	defaultPoolName           = "worker"
+)
+
+var specFlags = []string{hostedCPFlag, multiAZFlag, computeMachineTypeFlag, replicasFlag, minReplicasFlag,
+	maxReplicasFlag, useSpotInstancesFlag}
+
+type EstimateCostOptions struct {
+	PriceCatalogue     string
+	SpecFile           string
+	HostedCP           bool
+	MultiAZ            bool
+	ComputeMachineType string
+	Replicas           int
+	MinReplicas        int
+	MaxReplicas        int
+	UseSpotInstances   bool
+}
+
+func NewEstimateCostCommand() *cobra.Command {
+	options := &EstimateCostOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"costs"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), EstimateCostRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddOptionalClusterFlag(cmd)
+	flags.StringVar(
+		&options.PriceCatalogue,
+		priceCatalogueFlag,
+		"",
+		"Path to the JSON or CSV file with the hourly prices of the instance types.",
+	)
+	flags.StringVar(
+		&options.SpecFile,
+		specFileFlag,
+		"",
+		"Path to a YAML or JSON file that describes the cluster to estimate.",
+	)
+	flags.BoolVar(
+		&options.HostedCP,
+		hostedCPFlag,
+		false,
+		"Estimate a cluster with a hosted control plane.",
+	)
+	flags.BoolVar(
+		&options.MultiAZ,
+		multiAZFlag,
+		false,
+		"Estimate a classic cluster deployed across multiple availability zones.",
+	)
+	flags.StringVar(
+		&options.ComputeMachineType,
+		computeMachineTypeFlag,
+		defaultComputeMachineType,
+		"Instance type of the worker nodes of the estimated cluster.",
+	)
+	flags.IntVar(
+		&options.Replicas,
+		replicasFlag,
+		0,
+		"Number of worker nodes of the estimated cluster. Defaults to 2, or 3 for multi-AZ classic clusters.",
+	)
+	flags.IntVar(
+		&options.MinReplicas,
+		minReplicasFlag,
+		0,
+		"Minimum number of worker nodes of an autoscaling estimated cluster.",
+	)
+	flags.IntVar(
+		&options.MaxReplicas,
+		maxReplicasFlag,
+		0,
+		"Maximum number of worker nodes of an autoscaling estimated cluster.",
+	)
+	flags.BoolVar(
+		&options.UseSpotInstances,
+		useSpotInstancesFlag,
+		false,
+		"Estimate the worker nodes with spot prices.",
+	)
+	output.AddFlag(cmd)
+	cmd.MarkFlagRequired(priceCatalogueFlag)
+	cmd.MarkFlagsMutuallyExclusive("cluster", specFileFlag)
+	cmd.MarkFlagsMutuallyExclusive(replicasFlag, minReplicasFlag)
+	cmd.MarkFlagsMutuallyExclusive(replicasFlag, maxReplicasFlag)
+	cmd.MarkFlagsRequiredTogether(minReplicasFlag, maxReplicasFlag)
+	return cmd
+}
+
+func EstimateCostRunner(options *EstimateCostOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		clusterKey := ""
+		if cmd.Flags().Changed("cluster") {
+			clusterKey = r.GetClusterKey()
+		}
+		if clusterKey != "" || options.SpecFile != "" {
+			for _, name := range specFlags {
+				if cmd.Flags().Changed(name) {
+					return fmt.Errorf("'--%s' can only be used to describe a new cluster, it can't be "+
+						"combined with '--cluster' or '--%s'", name, specFileFlag)
+				}
+			}
+		}
+
+		catalogue, err := costestimate.LoadCatalogue(options.PriceCatalogue)
+		if err != nil {
+			return fmt.Errorf("Failed to load the price catalogue: %v", err)
+		}
+
+		var region string
+		var hostedCP bool
+		var pools []*costestimate.Pool
+		switch {
+		case clusterKey != "":
+			r.WithOCM()
+			cluster := r.FetchCluster()
+			region = cluster.Region().ID()
+			hostedCP = cluster.Hypershift().Enabled()
+			if hostedCP {
+				nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+				if err != nil {
+					return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+				}
+				pools = costestimate.ClusterPools(cluster, nil, nodePools)
+			} else {
+				machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+				if err != nil {
+					return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+				}
+				pools = costestimate.ClusterPools(cluster, machinePools, nil)
+			}
+		case options.SpecFile != "":
+			spec, err := costestimate.LoadSpec(options.SpecFile)
+			if err != nil {
+				return fmt.Errorf("Failed to load the cluster spec: %v", err)
+			}
+			if spec.Region == "" {
+				spec.Region = arguments.GetRegion()
+			}
+			region = spec.Region
+			hostedCP = spec.HostedCP
+			pools, err = spec.Pools()
+			if err != nil {
+				return fmt.Errorf("Invalid cluster spec '%s': %v", options.SpecFile, err)
+			}
+		default:
+			spec := buildSpec(options)
+			region = spec.Region
+			hostedCP = spec.HostedCP
+			pools, err = spec.Pools()
+			if err != nil {
+				return fmt.Errorf("Invalid cluster: %v", err)
+			}
+		}
+		if region == "" {
+			return fmt.Errorf("Expected the region of the cluster to be set with '--region'")
+		}
+
+		estimate := costestimate.EstimateCost(catalogue, region, hostedCP, pools)
+		if output.HasFlag() {
+			return output.Print(estimate)
+		}
+		for _, warning := range estimate.Warnings {
+			r.Reporter.Warnf("%s", warning)
+		}
+		printEstimate(estimate)
+		return nil
+	}
+}
+
+func buildSpec(options *EstimateCostOptions) *costestimate.Spec {
+	machinePool := costestimate.SpecMachinePool{
+		Name:         defaultPoolName,
+		InstanceType: options.ComputeMachineType,
+		Replicas:     options.Replicas,
+		MinReplicas:  options.MinReplicas,
+		MaxReplicas:  options.MaxReplicas,
+		Spot:         options.UseSpotInstances,
+	}
+	if machinePool.Replicas == 0 && machinePool.MaxReplicas == 0 {
+		machinePool.Replicas = 2
+		if options.MultiAZ && !options.HostedCP {
+			machinePool.Replicas = 3
+		}
+	}
+	return &costestimate.Spec{
+		Region:       arguments.GetRegion(),
+		HostedCP:     options.HostedCP,
+		MultiAZ:      options.MultiAZ,
+		MachinePools: []costestimate.SpecMachinePool{machinePool},
+	}
+}
+
+func printEstimate(estimate *costestimate.Estimate) {
+	fmt.Printf("Region:                   %s\n", estimate.Region)
+	fmt.Printf("Currency:                 %s\n", estimate.Currency)
+	fmt.Printf("Hours per month:          %d\n", estimate.HoursPerMonth)
+	fmt.Println()
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "POOL\tROLE\tINSTANCE_TYPE\tNODES\tPRICING\tHOURLY_PER_NODE\tMONTHLY\n")
+	for _, pool := range estimate.Pools {
+		pricing := "on-demand"
+		if pool.Spot {
+			pricing = "spot"
+		}
+		hourly := "-"
+		monthly := "-"
+		if pool.Priced {
+			hourly = fmt.Sprintf("%.4f", pool.HourlyPrice+pool.HourlyFee)
+			monthly = formatRange(pool.MinMonthlyCost, pool.MaxMonthlyCost)
+		}
+		nodes := fmt.Sprintf("%d", pool.MinNodes)
+		if pool.MinNodes != pool.MaxNodes {
+			nodes = fmt.Sprintf("%d-%d", pool.MinNodes, pool.MaxNodes)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			pool.Name, pool.Role, pool.InstanceType, nodes, pricing, hourly, monthly)
+	}
+	writer.Flush()
+	fmt.Println()
+
+	if estimate.HostedControlPlane > 0 {
+		fmt.Printf("Hosted control plane:     %.2f %s\n", estimate.HostedControlPlane, estimate.Currency)
+	}
+	total := fmt.Sprintf("%s %s", formatRange(estimate.MinMonthlyCost, estimate.MaxMonthlyCost),
+		estimate.Currency)
+	if !estimate.Complete() {
+		total += " (incomplete, some pools have no price)"
+	}
+	fmt.Printf("Monthly total:            %s\n", total)
+}
+
+func formatRange(minimum float64, maximum float64) string {
+	if minimum == maximum {
+		return fmt.Sprintf("%.2f", minimum)
+	}
+	return fmt.Sprintf("%.2f - %.2f", minimum, maximum)
+}
@@ -0,0 +1,138 @@
+package cost
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const prices = `region,instance_type,on_demand,spot,worker_fee
+us-east-1,m5.xlarge,0.2,0.05,0.1
+us-east-1,m5.2xlarge,0.4,,0.2
+us-east-1,r5.xlarge,0.25,,
+*,hosted-control-plane,0.25,,
+`
+
+var _ = Describe("estimate cost", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewEstimateCostCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+
+		Expect(cmd.Flags().Lookup(priceCatalogueFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(specFileFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+	})
+
+	Context("Estimate Cost Runner", func() {
+		var (
+			t         *TestingRuntime
+			catalogue string
+			dir       string
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			dir = GinkgoT().TempDir()
+			catalogue = filepath.Join(dir, "prices.csv")
+			Expect(os.WriteFile(catalogue, []byte(prices), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			t.SetCluster("", nil)
+			output.SetOutput("")
+		})
+
+		It("Estimates a cluster spec file", func() {
+			spec := filepath.Join(dir, "cluster.yaml")
+			Expect(os.WriteFile(spec, []byte(`region: us-east-1
+machine_pools:
+- name: workers
+  instance_type: m5.xlarge
+  replicas: 3
+- name: batch
+  instance_type: m5.xlarge
+  min_replicas: 0
+  max_replicas: 4
+  spot: true
+`), 0600)).To(Succeed())
+
+			t.StdOutReader.Record()
+			runner := EstimateCostRunner(&EstimateCostOptions{PriceCatalogue: catalogue, SpecFile: spec})
+			err := runner(context.Background(), t.RosaRuntime, NewEstimateCostCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal(`Region:                   us-east-1
+Currency:                 USD
+Hours per month:          730
+
+POOL           ROLE           INSTANCE_TYPE  NODES  PRICING    HOURLY_PER_NODE  MONTHLY
+control-plane  control-plane  m5.2xlarge     3      on-demand  0.4000           876.00
+infra          infra          r5.xlarge      2      on-demand  0.2500           365.00
+workers        worker         m5.xlarge      3      on-demand  0.3000           657.00
+batch          worker         m5.xlarge      0-4    spot       0.1500           0.00 - 438.00
+
+Monthly total:            1898.00 - 2336.00 USD
+`))
+		})
+
+		It("Estimates the node pools of an existing hosted cluster", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			})
+			t.SetCluster("mycluster", cluster)
+			nodePool, err := cmv1.NewNodePool().ID("workers").Replicas(2).
+				AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.2xlarge")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatNodePoolList([]*cmv1.NodePool{nodePool})))
+
+			cmd := NewEstimateCostCommand()
+			Expect(cmd.Flags().Set("cluster", "mycluster")).To(Succeed())
+			output.SetOutput("json")
+			t.StdOutReader.Record()
+			runner := EstimateCostRunner(&EstimateCostOptions{PriceCatalogue: catalogue})
+			err = runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring(`"hosted_control_plane_monthly_cost": 182.5`))
+			Expect(stdOut).To(ContainSubstring(`"min_monthly_cost": 1058.5`))
+			Expect(stdOut).To(ContainSubstring(`"name": "workers"`))
+		})
+
+		It("Rejects spec flags together with an existing cluster", func() {
+			t.SetCluster("mycluster", MockCluster(func(c *cmv1.ClusterBuilder) {}))
+			cmd := NewEstimateCostCommand()
+			Expect(cmd.Flags().Set("cluster", "mycluster")).To(Succeed())
+			Expect(cmd.Flags().Set(replicasFlag, "3")).To(Succeed())
+			runner := EstimateCostRunner(&EstimateCostOptions{PriceCatalogue: catalogue, Replicas: 3})
+			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("'--replicas' can only be used to describe a new cluster, it can't be " +
+				"combined with '--cluster' or '--spec-file'"))
+		})
+
+		It("Requires the region of a new cluster", func() {
+			runner := EstimateCostRunner(&EstimateCostOptions{
+				PriceCatalogue:     catalogue,
+				ComputeMachineType: defaultComputeMachineType,
+			})
+			err := runner(context.Background(), t.RosaRuntime, NewEstimateCostCommand(), nil)
+			Expect(err).To(MatchError("Expected the region of the cluster to be set with '--region'"))
+		})
+	})
+})
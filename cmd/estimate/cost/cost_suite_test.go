@@ -0,0 +1,13 @@
+package cost
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestEstimateCost(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Estimate Cost Suite")
+}
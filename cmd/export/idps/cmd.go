@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idps
+
+import (
+	"context"
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/idp"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "idps"
+	short = "Export the identity providers of a cluster"
+	long  = "Export the identity providers of a cluster as a YAML document that 'rosa create idps --from-file' " +
+		"applies to other clusters. Secrets aren't returned by the API, they are exported as placeholders " +
+		"that are resolved from environment variables or files when the identity providers are created."
+	example = `  # Export the identity providers of a cluster named 'mycluster' to a file
+  rosa export idps --cluster=mycluster > idps.yaml
+
+  # Create the same identity providers on a cluster named 'newcluster'
+  export GITHUB_1_CLIENT_SECRET=...
+  rosa create idps --cluster=newcluster --from-file=idps.yaml`
+)
+
+func NewExportIdpsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"idp"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ExportIdpsRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	return cmd
+}
+
+func ExportIdpsRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		if cluster.State() != cmv1.ClusterStateReady &&
+			cluster.State() != cmv1.ClusterStateHibernating {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Exporting identity providers is not supported for clusters with external " +
+				"authentication configured")
+		}
+
+		r.Reporter.Debugf("Loading identity providers for cluster '%s'", clusterKey)
+		ocmIdps, err := r.OCMClient.GetIdentityProviders(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get identity providers for cluster '%s': %v", clusterKey, err)
+		}
+
+		idps := []*idp.IdentityProvider{}
+		for _, ocmIdp := range ocmIdps {
+			if ocmIdp.Name() == idp.ClusterAdminName {
+				r.Reporter.Warnf("Skipping identity provider '%s', use 'rosa create admin' to create the "+
+					"cluster admin", ocmIdp.Name())
+				continue
+			}
+			var users []*cmv1.HTPasswdUser
+			if ocmIdp.Type() == cmv1.IdentityProviderTypeHtpasswd {
+				userList, err := r.OCMClient.GetHTPasswdUserList(cluster.ID(), ocmIdp.ID())
+				if err != nil {
+					return fmt.Errorf("Failed to get the users of identity provider '%s': %v", ocmIdp.Name(), err)
+				}
+				users = userList.Slice()
+			}
+			idps = append(idps, idp.NewIdentityProvider(ocmIdp, users))
+		}
+		if len(idps) == 0 {
+			return fmt.Errorf("There are no identity providers to export for cluster '%s'", clusterKey)
+		}
+
+		data, err := idp.Export(idps)
+		if err != nil {
+			return fmt.Errorf("Failed to export identity providers: %v", err)
+		}
+		fmt.Print(string(data))
+		return nil
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idps
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("export idps", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewExportIdpsCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			}))
+		})
+
+		It("Exports the identity providers and the users of HTPasswd providers", func() {
+			admin, err := cmv1.NewIdentityProvider().ID("a1").Name("cluster-admin").
+				Type(cmv1.IdentityProviderTypeHtpasswd).Build()
+			Expect(err).NotTo(HaveOccurred())
+			htpasswd, err := cmv1.NewIdentityProvider().ID("h1").Name("htpasswd-1").
+				Type(cmv1.IdentityProviderTypeHtpasswd).Build()
+			Expect(err).NotTo(HaveOccurred())
+			google, err := cmv1.NewIdentityProvider().ID("g1").Name("google-1").
+				Type(cmv1.IdentityProviderTypeGoogle).MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+				Google(cmv1.NewGoogleIdentityProvider().ClientID("client").HostedDomain("example.com")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			user, err := cmv1.NewHTPasswdUser().Username("jane").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{admin, htpasswd, google})),
+				RespondWithJSON(http.StatusOK, FormatHtpasswdUserList([]*cmv1.HTPasswdUser{user})),
+			)
+
+			t.StdOutReader.Record()
+			err = ExportIdpsRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(HaveSuffix(`identity_providers:
+- htpasswd:
+    users:
+    - password: ${env:HTPASSWD_1_JANE_PASSWORD}
+      username: jane
+  name: htpasswd-1
+  type: htpasswd
+- google:
+    client_id: client
+    client_secret: ${env:GOOGLE_1_CLIENT_SECRET}
+    hosted_domain: example.com
+  mapping_method: claim
+  name: google-1
+  type: google
+`))
+			Expect(stdOut).NotTo(ContainSubstring("cluster-admin"))
+		})
+
+		It("Fails when there are no identity providers to export", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatIDPList([]*cmv1.IdentityProvider{})),
+			)
+			err := ExportIdpsRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("There are no identity providers to export for cluster 'cluster'"))
+		})
+
+		It("Fails when the cluster isn't ready", func() {
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateInstalling)
+			}))
+			err := ExportIdpsRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Cluster 'cluster' is not yet ready"))
+		})
+	})
+})
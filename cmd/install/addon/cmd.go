@@ -30,6 +30,7 @@ import (
 	"github.com/spf13/cobra"
 	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/pkg/addon"
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/aws/tags"
@@ -43,11 +44,13 @@ import (
 const (
 	billingModelFlag          = "billing-model"
 	billingModelAccountIDFlag = "billing-model-account-id"
+	paramsFileFlag            = "params-file"
 )
 
 var args struct {
 	billingModel          string
 	billingModelAccountID string
+	paramsFile            string
 }
 
 var Cmd = &cobra.Command{
@@ -56,7 +59,10 @@ var Cmd = &cobra.Command{
 	Short:   "Install add-ons on cluster",
 	Long:    "Install Red Hat managed add-ons on a cluster",
 	Example: `  # Add the CodeReady Workspaces add-on installation to the cluster
-  rosa install addon --cluster=mycluster codeready-workspaces`,
+  rosa install addon --cluster=mycluster codeready-workspaces
+
+  # Install the add-on with the parameters in a file, parameter flags override the file
+  rosa install addon --cluster=mycluster codeready-workspaces --params-file=params.yaml`,
 	Run:                run,
 	DisableFlagParsing: true,
 	Args: func(cmd *cobra.Command, argv []string) error {
@@ -89,6 +95,14 @@ func init() {
 		"Account ID of associated billing model for the addon installation resource",
 	)
 
+	flags.StringVar(
+		&args.paramsFile,
+		paramsFileFlag,
+		"",
+		"Path to a YAML file with the values of the add-on parameters by parameter ID. "+
+			"The values are validated against the parameters of the add-on before installing it.",
+	)
+
 	confirm.AddFlag(flags)
 	ocm.AddClusterFlag(Cmd)
 }
@@ -124,6 +138,22 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Warnf("Addon '%s' needs access to resources in account '%s'", addOnID, r.Creator.AccountID)
 	}
 
+	addonParameters, err := r.OCMClient.GetAddOnParameters(cluster.ID(), addOnID)
+	if err != nil {
+		r.Reporter.Errorf("Failed to get add-on '%s' parameters: %v", addOnID, err)
+		os.Exit(1)
+	}
+
+	// Parameters from a file are validated before anything is created for the add-on
+	var addonArguments []ocm.AddOnParam
+	if args.paramsFile != "" {
+		addonArguments, err = addon.ParamsFromFile(cmd.Flags(), addonParameters, args.paramsFile, nil)
+		if err != nil {
+			r.Reporter.Errorf("Invalid parameters for add-on '%s': %v", addOnID, err)
+			os.Exit(1)
+		}
+	}
+
 	if !confirm.Confirm("install add-on '%s' on cluster '%s'", addOnID, clusterKey) {
 		os.Exit(0)
 	}
@@ -168,14 +198,7 @@ func run(cmd *cobra.Command, argv []string) {
 		}
 	}
 
-	addonParameters, err := r.OCMClient.GetAddOnParameters(cluster.ID(), addOnID)
-	if err != nil {
-		r.Reporter.Errorf("Failed to get add-on '%s' parameters: %v", addOnID, err)
-		os.Exit(1)
-	}
-
-	var addonArguments []ocm.AddOnParam
-	if addonParameters.Len() > 0 {
+	if args.paramsFile == "" && addonParameters.Len() > 0 {
 		// Determine if all required parameters have already been set as flags and ensure
 		// that interactive mode is enabled if they have not. If there are no parameters
 		// set as flags, then we also ensure that interactive mode is enabled so that the
@@ -0,0 +1,13 @@
+package capacityreservations
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCapacityReservations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "List CapacityReservations Suite")
+}
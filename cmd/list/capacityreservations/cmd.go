@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservations
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "capacity-reservations"
+	short = "List capacity reservations"
+	long  = "List the EC2 On-Demand Capacity Reservations of the region with the machine pools that target " +
+		"them. The machine pools of the hosted clusters of the account in the region are listed, or only " +
+		"those of the given cluster."
+	example = `  # List the capacity reservations of region us-east-1
+  rosa list capacity-reservations --region us-east-1
+
+  # List the capacity reservations and the machine pools of cluster "mycluster" that target them
+  rosa list capacity-reservations --region us-east-1 --cluster mycluster`
+)
+
+var aliases = []string{"capacityreservations", "capacity-reservation", "capacityreservation"}
+
+// capacityReservation is a capacity reservation and the machine pools, '<cluster>/<machine pool>', that
+// target it
+type capacityReservation struct {
+	ID                 string   `json:"id"`
+	InstanceType       string   `json:"instance_type"`
+	AvailabilityZone   string   `json:"availability_zone"`
+	State              string   `json:"state"`
+	TotalInstances     int32    `json:"total_instances"`
+	AvailableInstances int32    `json:"available_instances"`
+	MachinePools       []string `json:"machine_pools"`
+}
+
+func NewListCapacityReservationsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Aliases: aliases,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), ListCapacityReservationsRunner()),
+	}
+
+	ocm.AddOptionalClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ListCapacityReservationsRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		clusters := []*cmv1.Cluster{}
+		if cmd.Flags().Changed("cluster") {
+			cluster := r.FetchCluster()
+			if !cluster.Hypershift().Enabled() {
+				return fmt.Errorf("Capacity reservations are only supported for Hosted Control Plane machine pools")
+			}
+			clusters = append(clusters, cluster)
+		} else {
+			r.Reporter.Debugf("Loading the clusters of the account")
+			all, err := r.OCMClient.GetAllClusters(r.Creator)
+			if err != nil {
+				return fmt.Errorf("Failed to get clusters: %v", err)
+			}
+			for _, cluster := range all {
+				if cluster.Hypershift().Enabled() && cluster.Region().ID() == r.AWSClient.GetRegion() {
+					clusters = append(clusters, cluster)
+				}
+			}
+		}
+
+		consumers := map[string][]string{}
+		for _, cluster := range clusters {
+			nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get machine pools for cluster '%s': %v", cluster.Name(), err)
+			}
+			for id, machinePools := range machinepool.CapacityReservationConsumers(nodePools) {
+				for _, machinePool := range machinePools {
+					consumers[id] = append(consumers[id], fmt.Sprintf("%s/%s", cluster.Name(), machinePool))
+				}
+			}
+		}
+
+		r.Reporter.Debugf("Loading the capacity reservations of region '%s'", r.AWSClient.GetRegion())
+		reservations, err := r.AWSClient.ListCapacityReservations()
+		if err != nil {
+			return fmt.Errorf("Failed to get capacity reservations: %v", err)
+		}
+		result := []capacityReservation{}
+		for _, reservation := range reservations {
+			id := awssdk.ToString(reservation.CapacityReservationId)
+			machinePools := consumers[id]
+			if machinePools == nil {
+				machinePools = []string{}
+			}
+			sort.Strings(machinePools)
+			result = append(result, capacityReservation{
+				ID:                 id,
+				InstanceType:       awssdk.ToString(reservation.InstanceType),
+				AvailabilityZone:   awssdk.ToString(reservation.AvailabilityZone),
+				State:              string(reservation.State),
+				TotalInstances:     awssdk.ToInt32(reservation.TotalInstanceCount),
+				AvailableInstances: awssdk.ToInt32(reservation.AvailableInstanceCount),
+				MachinePools:       machinePools,
+			})
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].ID < result[j].ID
+		})
+
+		if output.HasFlag() {
+			return output.Print(result)
+		}
+		if len(result) == 0 {
+			r.Reporter.Infof("There are no capacity reservations in region '%s'", r.AWSClient.GetRegion())
+			return nil
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "ID\tINSTANCE TYPE\tAVAILABILITY ZONE\tSTATE\tAVAILABLE\tMACHINE POOLS\n")
+		for _, reservation := range result {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d/%d\t%s\n",
+				reservation.ID,
+				reservation.InstanceType,
+				reservation.AvailabilityZone,
+				reservation.State,
+				reservation.AvailableInstances,
+				reservation.TotalInstances,
+				strings.Join(reservation.MachinePools, ", "),
+			)
+		}
+		return writer.Flush()
+	}
+}
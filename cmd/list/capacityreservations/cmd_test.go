@@ -0,0 +1,107 @@
+package capacityreservations
+
+import (
+	"context"
+	"net/http"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("list capacity-reservations", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewListCapacityReservationsCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Aliases).To(ContainElements(aliases))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("List capacity reservations runner", func() {
+		var t *TestingRuntime
+		var awsClient *aws.MockClient
+
+		cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Name("cluster")
+			c.State(cmv1.ClusterStateReady)
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+		nodePool, err := cmv1.NewNodePool().ID("gpu").AWSNodePool(cmv1.NewAWSNodePool().InstanceType("p4d.24xlarge").
+			CapacityReservation(cmv1.NewAWSCapacityReservation().Id("cr-1"))).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			awsClient.EXPECT().GetRegion().Return("us-east-1").AnyTimes()
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+			t.SetCluster("", nil)
+		})
+
+		It("Lists the reservations with the machine pools of the cluster that target them", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatNodePoolList([]*cmv1.NodePool{nodePool})))
+			t.SetCluster("cluster", cluster)
+			awsClient.EXPECT().ListCapacityReservations().Return([]ec2types.CapacityReservation{
+				{
+					CapacityReservationId:  awssdk.String("cr-2"),
+					InstanceType:           awssdk.String("m5.xlarge"),
+					AvailabilityZone:       awssdk.String("us-east-1b"),
+					State:                  ec2types.CapacityReservationStateActive,
+					TotalInstanceCount:     awssdk.Int32(2),
+					AvailableInstanceCount: awssdk.Int32(2),
+				},
+				{
+					CapacityReservationId:  awssdk.String("cr-1"),
+					InstanceType:           awssdk.String("p4d.24xlarge"),
+					AvailabilityZone:       awssdk.String("us-east-1a"),
+					State:                  ec2types.CapacityReservationStateActive,
+					TotalInstanceCount:     awssdk.Int32(4),
+					AvailableInstanceCount: awssdk.Int32(1),
+				},
+			}, nil)
+
+			cmd := NewListCapacityReservationsCommand()
+			Expect(cmd.Flags().Set("cluster", "cluster")).To(Succeed())
+			Expect(t.StdOutReader.Record()).To(Succeed())
+			err := ListCapacityReservationsRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, err := t.StdOutReader.Read()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal(
+				"ID    INSTANCE TYPE  AVAILABILITY ZONE  STATE   AVAILABLE  MACHINE POOLS\n" +
+					"cr-1  p4d.24xlarge   us-east-1a         active  1/4        cluster/gpu\n" +
+					"cr-2  m5.xlarge      us-east-1b         active  2/2        \n"))
+		})
+
+		It("Rejects classic clusters", func() {
+			classic := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Hypershift(cmv1.NewHypershift().Enabled(false))
+			})
+			t.SetCluster("cluster", classic)
+
+			cmd := NewListCapacityReservationsCommand()
+			Expect(cmd.Flags().Set("cluster", "cluster")).To(Succeed())
+			err := ListCapacityReservationsRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("Capacity reservations are only supported for Hosted Control Plane " +
+				"machine pools"))
+		})
+	})
+})
@@ -23,10 +23,12 @@ import (
 	"github.com/openshift/rosa/cmd/list/accountroles"
 	"github.com/openshift/rosa/cmd/list/addon"
 	"github.com/openshift/rosa/cmd/list/breakglasscredential"
+	"github.com/openshift/rosa/cmd/list/capacityreservations"
 	"github.com/openshift/rosa/cmd/list/cluster"
 	"github.com/openshift/rosa/cmd/list/dnsdomains"
 	"github.com/openshift/rosa/cmd/list/externalauthprovider"
 	"github.com/openshift/rosa/cmd/list/gates"
+	"github.com/openshift/rosa/cmd/list/groups"
 	"github.com/openshift/rosa/cmd/list/iamserviceaccounts"
 	"github.com/openshift/rosa/cmd/list/idp"
 	"github.com/openshift/rosa/cmd/list/imagemirrors"
@@ -35,6 +37,8 @@ import (
 	"github.com/openshift/rosa/cmd/list/kubeletconfig"
 	"github.com/openshift/rosa/cmd/list/logforwarders"
 	"github.com/openshift/rosa/cmd/list/machinepool"
+	"github.com/openshift/rosa/cmd/list/networks"
+	"github.com/openshift/rosa/cmd/list/networktemplates"
 	"github.com/openshift/rosa/cmd/list/ocmroles"
 	"github.com/openshift/rosa/cmd/list/oidcconfig"
 	"github.com/openshift/rosa/cmd/list/oidcprovider"
@@ -61,6 +65,8 @@ func init() {
 	Cmd.AddCommand(addon.Cmd)
 	Cmd.AddCommand(cluster.Cmd)
 	Cmd.AddCommand(gates.Cmd)
+	groupsCommand := groups.NewListGroupsCommand()
+	Cmd.AddCommand(groupsCommand)
 	Cmd.AddCommand(iamserviceaccounts.Cmd)
 	Cmd.AddCommand(idp.Cmd)
 	imageMirrorsCommand := imagemirrors.NewListImageMirrorsCommand()
@@ -91,6 +97,10 @@ func init() {
 	Cmd.AddCommand(logforwardersCommand)
 	accessrequest := accessrequests.NewListAccessRequestsCommand()
 	Cmd.AddCommand(accessrequest)
+	networkTemplatesCommand := networktemplates.NewListNetworkTemplatesCommand()
+	Cmd.AddCommand(networkTemplatesCommand)
+	Cmd.AddCommand(networks.NewListNetworksCommand())
+	Cmd.AddCommand(capacityreservations.NewListCapacityReservationsCommand())
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
 	arguments.AddRegionFlag(flags)
@@ -105,6 +115,7 @@ func init() {
 		operatorroles.Cmd, region.Cmd, rhRegion.Cmd,
 		service.Cmd, tuningconfigs.Cmd, upgrade.Cmd,
 		user.Cmd, version.Cmd, kubeletconfig, logforwardersCommand, accessrequest,
+		networkTemplatesCommand, groupsCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "groups"
+	short   = "List cluster groups"
+	long    = "List the groups of a cluster and their users."
+	example = `  # List the groups of a cluster named "mycluster"
+  rosa list groups --cluster=mycluster`
+)
+
+var aliases = []string{"group"}
+
+func NewListGroupsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListGroupsRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ListGroupsRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		if cluster.State() != cmv1.ClusterStateReady &&
+			cluster.State() != cmv1.ClusterStateHibernating {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Listing cluster groups is not supported for clusters with external " +
+				"authentication configured")
+		}
+
+		r.Reporter.Debugf("Loading groups for cluster '%s'", clusterKey)
+		groups, err := r.OCMClient.GetGroups(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get groups for cluster '%s': %v", clusterKey, err)
+		}
+
+		if output.HasFlag() {
+			return output.Print(groups)
+		}
+
+		if len(groups) == 0 {
+			r.Reporter.Infof("There are no groups configured for cluster '%s'", clusterKey)
+			return nil
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "ID\tUSERS\n")
+		for _, group := range groups {
+			users := []string{}
+			for _, user := range group.Users().Slice() {
+				users = append(users, user.ID())
+			}
+			sort.Strings(users)
+			fmt.Fprintf(writer, "%s\t%s\n", group.ID(), strings.Join(users, ", "))
+		}
+		return writer.Flush()
+	}
+}
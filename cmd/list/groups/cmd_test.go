@@ -0,0 +1,96 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groups
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("list groups", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewListGroupsCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Aliases).To(Equal(aliases))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			}))
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Lists the groups and their users", func() {
+			dedicatedAdmins, err := cmv1.NewGroup().ID("dedicated-admins").
+				Users(cmv1.NewUserList().Items(cmv1.NewUser().ID("bob"), cmv1.NewUser().ID("alice"))).Build()
+			Expect(err).NotTo(HaveOccurred())
+			clusterAdmins, err := cmv1.NewGroup().ID("cluster-admins").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatGroupList([]*cmv1.Group{clusterAdmins, dedicatedAdmins})),
+			)
+
+			t.StdOutReader.Record()
+			err = ListGroupsRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("ID                USERS\n" +
+				"cluster-admins    \n" +
+				"dedicated-admins  alice, bob\n"))
+		})
+
+		It("Reports clusters without groups", func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatGroupList([]*cmv1.Group{})))
+
+			t.StdOutReader.Record()
+			err := ListGroupsRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: There are no groups configured for cluster 'cluster'\n"))
+		})
+
+		It("Fails when the cluster uses external authentication", func() {
+			t.SetCluster("cluster", MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.ExternalAuthConfig(cmv1.NewExternalAuthConfig().Enabled(true))
+			}))
+			err := ListGroupsRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("not supported for clusters with external authentication")))
+		})
+	})
+})
@@ -26,17 +26,25 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/imagemirror"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
 const (
-	use     = "image-mirrors"
-	short   = "List cluster image mirrors"
-	long    = "List image mirror configurations for a Hosted Control Plane cluster."
+	use   = "image-mirrors"
+	short = "List cluster image mirrors"
+	long  = "List image mirror configurations for a Hosted Control Plane cluster. With '-o idms' the image " +
+		"mirrors are exported as an ImageDigestMirrorSet manifest that 'rosa create image-mirrors --from-file' " +
+		"accepts, followed by an ImageTagMirrorSet manifest if the cluster has tag mirrors."
 	example = `  # List all image mirrors on a cluster named "mycluster"
-  rosa list image-mirrors --cluster=mycluster`
+  rosa list image-mirrors --cluster=mycluster
+
+  # Export the image mirrors of cluster "mycluster" as an ImageDigestMirrorSet
+  rosa list image-mirrors --cluster=mycluster -o idms > idms.yaml`
+
+	idmsFormat = "idms"
 )
 
 var (
@@ -56,6 +64,8 @@ func NewListImageMirrorsCommand() *cobra.Command {
 	}
 
 	output.AddFlag(cmd)
+	cmd.Flags().Lookup(output.FLAG_NAME).Usage = fmt.Sprintf("Output format. Allowed formats are %s",
+		[]string{output.JSON, output.YAML, idmsFormat})
 	ocm.AddClusterFlag(cmd)
 	arguments.AddProfileFlag(cmd.Flags())
 	arguments.AddRegionFlag(cmd.Flags())
@@ -75,6 +85,15 @@ func ListImageMirrorsRunner(options *ListImageMirrorsOptions) rosa.CommandRunner
 			return fmt.Errorf("failed to list image mirrors: %v", err)
 		}
 
+		if output.Output() == idmsFormat {
+			manifests, err := imagemirror.Manifests(cluster.Name(), imageMirrors)
+			if err != nil {
+				return fmt.Errorf("failed to export image mirrors: %v", err)
+			}
+			fmt.Print(string(manifests))
+			return nil
+		}
+
 		if output.HasFlag() {
 			return output.Print(imageMirrors)
 		}
@@ -124,6 +124,49 @@ var _ = Describe("List image mirrors", func() {
 				Expect(stdout).To(ContainSubstring("["))
 				Expect(stdout).To(ContainSubstring("]"))
 			})
+
+			It("Exports the image mirrors as manifests with the idms output", func() {
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hypershiftClusterReady))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, multipleImageMirrorsResponse))
+				runner := ListImageMirrorsRunner(NewListImageMirrorsOptions())
+				err := t.StdOutReader.Record()
+				Expect(err).ToNot(HaveOccurred())
+				cmd := NewListImageMirrorsCommand()
+				err = cmd.Flag("cluster").Value.Set(clusterId)
+				Expect(err).ToNot(HaveOccurred())
+				err = cmd.Flag("output").Value.Set("idms")
+				Expect(err).ToNot(HaveOccurred())
+				err = runner(context.Background(), t.RosaRuntime, cmd, []string{})
+				Expect(err).ToNot(HaveOccurred())
+				stdout, err := t.StdOutReader.Read()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stdout).To(Equal(fmt.Sprintf(`apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+metadata:
+  name: %[1]s
+spec:
+  imageDigestMirrors:
+  - mirrors:
+    - mirror.example.com
+    source: registry.redhat.io
+---
+apiVersion: config.openshift.io/v1
+kind: ImageTagMirrorSet
+metadata:
+  name: %[1]s
+spec:
+  imageTagMirrors:
+  - mirrors:
+    - mirror1.com
+    - mirror2.com
+    source: quay.io/openshift
+`, mockClusterReady.Name())))
+			})
+
+			It("Lists the idms output in the help of the output flag", func() {
+				cmd := NewListImageMirrorsCommand()
+				Expect(cmd.Flag("output").Usage).To(Equal("Output format. Allowed formats are [json yaml idms]"))
+			})
 		})
 
 		Context("Error scenarios", func() {
@@ -19,6 +19,7 @@ package instancetypes
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -27,6 +28,7 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/instancetype"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
@@ -44,7 +46,13 @@ func makeCmd() *cobra.Command {
 		Short:   "List Instance types",
 		Long:    "List Instance types that are available for use with ROSA.",
 		Example: `  # List all instance types
-	rosa list instance-types`,
+	rosa list instance-types
+
+	# List the memory optimized instance types with at least 16 cores and 128 GiB of memory
+	rosa list instance-types --category memory_optimized --min-cpu 16 --min-memory 128Gi
+
+	# List the GPU instance types offered in an availability zone
+	rosa list instance-types --gpu --region us-east-1 --available-in-az us-east-1a`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -62,10 +70,17 @@ var args struct {
 	externalId           string
 	hostedClusterEnabled bool
 	withFeatures         []string
+	minCPU               int
+	minMemory            string
+	categories           []string
+	architecture         string
+	gpu                  bool
+	availableInAZ        []string
 }
 
 const (
 	InstallerRoleArnFlag = "role-arn"
+	availableInAZFlag    = "available-in-az"
 )
 
 func initFlags(cmd *cobra.Command) {
@@ -93,6 +108,51 @@ func initFlags(cmd *cobra.Command) {
 		Run 'rosa list instancetypes -ojson | "jq .[0].features | keys"' for a full list of instance type features.`,
 	)
 
+	flags.IntVar(
+		&args.minCPU,
+		"min-cpu",
+		0,
+		"Only list instance types with at least this number of CPU cores.",
+	)
+
+	flags.StringVar(
+		&args.minMemory,
+		"min-memory",
+		"",
+		"Only list instance types with at least this amount of memory (e.g., '64Gi'). "+
+			"Numbers without a unit are GiB.",
+	)
+
+	flags.StringSliceVar(
+		&args.categories,
+		"category",
+		nil,
+		fmt.Sprintf("Only list instance types of these categories. Allowed values are %s.",
+			strings.Join(instancetype.Categories, ", ")),
+	)
+
+	flags.StringVar(
+		&args.architecture,
+		"arch",
+		"",
+		fmt.Sprintf("Only list instance types of this processor architecture. Allowed values are %s.",
+			strings.Join(instancetype.Architectures, ", ")),
+	)
+
+	flags.BoolVar(
+		&args.gpu,
+		"gpu",
+		false,
+		"Only list accelerated computing instance types with GPUs.",
+	)
+
+	flags.StringSliceVar(
+		&args.availableInAZ,
+		availableInAZFlag,
+		nil,
+		"Only list instance types offered in these availability zones of the region given with '--region'.",
+	)
+
 	// normalizing installer role argument to support deprecated flag
 	flags.SetNormalizeFunc(arguments.NormalizeFlags)
 	flags.StringVar(
@@ -104,6 +164,14 @@ func initFlags(cmd *cobra.Command) {
 
 	arguments.AddRegionFlag(flags)
 	cmd.MarkFlagsMutuallyExclusive("with-feature", "region")
+	cmd.RegisterFlagCompletionFunc("category", func(_ *cobra.Command, _ []string, _ string) ([]string,
+		cobra.ShellCompDirective) {
+		return instancetype.Categories, cobra.ShellCompDirectiveDefault
+	})
+	cmd.RegisterFlagCompletionFunc("arch", func(_ *cobra.Command, _ []string, _ string) ([]string,
+		cobra.ShellCompDirective) {
+		return instancetype.Architectures, cobra.ShellCompDirectiveDefault
+	})
 	output.AddFlag(cmd)
 	confirm.AddFlag(flags)
 }
@@ -130,6 +198,14 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	if err := validateChangedSTSExternalIDFlag(cmd, args.externalId); err != nil {
 		return fmt.Errorf("expected a valid STS external ID: %w", err)
 	}
+	filter, err := buildFilter()
+	if err != nil {
+		return err
+	}
+	if len(args.availableInAZ) > 0 && !cmd.Flags().Changed("region") {
+		return fmt.Errorf("'--%s' requires the region of the availability zones to be set with '--region'",
+			availableInAZFlag)
+	}
 	checkInteractiveModeNeeded(cmd)
 	r.Reporter.Debugf("Fetching instance types")
 	var machineTypes ocm.MachineTypeList
@@ -144,7 +220,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 					r.AWSClient.FindRoleARNs,
 				)
 		}
-		var availabilityZones []string
+		availabilityZones := args.availableInAZ
 		roleArn := ""
 		regionList, _, err := r.OCMClient.GetRegionList(false, args.installerRoleArn, args.externalId, "",
 			r.AWSClient, args.hostedClusterEnabled, false)
@@ -177,6 +253,9 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		machineTypes = availableMachineTypes
 	}
 
+	filtered := len(machineTypes.Items) > 0
+	machineTypes = filter.Apply(machineTypes)
+
 	if output.HasFlag() {
 		var instanceTypes []*cmv1.MachineType
 		for _, machine := range machineTypes.Items {
@@ -191,6 +270,9 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	}
 
 	if len(machineTypes.Items) == 0 {
+		if filtered {
+			return fmt.Errorf("there are no instance types that match the filters")
+		}
 		return fmt.Errorf("there are no machine types supported for your account. Contact Red Hat support")
 	}
 
@@ -215,6 +297,24 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	return nil
 }
 
+func buildFilter() (*instancetype.Filter, error) {
+	minMemory, err := instancetype.ParseMemory(args.minMemory)
+	if err != nil {
+		return nil, fmt.Errorf("expected a valid value for '--min-memory': %v", err)
+	}
+	filter := &instancetype.Filter{
+		MinCPU:       args.minCPU,
+		MinMemory:    minMemory,
+		Categories:   args.categories,
+		Architecture: args.architecture,
+		GPU:          args.gpu,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
 func ByteCountIEC(b int, uValue string) string {
 	var unit int
 	if uValue == "B" {
@@ -424,6 +424,48 @@ g4dn.12xlarge  accelerated_computing  48         192.0 GiB
 		Expect(stdout).To(ContainSubstring("t4-gpu-48"))
 	})
 
+	It("Filters instance types", func() {
+		Expect(cmd.Flags().Set("gpu", "true")).To(Succeed())
+		Expect(cmd.Flags().Set("min-memory", "128Gi")).To(Succeed())
+
+		// GET /api/clusters_mgmt/v1/machine_types
+		apiServer.AppendHandlers(RespondWithJSON(http.StatusOK, machinesSuccess))
+		// GET /api/accounts_mgmt/v1/current_account
+		apiServer.AppendHandlers(RespondWithJSON(http.StatusOK, currentAccount))
+		// GET /api/accounts_mgmt/v1/organizations/123abc/quota_cost
+		apiServer.AppendHandlers(RespondWithJSON(http.StatusOK, orgQuota))
+
+		stdout, stderr, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stderr).To(BeZero())
+		Expect(stdout).To(Equal("ID             CATEGORY               CPU_CORES  MEMORY\n" +
+			"g4dn.12xlarge  accelerated_computing  48         192.0 GiB\n"))
+	})
+
+	It("Fails when no instance type matches the filters", func() {
+		Expect(cmd.Flags().Set("min-cpu", "128")).To(Succeed())
+
+		apiServer.AppendHandlers(RespondWithJSON(http.StatusOK, machinesSuccess))
+		apiServer.AppendHandlers(RespondWithJSON(http.StatusOK, currentAccount))
+		apiServer.AppendHandlers(RespondWithJSON(http.StatusOK, orgQuota))
+
+		_, _, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
+		Expect(err).To(MatchError("there are no instance types that match the filters"))
+	})
+
+	It("Rejects an invalid --category", func() {
+		Expect(cmd.Flags().Set("category", "storage")).To(Succeed())
+		_, _, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
+		Expect(err).To(MatchError(ContainSubstring("invalid category 'storage'")))
+	})
+
+	It("Requires --region with --available-in-az", func() {
+		Expect(cmd.Flags().Set("available-in-az", "us-east-1a")).To(Succeed())
+		_, _, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
+		Expect(err).To(MatchError("'--available-in-az' requires the region of the availability zones " +
+			"to be set with '--region'"))
+	})
+
 	It("rejects an invalid --external-id", func() {
 		Expect(cmd.Flags().Set("external-id", "x")).To(Succeed())
 		_, _, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
@@ -37,7 +37,10 @@ const (
   rosa list machinepools --cluster=mycluster
   
   # List machine pools showing all information
-  rosa list machinepools --cluster=mycluster --all`
+  rosa list machinepools --cluster=mycluster --all
+
+  # List machine pools with their current replicas, zone distribution and status
+  rosa list machinepools --cluster=mycluster --wide`
 )
 
 var (
@@ -86,6 +89,14 @@ func NewListMachinePoolCommand() *cobra.Command {
 		"Show all additional information for each machine pool (equivalent to --az-type --dedicated-host --win-li)",
 	)
 
+	flags.BoolVar(
+		&args.ShowWide,
+		"wide",
+		false,
+		"Show the current replicas, zone distribution and spot interruptions of classic machine pools, "+
+			"or the state, status message and scheduled upgrade of hosted control plane machine pools",
+	)
+
 	output.AddFlag(cmd)
 	ocm.AddClusterFlag(cmd)
 	return cmd
@@ -101,6 +112,17 @@ func ListMachinePoolRunner() rosa.CommandRunner {
 			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
 		}
 
+		if args.ShowWide {
+			if output.HasFlag() {
+				return fmt.Errorf("'--wide' can't be used with '--output', use 'rosa describe machinepool " +
+					"--capacity --output' to get the capacity of a machine pool")
+			}
+			// Classic machine pools don't report their nodes, their EC2 instances are counted instead
+			if !cluster.Hypershift().Enabled() && runtime.AWSClient == nil {
+				runtime.WithAWS()
+			}
+		}
+
 		service := machinepool.NewMachinePoolService()
 		err := service.ListMachinePools(
 			runtime,
@@ -34,6 +34,8 @@ const (
 		"nodepool852  Yes          /100-1000  m5.xlarge      test=label              us-east-1a                 No              default    4.12.24  No          \n"
 )
 
+const emptyUpgradePolicies = "{\n  \"items\": [],\n  \"page\": 1,\n  \"size\": 0,\n  \"total\": 0\n}"
+
 var _ = Describe("List machine pool", func() {
 	Context("List machine pool command", func() {
 		// Full diff for long string to help debugging
@@ -99,6 +101,25 @@ var _ = Describe("List machine pool", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(stdout).To(Equal(multipleNodePoolsOutput))
 			})
+			It("Shows the state and scheduled upgrade columns when --wide flag is used", func() {
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hypershiftClusterReady))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolResponse))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, formatNodePoolResource()))
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, emptyUpgradePolicies))
+				runner := ListMachinePoolRunner()
+				err := t.StdOutReader.Record()
+				Expect(err).ToNot(HaveOccurred())
+				cmd := NewListMachinePoolCommand()
+				err = cmd.Flag("cluster").Value.Set(clusterId)
+				Expect(err).ToNot(HaveOccurred())
+				err = cmd.Flag("wide").Value.Set("true")
+				Expect(err).ToNot(HaveOccurred())
+				err = runner(context.Background(), t.RosaRuntime, cmd, []string{})
+				Expect(err).ToNot(HaveOccurred())
+				stdout, err := t.StdOutReader.Read()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stdout).To(ContainSubstring("AUTOREPAIR  STATE  MESSAGE  SCHEDULED UPGRADE"))
+			})
 		})
 		Context("ROSA Classic", func() {
 			It("Lists machinepool in classic cluster", func() {
@@ -157,6 +178,19 @@ var _ = Describe("List machine pool", func() {
 				Expect(stdout).To(ContainSubstring("DEDICATED HOST"))
 			})
 
+			It("Fails when --wide is used with --output", func() {
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, classicClusterReady))
+				runner := ListMachinePoolRunner()
+				cmd := NewListMachinePoolCommand()
+				err := cmd.Flag("cluster").Value.Set(clusterId)
+				Expect(err).ToNot(HaveOccurred())
+				err = cmd.Flag("wide").Value.Set("true")
+				Expect(err).ToNot(HaveOccurred())
+				SetOutput("json")
+				err = runner(context.Background(), t.RosaRuntime, cmd, []string{})
+				Expect(err).To(MatchError(ContainSubstring("'--wide' can't be used with '--output'")))
+			})
+
 			It("Shows AZ TYPE column when --az-type flag is used", func() {
 				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, classicClusterReady))
 				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, mpResponse))
@@ -354,6 +388,15 @@ func formatNodePool() string {
 		test.FormatResource(nodePool))
 }
 
+// formatNodePoolResource simulates the output of APIs for the fake node pool
+func formatNodePoolResource() string {
+	version := cmv1.NewVersion().ID("4.12.24").RawID("openshift-4.12.24")
+	nodePool, err := cmv1.NewNodePool().ID(nodePoolName).Version(version).
+		AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).AvailabilityZone("us-east-1a").Build()
+	Expect(err).ToNot(HaveOccurred())
+	return test.FormatResource(nodePool)
+}
+
 // formatNodePools simulates the output of APIs for a fake node pool list (multiple)
 func formatNodePools() string {
 	version := cmv1.NewVersion().ID("4.12.24").RawID("openshift-4.12.24")
@@ -0,0 +1,80 @@
+package networks
+
+import (
+	"context"
+	"time"
+
+	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("List networks", func() {
+	It("Creates the command correctly", func() {
+		cmd := NewListNetworksCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Aliases).To(ContainElements(aliases))
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("Command Runner", func() {
+		var (
+			t         *TestingRuntime
+			awsClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			output.SetOutput("")
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Reports when there are no network stacks", func() {
+			awsClient.EXPECT().ListCFStacks(gomock.Any()).Return([]cfTypes.Stack{
+				{StackName: awsSdk.String("osdCcsAdminIAMUser")},
+			}, nil)
+			awsClient.EXPECT().GetRegion().Return("us-east-1")
+
+			t.StdOutReader.Record()
+			err := ListNetworksRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: There are no network stacks in region 'us-east-1'\n"))
+		})
+
+		It("Lists the network stacks", func() {
+			awsClient.EXPECT().ListCFStacks(gomock.Any()).Return([]cfTypes.Stack{
+				{
+					StackName:    awsSdk.String("my-network"),
+					StackStatus:  cfTypes.StackStatusCreateComplete,
+					CreationTime: awsSdk.Time(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+					Tags: []cfTypes.Tag{
+						{Key: awsSdk.String(network.TemplateTagKey), Value: awsSdk.String(network.PrivateTemplateName)},
+					},
+					Outputs: []cfTypes.Output{{OutputKey: awsSdk.String("VPCId"), OutputValue: awsSdk.String("vpc-1")}},
+				},
+			}, nil)
+
+			t.StdOutReader.Record()
+			err := ListNetworksRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal(
+				"NAME        STATUS           TEMPLATE                     VPC ID  CREATED\n" +
+					"my-network  CREATE_COMPLETE  rosa-quickstart-private-vpc  vpc-1   2026-01-02T03:04:05Z\n"))
+		})
+	})
+})
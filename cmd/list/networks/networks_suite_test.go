@@ -0,0 +1,13 @@
+package networks
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestListNetworks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "List Networks Suite")
+}
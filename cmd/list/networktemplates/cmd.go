@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networktemplates
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/network"
+	opts "github.com/openshift/rosa/pkg/options/network"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "network-templates"
+	short = "List network templates"
+	long  = "List the CloudFormation templates that can be used by 'rosa create network'. " +
+		"The built-in templates are always available, custom templates are read from the directory " +
+		"given by '--template-dir' or the 'OCM_TEMPLATE_DIR' environment variable."
+	example = `  # List the built-in network templates
+  rosa list network-templates
+
+  # List the built-in templates and the custom templates of a directory
+  rosa list network-templates --template-dir ./templates`
+
+	templateDirFlag = "template-dir"
+)
+
+var aliases = []string{"network-template", "networktemplates", "networktemplate"}
+
+func NewListNetworkTemplatesCommand() *cobra.Command {
+	options := opts.NewNetworkUserOptions()
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Aliases: aliases,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), ListNetworkTemplatesRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.TemplateDir,
+		templateDirFlag,
+		options.TemplateDir,
+		"Directory of the custom templates, overriding the OCM_TEMPLATE_DIR environment variable.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ListNetworkTemplatesRunner(options *opts.NetworkUserOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		templates, err := network.ListTemplates(options.CustomTemplateDir())
+		if err != nil {
+			return fmt.Errorf("Failed to list network templates: %v", err)
+		}
+
+		if output.HasFlag() {
+			return output.Print(templates)
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(writer, "NAME\tSOURCE\tDESCRIPTION\n")
+		for _, template := range templates {
+			fmt.Fprintf(writer, "%s\t%s\t%s\n", template.Name, template.Source, summary(template.Description))
+		}
+		return writer.Flush()
+	}
+}
+
+// summary returns the first sentence of a template description
+func summary(description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	if i := strings.Index(description, ". "); i >= 0 {
+		return description[:i+1]
+	}
+	return description
+}
@@ -0,0 +1,79 @@
+package networktemplates
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	opts "github.com/openshift/rosa/pkg/options/network"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const customTemplate = `
+Description: Custom VPC. With a long description.
+Parameters:
+  Name:
+    Type: String
+`
+
+var _ = Describe("List network templates", func() {
+	Context("Create Command", func() {
+		It("Creates the command correctly", func() {
+			cmd := NewListNetworkTemplatesCommand()
+			Expect(cmd).NotTo(BeNil())
+			Expect(cmd.Use).To(Equal(use))
+			Expect(cmd.Aliases).To(ContainElements(aliases))
+			Expect(cmd.Flags().Lookup(templateDirFlag)).NotTo(BeNil())
+			Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+		})
+	})
+
+	Context("Command Runner", func() {
+		var t *TestingRuntime
+		var options *opts.NetworkUserOptions
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			options = &opts.NetworkUserOptions{TemplateDir: opts.DefaultTemplateDir}
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Lists the built-in templates", func() {
+			t.StdOutReader.Record()
+			err := ListNetworkTemplatesRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("NAME                            SOURCE    DESCRIPTION\n"))
+			Expect(stdOut).To(ContainSubstring("rosa-quickstart-default-vpc     built-in"))
+			Expect(stdOut).To(ContainSubstring("rosa-quickstart-private-vpc     built-in"))
+			Expect(stdOut).To(ContainSubstring("rosa-quickstart-tgw-egress-vpc  built-in"))
+		})
+
+		It("Lists the custom templates of the template directory", func() {
+			options.TemplateDir = GinkgoT().TempDir()
+			Expect(os.MkdirAll(filepath.Join(options.TemplateDir, "my-vpc"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(options.TemplateDir, "my-vpc", "cloudformation.yaml"),
+				[]byte(customTemplate), 0600)).To(Succeed())
+
+			t.StdOutReader.Record()
+			err := ListNetworkTemplatesRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("my-vpc                          custom    Custom VPC.\n"))
+		})
+
+		It("Fails when the template directory doesn't exist", func() {
+			options.TemplateDir = filepath.Join(GinkgoT().TempDir(), "missing")
+			err := ListNetworkTemplatesRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Failed to list network templates")))
+		})
+	})
+})
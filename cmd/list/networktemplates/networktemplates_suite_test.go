@@ -0,0 +1,13 @@
+package networktemplates
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestListNetworkTemplates(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "List Network Templates Suite")
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/machinepool"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/poolmigration"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "machinepool ID"
+	short = "Migrate a machine pool to a new instance type"
+	long  = "Moves the nodes of a machine pool to a new instance type without downtime. A new machine pool " +
+		"with the labels, taints, tuning and kubelet configs, subnets, disk size and replicas or autoscaling " +
+		"bounds of the machine pool is created with the new instance type. Once its nodes are ready the " +
+		"original machine pool is scaled down in steps, letting the workloads move to the new nodes, and " +
+		"deleted.\n\nThe state of the migration is read from the machine pools, if the command is " +
+		"interrupted run it again to resume the migration."
+	example = `  # Migrate machine pool 'workers' of cluster 'mycluster' to instance type r6i.2xlarge
+  rosa migrate machinepool workers --instance-type r6i.2xlarge -c mycluster
+
+  # Remove two nodes at a time and name the new machine pool
+  rosa migrate machinepool workers --instance-type r6i.2xlarge --name workers-r6i --step 2 -c mycluster`
+
+	instanceTypeFlag = "instance-type"
+	nameFlag         = "name"
+	stepFlag         = "step"
+	timeoutFlag      = "timeout"
+)
+
+var aliases = []string{"machinepools", "machine-pool", "machine-pools"}
+
+type MigrateMachinePoolOptions struct {
+	InstanceType string
+	Name         string
+	Step         int
+	Timeout      time.Duration
+}
+
+func NewMigrateMachinePoolCommand() *cobra.Command {
+	options := &MigrateMachinePoolOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.ExactArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), MigrateMachinePoolRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.InstanceType,
+		instanceTypeFlag,
+		"",
+		"Instance type of the new machine pool.",
+	)
+	flags.StringVar(
+		&options.Name,
+		nameFlag,
+		"",
+		"Name of the new machine pool. Defaults to the name of the machine pool followed by the instance type.",
+	)
+	flags.IntVar(
+		&options.Step,
+		stepFlag,
+		poolmigration.DefaultStep,
+		"Number of nodes removed from the original machine pool at a time.",
+	)
+	flags.DurationVar(
+		&options.Timeout,
+		timeoutFlag,
+		poolmigration.DefaultTimeout,
+		"Time to wait for the nodes of a machine pool to reach the expected number at each step.",
+	)
+	cmd.MarkFlagRequired(instanceTypeFlag)
+	ocm.AddClusterFlag(cmd)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func MigrateMachinePoolRunner(options *MigrateMachinePoolOptions) rosa.CommandRunner {
+	return func(ctx context.Context, r *rosa.Runtime, _ *cobra.Command, argv []string) error {
+		machinePoolID := argv[0]
+		if !machinepool.MachinePoolKeyRE.MatchString(machinePoolID) {
+			return fmt.Errorf("Expected a valid identifier for the machine pool")
+		}
+		if options.Step < 1 {
+			return fmt.Errorf("Invalid value '%d' for '--%s', it must be at least 1", options.Step, stepFlag)
+		}
+		targetID := options.Name
+		if targetID == "" {
+			targetID = poolmigration.TargetID(machinePoolID, options.InstanceType)
+		}
+		if !machinepool.MachinePoolKeyRE.MatchString(targetID) {
+			return fmt.Errorf("Invalid name '%s' for the new machine pool", targetID)
+		}
+		if targetID == machinePoolID {
+			return fmt.Errorf("The new machine pool must have a different name than machine pool '%s'",
+				machinePoolID)
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+
+		var pools poolmigration.Pools
+		if cluster.Hypershift().Enabled() {
+			if len(targetID) > poolmigration.MaxNodePoolNameLength {
+				return fmt.Errorf("Name '%s' of the new machine pool is longer than %d characters, use "+
+					"'--%s' to set a shorter one", targetID, poolmigration.MaxNodePoolNameLength, nameFlag)
+			}
+			pools = poolmigration.NewNodePools(r.OCMClient, cluster)
+		} else {
+			pools = poolmigration.NewMachinePools(r.OCMClient, r.AWSClient, cluster)
+		}
+
+		if !confirm.Prompt(true, "Migrate machine pool '%s' of cluster '%s' to instance type '%s' "+
+			"using new machine pool '%s'?", machinePoolID, clusterKey, options.InstanceType, targetID) {
+			return nil
+		}
+
+		migration := poolmigration.NewMigration(pools, poolmigration.Options{
+			SourceID:     machinePoolID,
+			TargetID:     targetID,
+			InstanceType: options.InstanceType,
+			Step:         options.Step,
+			Timeout:      options.Timeout,
+		}, r.Reporter.Infof)
+		err := migration.Run(ctx)
+		if err != nil {
+			r.Reporter.Infof("To resume the migration run the same command again")
+			return fmt.Errorf("Failed to migrate machine pool '%s': %v", machinePoolID, err)
+		}
+		r.Reporter.Infof("Machine pool '%s' of cluster '%s' was migrated to machine pool '%s'",
+			machinePoolID, clusterKey, targetID)
+		return nil
+	}
+}
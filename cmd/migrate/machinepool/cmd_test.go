@@ -0,0 +1,97 @@
+package machinepool
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	"github.com/openshift/rosa/pkg/poolmigration"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("migrate machinepool", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewMigrateMachinePoolCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+
+		Expect(cmd.Flags().Lookup(instanceTypeFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(nameFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(stepFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(timeoutFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+	})
+
+	Context("Migrate Machine Pool Runner", func() {
+		const clusterID = "hcp-cluster"
+
+		var t *test.TestingRuntime
+
+		hostedCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.State(cmv1.ClusterStateReady)
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			t.SetCluster(clusterID, hostedCluster)
+		})
+
+		AfterEach(func() {
+			t.SetCluster("", nil)
+		})
+
+		run := func(options *MigrateMachinePoolOptions, id string) error {
+			cmd := NewMigrateMachinePoolCommand()
+			Expect(cmd.Flag("cluster").Value.Set(clusterID)).To(Succeed())
+			Expect(cmd.Flag("yes").Value.Set("true")).To(Succeed())
+			runner := MigrateMachinePoolRunner(options)
+			return runner(context.Background(), t.RosaRuntime, cmd, []string{id})
+		}
+
+		It("Rejects an invalid step", func() {
+			err := run(&MigrateMachinePoolOptions{InstanceType: "r6i.2xlarge", Step: 0}, "workers")
+			Expect(err).To(MatchError("Invalid value '0' for '--step', it must be at least 1"))
+		})
+
+		It("Rejects node pool names that are too long", func() {
+			err := run(&MigrateMachinePoolOptions{InstanceType: "r6i.2xlarge", Step: 1}, "workers")
+			Expect(err).To(MatchError("Name 'workers-r6i-2xlarge' of the new machine pool is longer than 15 " +
+				"characters, use '--name' to set a shorter one"))
+		})
+
+		It("Rejects a new name equal to the machine pool", func() {
+			err := run(&MigrateMachinePoolOptions{InstanceType: "r6i.2xlarge", Name: "workers", Step: 1}, "workers")
+			Expect(err).To(MatchError("The new machine pool must have a different name than machine pool 'workers'"))
+		})
+
+		It("Reports a migration that already completed", func() {
+			target, err := cmv1.NewNodePool().ID("workers-r6i").Replicas(2).
+				AWSNodePool(cmv1.NewAWSNodePool().InstanceType("r6i.2xlarge")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusNotFound, "{}"))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatResource(target)))
+
+			t.StdOutReader.Record()
+			err = run(&MigrateMachinePoolOptions{
+				InstanceType: "r6i.2xlarge",
+				Name:         "workers-r6i",
+				Step:         1,
+				Timeout:      poolmigration.DefaultTimeout,
+			}, "workers")
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("Pool 'workers' was already migrated to pool 'workers-r6i'"))
+			Expect(stdOut).To(ContainSubstring("Machine pool 'workers' of cluster 'hcp-cluster' was migrated to " +
+				"machine pool 'workers-r6i'"))
+		})
+	})
+})
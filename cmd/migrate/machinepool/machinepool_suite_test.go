@@ -0,0 +1,13 @@
+package machinepool
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMigrateMachinePool(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Migrate Machine Pool Suite")
+}
@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/network"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "network"
+	short = "Plan the network CIDRs of a new cluster"
+	long  = "Inspects the CIDR blocks and routes of a VPC and proposes machine, service and pod CIDRs that " +
+		"don't overlap with them, and a host prefix sized for the expected number of nodes and pods. " +
+		"When no VPC is given, a CIDR for a new VPC that doesn't overlap with the existing VPCs is proposed."
+	example = `  # Plan the networks of a cluster of up to 200 nodes in an existing VPC
+  rosa plan network --vpc-id vpc-0123456789abcdef0 --max-nodes 200
+
+  # Print only the 'rosa create cluster' flags
+  rosa plan network --vpc-id vpc-0123456789abcdef0 --max-nodes 200 --emit create-cluster
+
+  # Plan a new VPC and print the 'rosa create network' command that creates it
+  rosa plan network --max-nodes 50 --emit create-network`
+
+	vpcIDFlag          = "vpc-id"
+	maxNodesFlag       = "max-nodes"
+	maxPodsPerNodeFlag = "max-pods-per-node"
+	emitFlag           = "emit"
+
+	emitCreateCluster = "create-cluster"
+	emitCreateNetwork = "create-network"
+
+	defaultNetworkTemplate = "rosa-quickstart-default-vpc"
+)
+
+var emitOptions = []string{emitCreateCluster, emitCreateNetwork}
+
+type PlanNetworkOptions struct {
+	VpcID          string
+	MaxNodes       int
+	MaxPodsPerNode int
+	Emit           string
+}
+
+func NewPlanNetworkCommand() *cobra.Command {
+	options := &PlanNetworkOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"networks"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithAWS(), PlanNetworkRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&options.VpcID,
+		vpcIDFlag,
+		"",
+		"ID of the existing VPC the cluster will be installed in. When omitted, a CIDR for a new VPC is proposed.",
+	)
+	flags.IntVar(
+		&options.MaxNodes,
+		maxNodesFlag,
+		0,
+		"Maximum number of nodes the cluster is expected to grow to.",
+	)
+	flags.IntVar(
+		&options.MaxPodsPerNode,
+		maxPodsPerNodeFlag,
+		network.DefaultMaxPodsPerNode,
+		"Maximum number of pods each node is expected to run.",
+	)
+	flags.StringVar(
+		&options.Emit,
+		emitFlag,
+		"",
+		fmt.Sprintf("Only print the command that applies the plan. Allowed values are %s.",
+			strings.Join(emitOptions, ", ")),
+	)
+	cmd.MarkFlagRequired(maxNodesFlag)
+	cmd.RegisterFlagCompletionFunc(emitFlag, func(_ *cobra.Command, _ []string, _ string) ([]string,
+		cobra.ShellCompDirective) {
+		return emitOptions, cobra.ShellCompDirectiveDefault
+	})
+	return cmd
+}
+
+func PlanNetworkRunner(options *PlanNetworkOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		switch options.Emit {
+		case "", emitCreateCluster:
+		case emitCreateNetwork:
+			if options.VpcID != "" {
+				return fmt.Errorf("'--%s %s' can only be used when planning a new VPC, remove '--%s'",
+					emitFlag, emitCreateNetwork, vpcIDFlag)
+			}
+		default:
+			return fmt.Errorf("Invalid value '%s' for '--%s'. Allowed values are %s",
+				options.Emit, emitFlag, strings.Join(emitOptions, ", "))
+		}
+
+		input := network.PlanInput{
+			MaxNodes:       options.MaxNodes,
+			MaxPodsPerNode: options.MaxPodsPerNode,
+		}
+		if options.VpcID != "" {
+			vpcs, err := r.AWSClient.ListVpcs(options.VpcID)
+			if err != nil {
+				return fmt.Errorf("Failed to get VPC '%s': %v", options.VpcID, err)
+			}
+			if len(vpcs) == 0 {
+				return fmt.Errorf("VPC '%s' does not exist", options.VpcID)
+			}
+			routeTables, err := r.AWSClient.ListVpcRouteTables(options.VpcID)
+			if err != nil {
+				return fmt.Errorf("Failed to get the route tables of VPC '%s': %v", options.VpcID, err)
+			}
+			_, input.MachineCIDR, err = net.ParseCIDR(*vpcs[0].CidrBlock)
+			if err != nil {
+				return fmt.Errorf("Failed to parse the CIDR of VPC '%s': %v", options.VpcID, err)
+			}
+			if len(vpcs[0].CidrBlockAssociationSet) > 1 {
+				r.Reporter.Warnf("VPC '%s' has secondary CIDR blocks, the machine CIDR only covers the "+
+					"primary block '%s'", options.VpcID, input.MachineCIDR)
+			}
+			input.Reserved = network.ReservedCIDRs(vpcs, routeTables)
+		} else {
+			vpcs, err := r.AWSClient.ListVpcs()
+			if err != nil {
+				return fmt.Errorf("Failed to list VPCs: %v", err)
+			}
+			input.Reserved = network.ReservedCIDRs(vpcs, []ec2types.RouteTable{})
+		}
+
+		plan, err := network.PlanNetwork(input)
+		if err != nil {
+			return fmt.Errorf("Failed to plan the network: %v", err)
+		}
+
+		createCluster := buildCreateClusterCommand(plan)
+		createNetwork := buildCreateNetworkCommand(plan, r.AWSClient.GetRegion())
+		switch options.Emit {
+		case emitCreateCluster:
+			fmt.Println(createCluster)
+			return nil
+		case emitCreateNetwork:
+			fmt.Println(createNetwork)
+			return nil
+		}
+
+		for _, warning := range plan.Warnings {
+			r.Reporter.Warnf("%s", warning)
+		}
+		printPlan(options, input, plan)
+		if plan.NewVpc {
+			r.Reporter.Infof("To create a VPC with this plan run:\n\n  %s\n", createNetwork)
+			r.Reporter.Infof("Then create the cluster in its subnets with:\n\n  %s --subnet-ids <subnet-ids>\n",
+				createCluster)
+		} else {
+			r.Reporter.Infof("To create a cluster with this plan run:\n\n  %s --subnet-ids <subnet-ids>\n",
+				createCluster)
+		}
+		return nil
+	}
+}
+
+func printPlan(options *PlanNetworkOptions, input network.PlanInput, plan *network.Plan) {
+	avoided := []string{}
+	for _, cidr := range input.Reserved {
+		avoided = append(avoided, cidr.String())
+	}
+	if len(avoided) == 0 {
+		avoided = append(avoided, "none")
+	}
+	vpc := options.VpcID
+	if plan.NewVpc {
+		vpc = "new VPC"
+	}
+	fmt.Printf("VPC:                      %s\n", vpc)
+	fmt.Printf("Max nodes:                %d\n", options.MaxNodes)
+	fmt.Printf("Max pods per node:        %d\n", options.MaxPodsPerNode)
+	fmt.Printf("Machine CIDR:             %s\n", plan.MachineCIDR)
+	fmt.Printf("Service CIDR:             %s\n", plan.ServiceCIDR)
+	fmt.Printf("Pod CIDR:                 %s\n", plan.PodCIDR)
+	fmt.Printf("Host prefix:              /%d\n", plan.HostPrefix)
+	fmt.Printf("Avoided CIDRs:            %s\n", strings.Join(avoided, ", "))
+}
+
+func buildCreateClusterCommand(plan *network.Plan) string {
+	return fmt.Sprintf("rosa create cluster --machine-cidr %s --service-cidr %s --pod-cidr %s --host-prefix %d",
+		plan.MachineCIDR, plan.ServiceCIDR, plan.PodCIDR, plan.HostPrefix)
+}
+
+func buildCreateNetworkCommand(plan *network.Plan, region string) string {
+	command := fmt.Sprintf("rosa create network %s --param VpcCidr=%s", defaultNetworkTemplate, plan.MachineCIDR)
+	if region != "" {
+		command += fmt.Sprintf(" --param Region=%s", region)
+	}
+	return command
+}
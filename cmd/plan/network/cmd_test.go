@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("plan network", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewPlanNetworkCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+
+		Expect(cmd.Flags().Lookup(vpcIDFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(maxNodesFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(maxPodsPerNodeFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(emitFlag)).NotTo(BeNil())
+	})
+
+	Context("Plan Network Runner", func() {
+		const vpcID = "vpc-0123456789abcdef0"
+
+		var (
+			t         *TestingRuntime
+			awsClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			awsClient.EXPECT().GetRegion().Return("us-east-1").AnyTimes()
+		})
+
+		It("Emits the create cluster flags avoiding routed CIDRs", func() {
+			awsClient.EXPECT().ListVpcs(vpcID).Return([]ec2types.Vpc{
+				{VpcId: awssdk.String(vpcID), CidrBlock: awssdk.String("10.0.0.0/16")},
+			}, nil)
+			awsClient.EXPECT().ListVpcRouteTables(vpcID).Return([]ec2types.RouteTable{
+				{Routes: []ec2types.Route{
+					{DestinationCidrBlock: awssdk.String("172.30.0.0/16"), TransitGatewayId: awssdk.String("tgw-1")},
+				}},
+			}, nil)
+
+			t.StdOutReader.Record()
+			runner := PlanNetworkRunner(&PlanNetworkOptions{
+				VpcID:          vpcID,
+				MaxNodes:       200,
+				MaxPodsPerNode: 250,
+				Emit:           emitCreateCluster,
+			})
+			err := runner(context.Background(), t.RosaRuntime, NewPlanNetworkCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("rosa create cluster --machine-cidr 10.0.0.0/16 --service-cidr 10.1.0.0/16 " +
+				"--pod-cidr 10.128.0.0/15 --host-prefix 23\n"))
+		})
+
+		It("Emits the create network command for a new VPC", func() {
+			awsClient.EXPECT().ListVpcs().Return([]ec2types.Vpc{
+				{VpcId: awssdk.String(vpcID), CidrBlock: awssdk.String("10.0.0.0/16")},
+			}, nil)
+
+			t.StdOutReader.Record()
+			runner := PlanNetworkRunner(&PlanNetworkOptions{
+				MaxNodes:       50,
+				MaxPodsPerNode: 250,
+				Emit:           emitCreateNetwork,
+			})
+			err := runner(context.Background(), t.RosaRuntime, NewPlanNetworkCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("rosa create network rosa-quickstart-default-vpc " +
+				"--param VpcCidr=10.1.0.0/16 --param Region=us-east-1\n"))
+		})
+
+		It("Prints the plan summary", func() {
+			awsClient.EXPECT().ListVpcs(vpcID).Return([]ec2types.Vpc{
+				{VpcId: awssdk.String(vpcID), CidrBlock: awssdk.String("10.0.0.0/16")},
+			}, nil)
+			awsClient.EXPECT().ListVpcRouteTables(vpcID).Return([]ec2types.RouteTable{}, nil)
+
+			t.StdOutReader.Record()
+			runner := PlanNetworkRunner(&PlanNetworkOptions{VpcID: vpcID, MaxNodes: 20, MaxPodsPerNode: 250})
+			err := runner(context.Background(), t.RosaRuntime, NewPlanNetworkCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("Pod CIDR:                 10.128.0.0/18\n"))
+			Expect(stdOut).To(ContainSubstring("Avoided CIDRs:            10.0.0.0/16\n"))
+			Expect(stdOut).To(ContainSubstring("--subnet-ids <subnet-ids>"))
+		})
+
+		It("Rejects emitting the create network command for an existing VPC", func() {
+			runner := PlanNetworkRunner(&PlanNetworkOptions{VpcID: vpcID, MaxNodes: 20, Emit: emitCreateNetwork})
+			err := runner(context.Background(), t.RosaRuntime, NewPlanNetworkCommand(), nil)
+			Expect(err).To(MatchError(ContainSubstring("can only be used when planning a new VPC")))
+		})
+	})
+})
@@ -0,0 +1,238 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/instancetype"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "instance-type"
+	short = "Recommend instance types for a workload"
+	long  = "Ranks the instance types available to the organization by how closely the nodes needed to run " +
+		"a workload of the given CPU, memory and pod count fit it, and shows how many nodes of each would be " +
+		"needed. Instance types without enough quota for the nodes are ranked last. Accelerated computing " +
+		"instance types are only considered with '--gpu'."
+	example = `  # Recommend instance types for 64 cores, 256 GiB of memory and 300 pods
+  rosa recommend instance-type --cpu 64 --memory 256Gi --pods 300
+
+  # Recommend ARM instance types
+  rosa recommend instance-type --cpu 32 --memory 128Gi --arch arm64
+
+  # Recommend GPU instance types
+  rosa recommend instance-type --cpu 96 --memory 384Gi --gpu`
+
+	cpuFlag            = "cpu"
+	memoryFlag         = "memory"
+	podsFlag           = "pods"
+	maxPodsPerNodeFlag = "max-pods-per-node"
+	archFlag           = "arch"
+	gpuFlag            = "gpu"
+	limitFlag          = "limit"
+
+	defaultLimit = 10
+)
+
+type RecommendInstanceTypeOptions struct {
+	CPU            int
+	Memory         string
+	Pods           int
+	MaxPodsPerNode int
+	Architecture   string
+	GPU            bool
+	Limit          int
+}
+
+type recommendation struct {
+	ID          string  `json:"id"`
+	Category    string  `json:"category"`
+	CPU         int     `json:"cpu"`
+	Memory      string  `json:"memory"`
+	Nodes       int     `json:"nodes"`
+	TotalCPU    int     `json:"total_cpu"`
+	TotalMemory string  `json:"total_memory"`
+	Overhead    float64 `json:"overhead"`
+	Quota       *int    `json:"available_quota,omitempty"`
+	HasQuota    bool    `json:"has_quota"`
+}
+
+func NewRecommendInstanceTypeCommand() *cobra.Command {
+	options := &RecommendInstanceTypeOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"instance-types", "instancetype", "instancetypes"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), RecommendInstanceTypeRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	flags.IntVar(
+		&options.CPU,
+		cpuFlag,
+		0,
+		"Number of CPU cores the workload needs.",
+	)
+	flags.StringVar(
+		&options.Memory,
+		memoryFlag,
+		"",
+		"Amount of memory the workload needs (e.g., '256Gi'). Numbers without a unit are GiB.",
+	)
+	flags.IntVar(
+		&options.Pods,
+		podsFlag,
+		0,
+		"Number of pods the workload runs.",
+	)
+	flags.IntVar(
+		&options.MaxPodsPerNode,
+		maxPodsPerNodeFlag,
+		instancetype.DefaultMaxPodsPerNode,
+		"Maximum number of pods that run on each node.",
+	)
+	flags.StringVar(
+		&options.Architecture,
+		archFlag,
+		"",
+		fmt.Sprintf("Only recommend instance types of this processor architecture. Allowed values are %s.",
+			strings.Join(instancetype.Architectures, ", ")),
+	)
+	flags.BoolVar(
+		&options.GPU,
+		gpuFlag,
+		false,
+		"Only recommend accelerated computing instance types with GPUs.",
+	)
+	flags.IntVar(
+		&options.Limit,
+		limitFlag,
+		defaultLimit,
+		"Maximum number of instance types to show.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func RecommendInstanceTypeRunner(options *RecommendInstanceTypeOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		memory, err := instancetype.ParseMemory(options.Memory)
+		if err != nil {
+			return fmt.Errorf("Expected a valid value for '--%s': %v", memoryFlag, err)
+		}
+		requirements := instancetype.Requirements{
+			CPU:            options.CPU,
+			Memory:         memory,
+			Pods:           options.Pods,
+			MaxPodsPerNode: options.MaxPodsPerNode,
+		}
+		if err := requirements.Validate(); err != nil {
+			return fmt.Errorf("Invalid requirements: %v, use '--%s' and '--%s'", err, cpuFlag, memoryFlag)
+		}
+		filter := &instancetype.Filter{Architecture: options.Architecture, GPU: options.GPU}
+		if !options.GPU {
+			filter.Categories = []string{
+				string(cmv1.MachineTypeCategoryComputeOptimized),
+				string(cmv1.MachineTypeCategoryGeneralPurpose),
+				string(cmv1.MachineTypeCategoryMemoryOptimized),
+			}
+		}
+		if err := filter.Validate(); err != nil {
+			return fmt.Errorf("Invalid value for '--%s': %v", archFlag, err)
+		}
+		if options.Limit <= 0 {
+			return fmt.Errorf("Expected a positive value for '--%s'", limitFlag)
+		}
+
+		r.Reporter.Debugf("Fetching instance types")
+		machineTypes, err := r.OCMClient.GetAvailableMachineTypes(ocm.FeatureFilters{})
+		if err != nil {
+			return fmt.Errorf("Failed to fetch instance types: %v", err)
+		}
+		recommendations := instancetype.Recommend(filter.Apply(machineTypes), requirements)
+		if len(recommendations) == 0 {
+			return fmt.Errorf("There are no instance types available for your account that match the filters")
+		}
+		if len(recommendations) > options.Limit {
+			recommendations = recommendations[:options.Limit]
+		}
+
+		if output.HasFlag() {
+			return output.Print(toOutput(recommendations))
+		}
+		printRecommendations(recommendations)
+		if !recommendations[0].HasQuota() {
+			r.Reporter.Warnf("None of the recommended instance types has enough quota for the required nodes")
+		}
+		return nil
+	}
+}
+
+func toOutput(recommendations []*instancetype.Recommendation) []recommendation {
+	result := make([]recommendation, 0, len(recommendations))
+	for _, rec := range recommendations {
+		machineType := rec.MachineType.MachineType
+		item := recommendation{
+			ID:          machineType.ID(),
+			Category:    string(machineType.Category()),
+			CPU:         instancetype.CPUCores(machineType),
+			Memory:      instancetype.FormatMemory(instancetype.MemoryBytes(machineType)),
+			Nodes:       rec.Nodes,
+			TotalCPU:    rec.TotalCPU,
+			TotalMemory: instancetype.FormatMemory(rec.TotalMemory),
+			Overhead:    rec.Overhead,
+			HasQuota:    rec.HasQuota(),
+		}
+		if rec.QuotaLimited {
+			quota := rec.Quota
+			item.Quota = &quota
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func printRecommendations(recommendations []*instancetype.Recommendation) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "ID\tCATEGORY\tCPU_CORES\tMEMORY\tNODES\tTOTAL_CPU\tTOTAL_MEMORY\tOVERHEAD\tQUOTA\n")
+	for _, rec := range recommendations {
+		machineType := rec.MachineType.MachineType
+		quota := "ok"
+		if !rec.HasQuota() {
+			quota = fmt.Sprintf("insufficient (%d available)", rec.Quota)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%d\t%d\t%s\t%.0f%%\t%s\n",
+			machineType.ID(), machineType.Category(), instancetype.CPUCores(machineType),
+			instancetype.FormatMemory(instancetype.MemoryBytes(machineType)), rec.Nodes, rec.TotalCPU,
+			instancetype.FormatMemory(rec.TotalMemory), rec.Overhead*100, quota)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,140 @@
+package instancetype
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	machineTypes = `{
+  "kind": "MachineTypeList",
+  "page": 1,
+  "size": 3,
+  "total": 3,
+  "items": [
+    {
+      "kind": "MachineType",
+      "id": "m5.xlarge",
+      "category": "general_purpose",
+      "architecture": "amd64",
+      "memory": {"value": 17179869184, "unit": "B"},
+      "cpu": {"value": 4, "unit": "vCPU"}
+    },
+    {
+      "kind": "MachineType",
+      "id": "m5.4xlarge",
+      "category": "general_purpose",
+      "architecture": "amd64",
+      "memory": {"value": 68719476736, "unit": "B"},
+      "cpu": {"value": 16, "unit": "vCPU"}
+    },
+    {
+      "kind": "MachineType",
+      "id": "g4dn.12xlarge",
+      "category": "accelerated_computing",
+      "architecture": "amd64",
+      "generic_name": "t4-gpu-48",
+      "memory": {"value": 206158430208, "unit": "B"},
+      "cpu": {"value": 48, "unit": "vCPU"}
+    }
+  ]
+}`
+	currentAccount = `{"kind": "Account", "organization": {"id": "123abc", "kind": "Organization"}}`
+	quotaCost      = `{
+  "items": [
+    {
+      "allowed": 2,
+      "consumed": 0,
+      "kind": "QuotaCost",
+      "quota_id": "compute.node|gpu|byoc|moa",
+      "related_resources": [
+        {"byoc": "byoc", "cloud_provider": "aws", "cost": 1, "product": "MOA", "resource_name": "t4-gpu-48"}
+      ]
+    }
+  ]
+}`
+)
+
+var _ = Describe("recommend instance-type", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewRecommendInstanceTypeCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+
+		Expect(cmd.Flags().Lookup(cpuFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(memoryFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(podsFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(gpuFlag)).NotTo(BeNil())
+	})
+
+	Context("Recommend Instance Type Runner", func() {
+		var t *TestingRuntime
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+		})
+
+		appendHandlers := func() {
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, machineTypes))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, currentAccount))
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, quotaCost))
+		}
+
+		It("Ranks the instance types by fit", func() {
+			appendHandlers()
+			t.StdOutReader.Record()
+			runner := RecommendInstanceTypeRunner(&RecommendInstanceTypeOptions{
+				CPU:            64,
+				Memory:         "256Gi",
+				Pods:           1500,
+				MaxPodsPerNode: 250,
+				Limit:          defaultLimit,
+			})
+			err := runner(context.Background(), t.RosaRuntime, NewRecommendInstanceTypeCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal(
+				"ID          CATEGORY         CPU_CORES  MEMORY    NODES  TOTAL_CPU  TOTAL_MEMORY  OVERHEAD  QUOTA\n" +
+					"m5.xlarge   general_purpose  4          16.0 GiB  16     64         256.0 GiB     0%        ok\n" +
+					"m5.4xlarge  general_purpose  16         64.0 GiB  6      96         384.0 GiB     50%       ok\n"))
+		})
+
+		It("Reports GPU instance types without enough quota", func() {
+			appendHandlers()
+			t.StdOutReader.Record()
+			runner := RecommendInstanceTypeRunner(&RecommendInstanceTypeOptions{
+				CPU:            144,
+				MaxPodsPerNode: 250,
+				GPU:            true,
+				Limit:          defaultLimit,
+			})
+			err := runner(context.Background(), t.RosaRuntime, NewRecommendInstanceTypeCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("g4dn.12xlarge"))
+			Expect(stdOut).To(ContainSubstring("insufficient (2 available)"))
+			Expect(stdOut).NotTo(ContainSubstring("m5.xlarge"))
+		})
+
+		It("Requires the CPU or memory of the workload", func() {
+			runner := RecommendInstanceTypeRunner(&RecommendInstanceTypeOptions{
+				Pods:           300,
+				MaxPodsPerNode: 250,
+				Limit:          defaultLimit,
+			})
+			err := runner(context.Background(), t.RosaRuntime, NewRecommendInstanceTypeCommand(), nil)
+			Expect(err).To(MatchError("Invalid requirements: the required number of CPU cores or memory must " +
+				"be set, use '--cpu' and '--memory'"))
+		})
+	})
+})
@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupusers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/create/idp"
+	"github.com/openshift/rosa/pkg/groupsync"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "group-users"
+	short = "Synchronize the users of a cluster group with a file"
+	long  = "Adds the users listed in a file to a cluster group and removes the users of the group that " +
+		"aren't listed, so that the group matches an identity source of truth. The file has one username " +
+		"per line, blank lines and lines starting with '#' are ignored. The '" + idp.ClusterAdminUsername +
+		"' user created by 'rosa create admin' is never removed."
+	example = `  # Show the users that would be added to and removed from the dedicated-admins group
+  rosa sync group-users --cluster=mycluster --group=dedicated-admins --from-file=users.txt --dry-run
+
+  # Synchronize the dedicated-admins group with the users of a file
+  rosa sync group-users --cluster=mycluster --group=dedicated-admins --from-file=users.txt`
+
+	groupFlag    = "group"
+	fromFileFlag = "from-file"
+	dryRunFlag   = "dry-run"
+)
+
+var aliases = []string{"group-user"}
+
+type SyncGroupUsersOptions struct {
+	Group    string
+	FromFile string
+	DryRun   bool
+}
+
+func NewSyncGroupUsersCommand() *cobra.Command {
+	options := &SyncGroupUsersOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), SyncGroupUsersRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.Group,
+		groupFlag,
+		"",
+		"Group to synchronize, see 'rosa list groups' for the groups of the cluster.",
+	)
+	flags.StringVar(
+		&options.FromFile,
+		fromFileFlag,
+		"",
+		"Path to a file with the usernames the group must have, one per line.",
+	)
+	flags.BoolVar(
+		&options.DryRun,
+		dryRunFlag,
+		false,
+		"Report the users that would be added and removed without changing the group.",
+	)
+	confirm.AddFlag(flags)
+	cmd.MarkFlagRequired(groupFlag)
+	cmd.MarkFlagRequired(fromFileFlag)
+	return cmd
+}
+
+func SyncGroupUsersRunner(options *SyncGroupUsersOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+
+		users, err := groupsync.LoadUsers(options.FromFile)
+		if err != nil {
+			return fmt.Errorf("Failed to load users from '%s': %v", options.FromFile, err)
+		}
+		if slices.Contains(users, idp.ClusterAdminUsername) {
+			return fmt.Errorf("Username '%s' is reserved for `rosa create/delete admin` command, remove it "+
+				"from '%s'", idp.ClusterAdminUsername, options.FromFile)
+		}
+
+		cluster := r.FetchCluster()
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+		if cluster.ExternalAuthConfig().Enabled() {
+			return fmt.Errorf("Synchronizing cluster groups is not supported for clusters with external " +
+				"authentication configured")
+		}
+
+		groups, err := r.OCMClient.GetGroups(cluster.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get groups for cluster '%s': %v", clusterKey, err)
+		}
+		groupIDs := []string{}
+		for _, group := range groups {
+			groupIDs = append(groupIDs, group.ID())
+		}
+		if !slices.Contains(groupIDs, options.Group) {
+			return fmt.Errorf("Group '%s' doesn't exist on cluster '%s'. Groups are %s", options.Group,
+				clusterKey, strings.Join(groupIDs, ", "))
+		}
+
+		r.Reporter.Debugf("Loading users of group '%s' for cluster '%s'", options.Group, clusterKey)
+		current, err := r.OCMClient.GetUsers(cluster.ID(), options.Group)
+		if err != nil {
+			return fmt.Errorf("Failed to get users of group '%s' for cluster '%s': %v", options.Group,
+				clusterKey, err)
+		}
+
+		plan := groupsync.NewPlan(options.Group, current, users, []string{idp.ClusterAdminUsername})
+		fmt.Print(plan.Report())
+		if options.DryRun {
+			r.Reporter.Infof("Dry run, group '%s' on cluster '%s' was not updated", options.Group, clusterKey)
+			return nil
+		}
+		if !plan.HasChanges() {
+			r.Reporter.Infof("Group '%s' on cluster '%s' is already in sync", options.Group, clusterKey)
+			return nil
+		}
+
+		if !confirm.Confirm("synchronize group '%s' on cluster '%s'", options.Group, clusterKey) {
+			return nil
+		}
+
+		added := []string{}
+		removed := []string{}
+		for _, username := range plan.Add {
+			user, err := cmv1.NewUser().ID(username).Build()
+			if err != nil {
+				return fmt.Errorf("Failed to create user '%s' for cluster '%s': %v%s", username, clusterKey, err,
+					appliedChanges(added, removed))
+			}
+			r.Reporter.Debugf("Adding user '%s' to group '%s' in cluster '%s'", username, options.Group,
+				clusterKey)
+			_, err = r.OCMClient.CreateUser(cluster.ID(), options.Group, user)
+			if err != nil {
+				return fmt.Errorf("Failed to add user '%s' to group '%s' on cluster '%s': %v%s", username,
+					options.Group, clusterKey, err, appliedChanges(added, removed))
+			}
+			added = append(added, username)
+		}
+		for _, username := range plan.Remove {
+			r.Reporter.Debugf("Removing user '%s' from group '%s' in cluster '%s'", username, options.Group,
+				clusterKey)
+			err = r.OCMClient.DeleteUser(cluster.ID(), options.Group, username)
+			if err != nil {
+				return fmt.Errorf("Failed to remove user '%s' from group '%s' on cluster '%s': %v%s", username,
+					options.Group, clusterKey, err, appliedChanges(added, removed))
+			}
+			removed = append(removed, username)
+		}
+		r.Reporter.Infof("Synchronized group '%s' on cluster '%s'", options.Group, clusterKey)
+		return nil
+	}
+}
+
+// appliedChanges describes the users already added and removed before a failure, so that a partially
+// synchronized group can be told apart from an untouched one.
+func appliedChanges(added, removed []string) string {
+	if len(added) == 0 && len(removed) == 0 {
+		return ". No changes were applied to the group"
+	}
+	changes := []string{}
+	if len(added) > 0 {
+		changes = append(changes, fmt.Sprintf("added '%s'", strings.Join(added, "', '")))
+	}
+	if len(removed) > 0 {
+		changes = append(changes, fmt.Sprintf("removed '%s'", strings.Join(removed, "', '")))
+	}
+	return fmt.Sprintf(". Changes already applied to the group: %s", strings.Join(changes, "; "))
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupusers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("sync group-users", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewSyncGroupUsersCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Aliases).To(Equal(aliases))
+		for _, flag := range []string{"cluster", groupFlag, fromFileFlag, dryRunFlag, "yes"} {
+			Expect(cmd.Flags().Lookup(flag)).NotTo(BeNil(), flag)
+		}
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+		var options *SyncGroupUsersOptions
+		var cluster *cmv1.Cluster
+		var added []string
+		var removed []string
+
+		usersPath := func() string {
+			return fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/groups/dedicated-admins/users", cluster.ID())
+		}
+		routeGroup := func(current ...string) {
+			users := []*cmv1.User{}
+			for _, id := range current {
+				user, err := cmv1.NewUser().ID(id).Build()
+				Expect(err).NotTo(HaveOccurred())
+				users = append(users, user)
+			}
+			group, err := cmv1.NewGroup().ID("dedicated-admins").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.RouteToHandler(http.MethodGet,
+				fmt.Sprintf("/api/clusters_mgmt/v1/clusters/%s/groups", cluster.ID()),
+				RespondWithJSON(http.StatusOK, FormatGroupList([]*cmv1.Group{group})))
+			t.ApiServer.RouteToHandler(http.MethodGet, usersPath(),
+				RespondWithJSON(http.StatusOK, FormatUserList(users)))
+			t.ApiServer.RouteToHandler(http.MethodPost, usersPath(), func(w http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+				body, err := io.ReadAll(req.Body)
+				Expect(err).NotTo(HaveOccurred())
+				user, err := cmv1.UnmarshalUser(body)
+				Expect(err).NotTo(HaveOccurred())
+				added = append(added, user.ID())
+				RespondWithJSON(http.StatusCreated, string(body))(w, req)
+			})
+			for _, id := range current {
+				t.ApiServer.RouteToHandler(http.MethodDelete, usersPath()+"/"+id,
+					func(w http.ResponseWriter, req *http.Request) {
+						removed = append(removed, filepath.Base(req.URL.Path))
+						w.WriteHeader(http.StatusNoContent)
+					})
+			}
+		}
+		writeUsers := func(content string) {
+			Expect(os.WriteFile(options.FromFile, []byte(content), 0600)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			// Building the command resets the cluster flag, so the confirmation is set up first
+			Expect(NewSyncGroupUsersCommand().Flag("yes").Value.Set("true")).To(Succeed())
+			DeferCleanup(func() {
+				Expect(NewSyncGroupUsersCommand().Flag("yes").Value.Set("false")).To(Succeed())
+			})
+			t = NewTestRuntime()
+			added = nil
+			removed = nil
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+			})
+			t.SetCluster("cluster", cluster)
+			options = &SyncGroupUsersOptions{
+				Group:    "dedicated-admins",
+				FromFile: filepath.Join(GinkgoT().TempDir(), "users.txt"),
+			}
+		})
+
+		It("Adds and removes users to match the file", func() {
+			routeGroup("alice", "carol", "cluster-admin")
+			writeUsers("# access review 2026-10\nalice\nbob\n")
+
+			t.StdOutReader.Record()
+			err := SyncGroupUsersRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("Changes to group 'dedicated-admins':\n  + bob\n  - carol\n" +
+				"Users added: 1, removed: 1, unchanged: 1\n" +
+				"INFO: Synchronized group 'dedicated-admins' on cluster 'cluster'\n"))
+			Expect(added).To(Equal([]string{"bob"}))
+			Expect(removed).To(Equal([]string{"carol"}))
+		})
+
+		It("Reports the changes already applied when a change fails", func() {
+			routeGroup("carol", "dave")
+			writeUsers("alice\nbob\n")
+			t.ApiServer.RouteToHandler(http.MethodDelete, usersPath()+"/dave",
+				RespondWithJSON(http.StatusInternalServerError, "{}"))
+
+			err := SyncGroupUsersRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(And(
+				HavePrefix("Failed to remove user 'dave' from group 'dedicated-admins' on cluster 'cluster'"),
+				HaveSuffix(". Changes already applied to the group: added 'alice', 'bob'; removed 'carol'"),
+			)))
+			Expect(added).To(Equal([]string{"alice", "bob"}))
+			Expect(removed).To(Equal([]string{"carol"}))
+		})
+
+		It("Only reports the changes in a dry run", func() {
+			routeGroup("alice", "carol")
+			writeUsers("alice\nbob\n")
+			options.DryRun = true
+
+			t.StdOutReader.Record()
+			err := SyncGroupUsersRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("  + bob\n  - carol\n"))
+			Expect(stdOut).To(HaveSuffix("INFO: Dry run, group 'dedicated-admins' on cluster 'cluster' was " +
+				"not updated\n"))
+			Expect(added).To(BeEmpty())
+			Expect(removed).To(BeEmpty())
+		})
+
+		It("Fails for groups that don't exist on the cluster", func() {
+			routeGroup()
+			writeUsers("alice\n")
+			options.Group = "developers"
+
+			err := SyncGroupUsersRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Group 'developers' doesn't exist on cluster 'cluster'. " +
+				"Groups are dedicated-admins"))
+		})
+
+		It("Fails when the file lists the reserved cluster admin user", func() {
+			writeUsers("alice\ncluster-admin\n")
+
+			err := SyncGroupUsersRunner(options)(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError(ContainSubstring("Username 'cluster-admin' is reserved")))
+		})
+	})
+})
@@ -19,11 +19,14 @@ package verify
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/verify/logforwarder"
 	"github.com/openshift/rosa/cmd/verify/network"
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/permissions"
 	"github.com/openshift/rosa/cmd/verify/quota"
 	"github.com/openshift/rosa/cmd/verify/rosa"
+	"github.com/openshift/rosa/cmd/verify/tags"
+	"github.com/openshift/rosa/cmd/verify/tuningconfig"
 )
 
 var Cmd = &cobra.Command{
@@ -34,9 +37,12 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
+	Cmd.AddCommand(logforwarder.NewVerifyLogForwarderCommand())
 	Cmd.AddCommand(network.Cmd)
 	Cmd.AddCommand(oc.Cmd)
 	Cmd.AddCommand(permissions.Cmd)
 	Cmd.AddCommand(quota.Cmd)
 	Cmd.AddCommand(rosa.NewVerifyRosaCommand())
+	Cmd.AddCommand(tags.NewVerifyTagsCommand())
+	Cmd.AddCommand(tuningconfig.NewVerifyTuningConfigCommand())
 }
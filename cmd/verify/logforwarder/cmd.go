@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logforwarder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/fedramp"
+	"github.com/openshift/rosa/pkg/logforwarding"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "log-forwarder"
+	short = "Verify that the log forwarders of a cluster can write to their destinations"
+	long  = "Checks that the S3 bucket or CloudWatch log group of each log forwarder of a cluster exists, " +
+		"its region and encryption, and simulates the writes of the log forwarder with the IAM policies " +
+		"of its role, so that misconfigured destinations are found before logs are dropped. CloudWatch log " +
+		"forwarders are simulated with their log distribution role, S3 log forwarders with the role given " +
+		"with '--role-arn', without it the permissions of S3 log forwarders aren't verified."
+	example = `  # Verify the log forwarders of cluster 'mycluster'
+  rosa verify log-forwarder --cluster=mycluster
+
+  # Verify a single log forwarder and simulate the writes to its S3 bucket for a role
+  rosa verify log-forwarder --cluster=mycluster --log-forwarder=2m7mnfp4eo1bcs6f6spo8g2ggnrmi6ro \
+    --role-arn=arn:aws:iam::123456789012:role/log-writer`
+
+	logForwarderFlag = "log-forwarder"
+	roleARNFlag      = "role-arn"
+)
+
+var aliases = []string{"logforwarder", "log-forwarders", "logforwarders"}
+
+type VerifyLogForwarderOptions struct {
+	LogForwarder string
+	RoleARN      string
+}
+
+func NewVerifyLogForwarderCommand() *cobra.Command {
+	options := &VerifyLogForwarderOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), VerifyLogForwarderRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&options.LogForwarder,
+		logForwarderFlag,
+		"",
+		"ID of the log forwarder to verify. Defaults to all the log forwarders of the cluster.",
+	)
+	flags.StringVar(
+		&options.RoleARN,
+		roleARNFlag,
+		"",
+		"ARN of the role that writes to the S3 buckets of the log forwarders, used to simulate the writes. "+
+			"The permissions of S3 log forwarders are not checked without it.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func VerifyLogForwarderRunner(options *VerifyLogForwarderOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if fedramp.Enabled() {
+			return fmt.Errorf("Log forwarding is not supported on Govcloud")
+		}
+		clusterKey := r.GetClusterKey()
+		cluster := r.FetchCluster()
+		if cluster.State() != cmv1.ClusterStateReady {
+			return fmt.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+
+		var logForwarders []*cmv1.LogForwarder
+		if options.LogForwarder != "" {
+			logForwarder, err := r.OCMClient.GetLogForwarderByID(cluster.ID(), options.LogForwarder)
+			if err != nil {
+				return fmt.Errorf("Failed to get log forwarder '%s': %v", options.LogForwarder, err)
+			}
+			if logForwarder == nil {
+				return fmt.Errorf("Log forwarder '%s' not found on cluster '%s'", options.LogForwarder, clusterKey)
+			}
+			logForwarders = append(logForwarders, logForwarder)
+		} else {
+			var err error
+			logForwarders, err = r.OCMClient.GetLogForwarders(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get log forwarders for cluster '%s': %v", clusterKey, err)
+			}
+		}
+		if len(logForwarders) == 0 {
+			r.Reporter.Infof("There are no log forwarders configured for cluster '%s'", clusterKey)
+			return nil
+		}
+
+		// Log groups are looked up in the region of the cluster
+		if r.AWSClient == nil {
+			val, ok := cluster.Properties()[properties.UseLocalCredentials]
+			var err error
+			r.AWSClient, err = aws.NewClient().
+				Region(cluster.Region().ID()).
+				Logger(r.Logger).
+				UseLocalCredentials(ok && val == "true").
+				Build()
+			if err != nil {
+				return fmt.Errorf("Failed to create AWS client: %v", err)
+			}
+		}
+
+		verifier := &logforwarding.Verifier{
+			AWSClient: r.AWSClient,
+			Region:    cluster.Region().ID(),
+			S3RoleARN: options.RoleARN,
+		}
+		verifications := []*logforwarding.Verification{}
+		failed := []string{}
+		unverified := []string{}
+		for _, logForwarder := range logForwarders {
+			r.Reporter.Debugf("Verifying log forwarder '%s'", logForwarder.ID())
+			verification := verifier.Verify(logForwarder)
+			verifications = append(verifications, verification)
+			if verification.Failed() {
+				failed = append(failed, verification.ID)
+			} else if verification.Skipped(logforwarding.PermissionsCheck) {
+				unverified = append(unverified, verification.ID)
+			}
+		}
+
+		if output.HasFlag() {
+			err := output.Print(verifications)
+			if err != nil {
+				return err
+			}
+		} else {
+			printVerifications(verifications)
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("Log forwarders '%s' of cluster '%s' failed verification",
+				strings.Join(failed, "', '"), clusterKey)
+		}
+		if output.HasFlag() {
+			return nil
+		}
+		if len(unverified) > 0 {
+			r.Reporter.Warnf("The permissions of log forwarders '%s' of cluster '%s' weren't verified, use "+
+				"'--%s' with the role that writes to their S3 buckets to verify them",
+				strings.Join(unverified, "', '"), clusterKey, roleARNFlag)
+			return nil
+		}
+		r.Reporter.Infof("Log forwarders of cluster '%s' can write to their destinations", clusterKey)
+		return nil
+	}
+}
+
+func printVerifications(verifications []*logforwarding.Verification) {
+	for i, verification := range verifications {
+		if i > 0 {
+			fmt.Println()
+		}
+		destination := fmt.Sprintf("%s destination", verification.Type)
+		switch verification.Type {
+		case "S3":
+			destination = fmt.Sprintf("S3 bucket '%s'", verification.Destination)
+		case "CloudWatch":
+			destination = fmt.Sprintf("CloudWatch log group '%s'", verification.Destination)
+		}
+		fmt.Printf("Log forwarder '%s' to %s:\n", verification.ID, destination)
+		writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, check := range verification.Checks {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", check.Name, strings.ToUpper(check.Result), check.Message)
+		}
+		writer.Flush()
+	}
+}
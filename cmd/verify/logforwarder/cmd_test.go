@@ -0,0 +1,149 @@
+package logforwarder
+
+import (
+	"context"
+	"net/http"
+
+	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/output"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	roleARN     = "arn:aws:iam::123456789012:role/log-writer"
+	logGroupARN = "arn:aws:logs:us-east-1:123456789012:log-group:cluster-logs:*"
+)
+
+var _ = Describe("verify log-forwarder", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewVerifyLogForwarderCommand()
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Aliases).To(Equal(aliases))
+		Expect(cmd.Flags().Lookup("cluster")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(logForwarderFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(roleARNFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("output")).NotTo(BeNil())
+	})
+
+	Context("Runner", func() {
+		var t *TestingRuntime
+		var awsClient *aws.MockClient
+		var cluster *cmv1.Cluster
+		var logForwarders []*cmv1.LogForwarder
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			output.SetOutput("")
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			cluster = MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+			})
+			t.SetCluster("cluster", cluster)
+
+			cloudWatch, err := cmv1.NewLogForwarder().ID("cw").Cloudwatch(cmv1.NewLogForwarderCloudWatchConfig().
+				LogGroupName("cluster-logs").LogDistributionRoleArn(roleARN)).Build()
+			Expect(err).NotTo(HaveOccurred())
+			s3, err := cmv1.NewLogForwarder().ID("s3").
+				S3(cmv1.NewLogForwarderS3Config().BucketName("missing-bucket")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			logForwarders = []*cmv1.LogForwarder{cloudWatch, s3}
+		})
+
+		AfterEach(func() {
+			output.SetOutput("")
+		})
+
+		It("Reports the checks of every log forwarder and fails when one of them fails", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatLogForwarderList(logForwarders)),
+			)
+			awsClient.EXPECT().GetLogGroup("cluster-logs").Return(&cwltypes.LogGroup{
+				LogGroupName: awsSdk.String("cluster-logs"),
+				Arn:          awsSdk.String(logGroupARN),
+			}, nil)
+			awsClient.EXPECT().FindDeniedActions(roleARN, gomock.Any(), []string{logGroupARN}).Return(nil, nil)
+			awsClient.EXPECT().GetBucketRegion("missing-bucket").
+				Return("", errors.NotFound.Errorf("Bucket 'missing-bucket' not found"))
+
+			runner := VerifyLogForwarderRunner(&VerifyLogForwarderOptions{})
+			t.StdOutReader.Record()
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			stdout, _ := t.StdOutReader.Read()
+			Expect(err).To(MatchError("Log forwarders 's3' of cluster 'cluster' failed verification"))
+			Expect(stdout).To(Equal(`Log forwarder 'cw' to CloudWatch log group 'cluster-logs':
+  Destination  PASSED  Log group 'cluster-logs' exists
+  Region       PASSED  Log group is in the region of the cluster 'us-east-1'
+  Encryption   PASSED  Log events are encrypted with CloudWatch Logs managed keys
+  Permissions  PASSED  Role 'arn:aws:iam::123456789012:role/log-writer' can write to the log group
+
+Log forwarder 's3' to S3 bucket 'missing-bucket':
+  Destination  FAILED   Bucket 'missing-bucket' doesn't exist
+  Region       SKIPPED  The bucket couldn't be found
+  Encryption   SKIPPED  The bucket couldn't be found
+  Permissions  SKIPPED  The bucket couldn't be found
+`))
+		})
+
+		It("Verifies a single log forwarder", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatLogForwarder(logForwarders[0])),
+			)
+			awsClient.EXPECT().GetLogGroup("cluster-logs").Return(&cwltypes.LogGroup{
+				LogGroupName: awsSdk.String("cluster-logs"),
+				Arn:          awsSdk.String(logGroupARN),
+			}, nil)
+			awsClient.EXPECT().FindDeniedActions(roleARN, gomock.Any(), []string{logGroupARN}).
+				Return([]string{"logs:PutLogEvents"}, nil)
+
+			runner := VerifyLogForwarderRunner(&VerifyLogForwarderOptions{LogForwarder: "cw"})
+			t.StdOutReader.Record()
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			stdout, _ := t.StdOutReader.Read()
+			Expect(err).To(MatchError("Log forwarders 'cw' of cluster 'cluster' failed verification"))
+			Expect(stdout).To(ContainSubstring("Permissions  FAILED  Role 'arn:aws:iam::123456789012:role/log-writer' " +
+				"isn't allowed to logs:PutLogEvents"))
+		})
+
+		It("Doesn't report that S3 log forwarders can write to their buckets without a role", func() {
+			s3, err := cmv1.NewLogForwarder().ID("s3").
+				S3(cmv1.NewLogForwarderS3Config().BucketName("cluster-logs")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatLogForwarder(s3)),
+			)
+			awsClient.EXPECT().GetBucketRegion("cluster-logs").Return("us-east-1", nil)
+			awsClient.EXPECT().GetBucketEncryption("cluster-logs", "us-east-1").Return(nil, nil)
+
+			runner := VerifyLogForwarderRunner(&VerifyLogForwarderOptions{LogForwarder: "s3"})
+			t.StdOutReader.Record()
+			err = runner(context.Background(), t.RosaRuntime, nil, nil)
+			stdout, _ := t.StdOutReader.Read()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring(
+				"Permissions  SKIPPED  There is no role to simulate the writes to the bucket for"))
+			Expect(stdout).NotTo(ContainSubstring("can write to their destinations"))
+		})
+
+		It("Does nothing without log forwarders", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatLogForwarderList([]*cmv1.LogForwarder{})),
+			)
+			runner := VerifyLogForwarderRunner(&VerifyLogForwarderOptions{})
+			err := runner(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package logforwarder
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVerifyLogForwarder(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Verify Log Forwarder Suite")
+}
@@ -0,0 +1,276 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/briandowns/spinner"
+	"github.com/spf13/cobra"
+
+	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/tagaudit"
+)
+
+const (
+	use   = "tags"
+	short = "Verify ROSA resources have the required tags"
+	long  = "Checks account roles, operator roles, their policies, OIDC providers and VPC subnets for the " +
+		"tags required by ROSA and by your organisation, and optionally adds the missing tags."
+	example = `  # Verify the ROSA tags of the roles, policies and OIDC providers in the current account
+  rosa verify tags
+
+  # Also verify the subnets of a cluster and require the 'cost-center' tag on every resource
+  rosa verify tags --cluster mycluster --required-tags cost-center
+
+  # Require the tags listed in a file and add the missing ones
+  rosa verify tags --required-tags-file tags.yaml --fix --mode auto
+
+  # Print the AWS commands needed to add the missing tags
+  rosa verify tags --subnet-ids subnet-03046a9b92b5014fb --fix --mode manual`
+
+	requiredTagsFlag     = "required-tags"
+	requiredTagsFileFlag = "required-tags-file"
+	subnetIDsFlag        = "subnet-ids"
+	fixFlag              = "fix"
+	clusterFlag          = "cluster"
+)
+
+type VerifyTagsOptions struct {
+	RequiredTags     []string
+	RequiredTagsFile string
+	SubnetIDs        []string
+	Fix              bool
+}
+
+func NewVerifyTagsCommand() *cobra.Command {
+	options := &VerifyTagsOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), VerifyTagsRunner(options)),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddOptionalClusterFlag(cmd)
+	flags.StringSliceVar(
+		&options.RequiredTags,
+		requiredTagsFlag,
+		nil,
+		"Tags that every resource must have. A bare key accepts any value, otherwise the tag must have "+
+			"the given value. Format should be a comma-separated list, for example: 'cost-center,owner:platform'.",
+	)
+	flags.StringVar(
+		&options.RequiredTagsFile,
+		requiredTagsFileFlag,
+		"",
+		"Path to a YAML file mapping the tag keys that every resource must have to their expected value. "+
+			"An empty value accepts any value.",
+	)
+	flags.StringSliceVar(
+		&options.SubnetIDs,
+		subnetIDsFlag,
+		nil,
+		"The subnet IDs to verify. Defaults to the subnets of the cluster when a cluster is supplied. "+
+			"Format should be a comma-separated list.",
+	)
+	flags.BoolVar(
+		&options.Fix,
+		fixFlag,
+		false,
+		"Add the missing tags. In manual mode the AWS commands that add them are printed instead.",
+	)
+	interactive.AddModeFlag(cmd)
+	return cmd
+}
+
+func VerifyTagsRunner(options *VerifyTagsOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, command *cobra.Command, _ []string) error {
+		mode, err := interactive.GetMode()
+		if err != nil {
+			return err
+		}
+		if mode != "" && !options.Fix {
+			return fmt.Errorf("The '--%s' flag can only be used together with '--%s'", interactive.Mode, fixFlag)
+		}
+		if options.Fix && mode == "" {
+			mode, err = interactive.GetOptionMode(command, interactive.ModeAuto, "Tags remediation mode")
+			if err != nil {
+				return fmt.Errorf("Expected a valid remediation mode: %v", err)
+			}
+		}
+
+		requiredTags, err := tagaudit.ParseRequiredTags(options.RequiredTags)
+		if err != nil {
+			return err
+		}
+		if options.RequiredTagsFile != "" {
+			fileTags, err := tagaudit.ReadRequiredTagsFile(options.RequiredTagsFile)
+			if err != nil {
+				return err
+			}
+			requiredTags, err = tagaudit.MergeRequiredTags(requiredTags, fileTags)
+			if err != nil {
+				return err
+			}
+		}
+
+		subnetIDs := options.SubnetIDs
+		if command.Flags().Changed(clusterFlag) && !command.Flags().Changed(subnetIDsFlag) {
+			cluster := r.FetchCluster()
+			if !helper.IsBYOVPC(cluster) {
+				return fmt.Errorf("Cluster '%s' does not use subnets supplied by the user, "+
+					"use '--%s' to verify other subnets", r.ClusterKey, subnetIDsFlag)
+			}
+			subnetIDs = cluster.AWS().SubnetIDs()
+		}
+
+		var spin *spinner.Spinner
+		if r.Reporter.IsTerminal() {
+			spin = spinner.New(spinner.CharSets[9], 100*time.Millisecond)
+			r.Reporter.Infof("Verifying the tags of the ROSA resources in AWS account '%s'", r.Creator.AccountID)
+			spin.Start()
+		}
+		auditor := tagaudit.NewAuditor(r.AWSClient, r.OCMClient, r.Creator.AccountID, requiredTags, subnetIDs)
+		result, err := auditor.Audit()
+		if spin != nil {
+			spin.Stop()
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to verify tags: %v", err)
+		}
+
+		if result.IsCompliant() {
+			r.Reporter.Infof("All %d resources have the required tags", checkedCount(result))
+			return nil
+		}
+
+		printFindings(result)
+
+		if !options.Fix {
+			return fmt.Errorf("Found %d missing or incorrect tags, run with '--%s' to add them",
+				len(result.Findings), fixFlag)
+		}
+
+		switch mode {
+		case interactive.ModeAuto:
+			r.OCMClient.LogEvent("ROSAVerifyTagsModeAuto", nil)
+			failed := false
+			for _, fix := range result.Fixes() {
+				r.Reporter.Infof("Tagging %s '%s'", fix.ResourceType, fix.Resource)
+				err := auditor.Apply(fix)
+				if err != nil {
+					r.Reporter.Warnf("There was an error tagging %s '%s': %v", fix.ResourceType, fix.Resource, err)
+					failed = true
+				}
+			}
+			if failed {
+				return fmt.Errorf("Some tags could not be added")
+			}
+		case interactive.ModeManual:
+			r.OCMClient.LogEvent("ROSAVerifyTagsModeManual", nil)
+			commands := buildCommands(result.Fixes())
+			if len(commands) > 0 {
+				if r.Reporter.IsTerminal() {
+					r.Reporter.Infof("Run the following commands to add the missing tags:\n")
+				}
+				fmt.Println(awscb.JoinCommands(commands))
+			}
+		default:
+			return fmt.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
+		}
+
+		unfixable := result.Unfixable()
+		if len(unfixable) > 0 {
+			return fmt.Errorf("%d tags have no known value and must be added by hand", len(unfixable))
+		}
+		if mode == interactive.ModeAuto {
+			r.Reporter.Infof("Successfully added the missing tags")
+		}
+		return nil
+	}
+}
+
+func checkedCount(result *tagaudit.Result) int {
+	count := 0
+	for _, checked := range result.Checked {
+		count += checked
+	}
+	return count
+}
+
+func printFindings(result *tagaudit.Result) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "TYPE\tRESOURCE\tTAG\tEXPECTED\tACTUAL\n")
+	for _, finding := range result.Findings {
+		expected := finding.Expected
+		if expected == "" {
+			expected = "<any>"
+		}
+		actual := finding.Actual
+		if finding.Missing {
+			actual = "<missing>"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+			finding.ResourceType, finding.Resource, finding.Key, expected, actual)
+	}
+	writer.Flush()
+}
+
+func buildCommands(fixes []tagaudit.Fix) []string {
+	commands := []string{}
+	for _, fix := range fixes {
+		switch fix.ResourceType {
+		case tagaudit.AccountRole, tagaudit.OperatorRole:
+			commands = append(commands, awscb.NewIAMCommandBuilder().
+				SetCommand(awscb.TagRole).
+				AddParam(awscb.RoleName, fix.Resource).
+				AddTags(fix.Tags).
+				Build())
+		case tagaudit.Policy:
+			commands = append(commands, awscb.NewIAMCommandBuilder().
+				SetCommand(awscb.TagPolicy).
+				AddParam(awscb.PolicyArn, fix.Resource).
+				AddTags(fix.Tags).
+				Build())
+		case tagaudit.OidcProvider:
+			commands = append(commands, awscb.NewIAMCommandBuilder().
+				SetCommand(awscb.TagOpenIdConnectProvider).
+				AddParam(awscb.OpenIdConnectProviderArn, fix.Resource).
+				AddTags(fix.Tags).
+				Build())
+		case tagaudit.Subnet:
+			commands = append(commands, awscb.NewEC2CommandBuilder().
+				SetCommand(awscb.CreateTags).
+				AddParam(awscb.Resources, fix.Resource).
+				AddTags(fix.Tags).
+				Build())
+		}
+	}
+	return commands
+}
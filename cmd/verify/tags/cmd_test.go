@@ -0,0 +1,143 @@
+package tags
+
+import (
+	"context"
+	"net/http"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/interactive"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("verify tags", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewVerifyTagsCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+
+		Expect(cmd.Flags().Lookup(requiredTagsFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(requiredTagsFileFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(subnetIDsFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(fixFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(clusterFlag)).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("mode")).NotTo(BeNil())
+	})
+
+	Context("Verify Tags Runner", func() {
+		const roleName = "foo-Installer-Role"
+
+		var (
+			t         *TestingRuntime
+			awsClient *aws.MockClient
+		)
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			interactive.SetModeKey("")
+		})
+
+		AfterEach(func() {
+			interactive.SetModeKey("")
+		})
+
+		expectRole := func(roleTags map[string]string) {
+			awsClient.EXPECT().ListRoles().Return([]iamtypes.Role{{RoleName: awssdk.String(roleName)}}, nil)
+			awsClient.EXPECT().GetRoleTags(roleName).Return(roleTags, nil)
+			awsClient.EXPECT().ListAttachedRolePolicies(roleName).Return([]string{}, nil)
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+		}
+
+		It("Reports when every resource is tagged", func() {
+			expectRole(map[string]string{
+				tags.RedHatManaged: tags.True,
+				tags.RolePrefix:    "foo",
+				tags.RoleType:      aws.InstallerAccountRole,
+			})
+
+			t.StdOutReader.Record()
+			runner := VerifyTagsRunner(&VerifyTagsOptions{})
+			err := runner(context.Background(), t.RosaRuntime, NewVerifyTagsCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(Equal("INFO: All 1 resources have the required tags\n"))
+		})
+
+		It("Fails and lists the missing tags without fixing them", func() {
+			expectRole(map[string]string{
+				tags.RolePrefix: "foo",
+				tags.RoleType:   aws.InstallerAccountRole,
+			})
+			awsClient.EXPECT().AddRoleTag(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			t.StdOutReader.Record()
+			runner := VerifyTagsRunner(&VerifyTagsOptions{RequiredTags: []string{"cost-center"}})
+			err := runner(context.Background(), t.RosaRuntime, NewVerifyTagsCommand(), nil)
+			Expect(err).To(MatchError("Found 2 missing or incorrect tags, run with '--fix' to add them"))
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("account-role  foo-Installer-Role  cost-center      <any>     <missing>"))
+			Expect(stdOut).To(ContainSubstring("account-role  foo-Installer-Role  red-hat-managed  true      <missing>"))
+		})
+
+		It("Adds the missing tags in auto mode", func() {
+			cmd := NewVerifyTagsCommand()
+			interactive.SetModeKey(interactive.ModeAuto)
+			expectRole(map[string]string{
+				tags.RedHatManaged: tags.True,
+				tags.RoleType:      aws.InstallerAccountRole,
+			})
+			awsClient.EXPECT().AddRoleTag(roleName, tags.RolePrefix, "foo").Return(nil)
+
+			runner := VerifyTagsRunner(&VerifyTagsOptions{Fix: true})
+			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Prints the AWS commands in manual mode", func() {
+			cmd := NewVerifyTagsCommand()
+			interactive.SetModeKey(interactive.ModeManual)
+			expectRole(map[string]string{
+				tags.RedHatManaged: tags.True,
+				tags.RolePrefix:    "foo",
+				tags.RoleType:      aws.InstallerAccountRole,
+			})
+			awsClient.EXPECT().ListSubnets("subnet-1").Return([]ec2types.Subnet{
+				{SubnetId: awssdk.String("subnet-1")},
+			}, nil)
+			awsClient.EXPECT().FetchPublicSubnetMap(gomock.Any()).Return(map[string]bool{"subnet-1": false}, nil)
+
+			t.StdOutReader.Record()
+			runner := VerifyTagsRunner(&VerifyTagsOptions{Fix: true, SubnetIDs: []string{"subnet-1"}})
+			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdOut, _ := t.StdOutReader.Read()
+			Expect(stdOut).To(ContainSubstring("aws ec2 create-tags \\\n\t--resources subnet-1 \\\n" +
+				"\t--tags Key=kubernetes.io/role/internal-elb,Value=1"))
+		})
+
+		It("Rejects the mode flag without fix", func() {
+			cmd := NewVerifyTagsCommand()
+			interactive.SetModeKey(interactive.ModeAuto)
+			runner := VerifyTagsRunner(&VerifyTagsOptions{})
+			err := runner(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("The '--mode' flag can only be used together with '--fix'"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package tags
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVerifyTags(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Verify Tags Suite")
+}
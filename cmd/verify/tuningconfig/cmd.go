@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tuningconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/input"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/tuningconfig"
+)
+
+const (
+	use   = "tuning-config"
+	short = "Verify the spec of a tuning config"
+	long  = "Validates the Tuned spec of a tuning config without sending it to a cluster: the structure of " +
+		"the spec, the INI syntax of the TuneD profiles, the labels matched by the recommendations, their " +
+		"priorities and the profiles they refer to. The same validation runs before 'rosa create " +
+		"tuning-configs' and 'rosa edit tuning-configs' send the spec."
+	example = `  # Verify the spec of a tuning config before creating it
+  rosa verify tuning-config --spec-path=spec.yaml`
+
+	specPathFlag = "spec-path"
+)
+
+var aliases = []string{"tuning-configs", "tuningconfig", "tuningconfigs"}
+
+type VerifyTuningConfigOptions struct {
+	SpecPath string
+}
+
+func NewVerifyTuningConfigCommand() *cobra.Command {
+	options := &VerifyTuningConfigOptions{}
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: aliases,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.DefaultRuntime(), VerifyTuningConfigRunner(options)),
+	}
+
+	cmd.Flags().StringVar(
+		&options.SpecPath,
+		specPathFlag,
+		"",
+		"Path of the file containing the spec section of the tuning config to verify.",
+	)
+	cmd.MarkFlagRequired(specPathFlag)
+	return cmd
+}
+
+func VerifyTuningConfigRunner(options *VerifyTuningConfigOptions) rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		spec, err := input.UnmarshalInputFile(options.SpecPath)
+		if err != nil {
+			return fmt.Errorf("Expected a valid TuneD spec file: %v", err)
+		}
+		err = tuningconfig.ValidateSpec(spec)
+		var specErr *tuningconfig.SpecError
+		if errors.As(err, &specErr) {
+			return fmt.Errorf("Tuning config spec '%s' is not valid:\n  - %s", options.SpecPath,
+				strings.Join(specErr.Problems, "\n  - "))
+		}
+		if err != nil {
+			return err
+		}
+		r.Reporter.Infof("Tuning config spec '%s' is valid", options.SpecPath)
+		return nil
+	}
+}
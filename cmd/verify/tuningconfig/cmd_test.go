@@ -0,0 +1,61 @@
+package tuningconfig
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("verify tuning-config", func() {
+	It("Correctly builds the command", func() {
+		cmd := NewVerifyTuningConfigCommand()
+		Expect(cmd).NotTo(BeNil())
+
+		Expect(cmd.Use).To(Equal(use))
+		Expect(cmd.Short).To(Equal(short))
+		Expect(cmd.Long).To(Equal(long))
+		Expect(cmd.Run).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup(specPathFlag)).NotTo(BeNil())
+	})
+
+	Context("Verify Tuning Config Runner", func() {
+		var t *TestingRuntime
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+		})
+
+		It("Accepts the example spec", func() {
+			options := &VerifyTuningConfigOptions{SpecPath: "../../create/tuningconfigs/spec.yaml"}
+			Expect(t.StdOutReader.Record()).To(Succeed())
+			err := VerifyTuningConfigRunner(options)(context.Background(), t.RosaRuntime,
+				NewVerifyTuningConfigCommand(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			stdout, err := t.StdOutReader.Read()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("INFO: Tuning config spec '../../create/tuningconfigs/spec.yaml' is valid\n"))
+		})
+
+		It("Lists the problems of an invalid spec", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "spec.yaml")
+			Expect(os.WriteFile(path, []byte(`profile:
+- name: tuned-1-profile
+  data: "vm.dirty_ratio=55"
+recommend:
+- profile: tuned-1-profile
+`), 0600)).To(Succeed())
+			options := &VerifyTuningConfigOptions{SpecPath: path}
+			err := VerifyTuningConfigRunner(options)(context.Background(), t.RosaRuntime,
+				NewVerifyTuningConfigCommand(), nil)
+			Expect(err).To(MatchError(fmt.Sprintf("Tuning config spec '%s' is not valid:\n"+
+				"  - profile[0].data: line 1: key 'vm.dirty_ratio' is outside of a section\n"+
+				"  - recommend[0].priority: required field is missing", path)))
+		})
+	})
+})
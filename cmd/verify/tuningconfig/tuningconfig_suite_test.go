@@ -0,0 +1,13 @@
+package tuningconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestVerifyTuningConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Verify Tuning Config Suite")
+}
@@ -0,0 +1,13 @@
+package addon
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAddon(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Addon Suite")
+}
@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	asv1 "github.com/openshift-online/ocm-sdk-go/addonsmgmt/v1"
+	"github.com/spf13/pflag" //nolint:depguard
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Params are the values of the parameters of an add-on installation by parameter ID
+type Params map[string]string
+
+// ParamsError is the problems found in the values of the parameters of an add-on
+type ParamsError struct {
+	Problems []string
+}
+
+func (e *ParamsError) Error() string {
+	return strings.Join(e.Problems, "; ")
+}
+
+// LoadParams reads a parameters file, a YAML object with the values of the parameters by parameter ID
+func LoadParams(path string) (Params, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseParams(data)
+}
+
+// ParseParams parses the contents of a parameters file. Booleans and numbers are accepted for convenience and
+// converted to the strings that the parameters are stored as.
+func ParseParams(data []byte) (Params, error) {
+	values := map[string]interface{}{}
+	err := yaml.Unmarshal(data, &values)
+	if err != nil {
+		return nil, fmt.Errorf("expected a YAML object with the values of the parameters: %v", err)
+	}
+	params := Params{}
+	for id, value := range values {
+		switch typed := value.(type) {
+		case string:
+			params[id] = typed
+		case bool:
+			params[id] = strconv.FormatBool(typed)
+		case float64:
+			params[id] = strconv.FormatFloat(typed, 'f', -1, 64)
+		case nil:
+			params[id] = ""
+		default:
+			return nil, fmt.Errorf("expected a string, number or boolean value for parameter '%s'", id)
+		}
+	}
+	return params, nil
+}
+
+// ParamsFromFile reads the values of the parameters from a file, the parameters set as flags override them.
+// The values are resolved with ResolveParams against the values of the installation in current, nil when
+// installing.
+func ParamsFromFile(flags *pflag.FlagSet, parameters *asv1.AddonParameterList, path string,
+	current Params) ([]ocm.AddOnParam, error) {
+	values, err := LoadParams(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parameters file '%s': %v", path, err)
+	}
+	parameters.Each(func(param *asv1.AddonParameter) bool {
+		flag := flags.Lookup(param.ID())
+		if flag != nil && flag.Changed {
+			values[param.ID()] = flag.Value.String()
+		}
+		return true
+	})
+	return ResolveParams(parameters, values, current)
+}
+
+// InstallationParams returns the values of the parameters of an existing add-on installation
+func InstallationParams(installation *asv1.AddonInstallation) Params {
+	params := Params{}
+	installation.Parameters().Each(func(param *asv1.AddonInstallationParameter) bool {
+		params[param.Id()] = param.Value()
+		return true
+	})
+	return params
+}
+
+// ResolveParams validates the values of the parameters of an add-on against its parameter definitions: the
+// parameters must exist, have a value of their type that matches their validation and options, and the
+// required parameters must have a value. Parameters without a value take the value of the installation in
+// current, nil when installing, and otherwise their default value.
+//
+// The parameters of the installation that can't be modified are left out of the arguments.
+// It returns the arguments for the installation in the order of the parameter definitions, or a *ParamsError
+// with all the problems found.
+func ResolveParams(parameters *asv1.AddonParameterList, values Params, current Params) ([]ocm.AddOnParam, error) {
+	problems := []string{}
+	known := map[string]bool{}
+	arguments := []ocm.AddOnParam{}
+	parameters.Each(func(param *asv1.AddonParameter) bool {
+		known[param.ID()] = true
+		currentValue, installed := current[param.ID()]
+		value, ok := values[param.ID()]
+		if installed && !param.Editable() {
+			// The installation keeps the values of the parameters that can't be modified
+			if ok && value != currentValue {
+				problems = append(problems, fmt.Sprintf("parameter '%s' can't be modified", param.ID()))
+			}
+			return true
+		}
+		if !ok {
+			value = param.DefaultValue()
+			if installed {
+				value = currentValue
+			}
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			if param.Required() {
+				problems = append(problems, fmt.Sprintf("parameter '%s' is required", param.ID()))
+			}
+			arguments = append(arguments, ocm.AddOnParam{Key: param.ID(), Val: value})
+			return true
+		}
+		if err := validateParam(param, value); err != nil {
+			problems = append(problems, fmt.Sprintf("parameter '%s': %v", param.ID(), err))
+		}
+		arguments = append(arguments, ocm.AddOnParam{Key: param.ID(), Val: value})
+		return true
+	})
+
+	unknown := []string{}
+	for id := range values {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Strings(unknown)
+	for _, id := range unknown {
+		problems = append(problems, fmt.Sprintf("unknown parameter '%s'", id))
+	}
+
+	if len(problems) > 0 {
+		return nil, &ParamsError{Problems: problems}
+	}
+	return arguments, nil
+}
+
+func validateParam(param *asv1.AddonParameter, value string) error {
+	switch param.ValueType() {
+	case asv1.AddonParameterValueTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("expected a boolean, got '%s'", value)
+		}
+	case asv1.AddonParameterValueTypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("expected a number, got '%s'", value)
+		}
+	case asv1.AddonParameterValueTypeResource:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("expected an integer, got '%s'", value)
+		}
+	case asv1.AddonParameterValueTypeCIDR:
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return fmt.Errorf("expected a CIDR, got '%s'", value)
+		}
+	}
+
+	if param.Validation() != "" {
+		isValid, err := regexp.MatchString(param.Validation(), value)
+		if err != nil || !isValid {
+			if param.ValidationErrMsg() != "" {
+				return fmt.Errorf("%s", param.ValidationErrMsg())
+			}
+			return fmt.Errorf("expected '%s' to match /%s/", value, param.Validation())
+		}
+	}
+
+	options, ok := param.GetOptions()
+	if ok && len(options) > 0 {
+		values := []string{}
+		for _, option := range options {
+			if option.Value() == value {
+				return nil
+			}
+			values = append(values, option.Value())
+		}
+		return fmt.Errorf("expected '%s' to be one of '%s'", value, strings.Join(values, "', '"))
+	}
+	return nil
+}
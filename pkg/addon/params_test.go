@@ -0,0 +1,156 @@
+package addon
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	asv1 "github.com/openshift-online/ocm-sdk-go/addonsmgmt/v1"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+func buildParameters(params ...*asv1.AddonParameterBuilder) *asv1.AddonParameterList {
+	list, err := asv1.NewAddonParameterList().Items(params...).Build()
+	Expect(err).NotTo(HaveOccurred())
+	return list
+}
+
+func paramsProblems(err error) []string {
+	Expect(err).To(HaveOccurred())
+	paramsErr, ok := err.(*ParamsError)
+	Expect(ok).To(BeTrue())
+	return paramsErr.Problems
+}
+
+var _ = Describe("ParseParams", func() {
+	It("Converts booleans and numbers to strings", func() {
+		params, err := ParseParams([]byte(`
+notification-email: ops@example.com
+enable-metrics: true
+replicas: 3
+ratio: 0.5
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(params).To(Equal(Params{
+			"notification-email": "ops@example.com",
+			"enable-metrics":     "true",
+			"replicas":           "3",
+			"ratio":              "0.5",
+		}))
+	})
+
+	It("Fails with nested values", func() {
+		_, err := ParseParams([]byte(`
+storage:
+  size: 10
+`))
+		Expect(err).To(MatchError("expected a string, number or boolean value for parameter 'storage'"))
+	})
+
+	It("Fails with a list", func() {
+		_, err := ParseParams([]byte(`- a`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ResolveParams", func() {
+	var parameters *asv1.AddonParameterList
+
+	BeforeEach(func() {
+		parameters = buildParameters(
+			asv1.NewAddonParameter().ID("email").ValueType(asv1.AddonParameterValueTypeString).
+				Required(true).Editable(true).Validation(`^[^@]+@[^@]+$`),
+			asv1.NewAddonParameter().ID("replicas").ValueType(asv1.AddonParameterValueTypeNumber).
+				DefaultValue("2").Editable(true),
+			asv1.NewAddonParameter().ID("metrics").ValueType(asv1.AddonParameterValueTypeBoolean).
+				DefaultValue("false").Editable(true),
+			asv1.NewAddonParameter().ID("size").ValueType(asv1.AddonParameterValueTypeString).
+				DefaultValue("small").Options(
+				asv1.NewAddonParameterOption().Name("Small").Value("small"),
+				asv1.NewAddonParameterOption().Name("Large").Value("large"),
+			),
+		)
+	})
+
+	It("Fills the defaults in the order of the parameters", func() {
+		arguments, err := ResolveParams(parameters, Params{"email": "ops@example.com"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arguments).To(Equal([]ocm.AddOnParam{
+			{Key: "email", Val: "ops@example.com"},
+			{Key: "replicas", Val: "2"},
+			{Key: "metrics", Val: "false"},
+			{Key: "size", Val: "small"},
+		}))
+	})
+
+	It("Reports all the problems", func() {
+		_, err := ResolveParams(parameters, Params{
+			"replicas": "many",
+			"metrics":  "yes",
+			"size":     "medium",
+			"colour":   "blue",
+		}, nil)
+		Expect(paramsProblems(err)).To(Equal([]string{
+			"parameter 'email' is required",
+			"parameter 'replicas': expected a number, got 'many'",
+			"parameter 'metrics': expected a boolean, got 'yes'",
+			"parameter 'size': expected 'medium' to be one of 'small', 'large'",
+			"unknown parameter 'colour'",
+		}))
+	})
+
+	It("Validates the value against the regular expression", func() {
+		_, err := ResolveParams(parameters, Params{"email": "ops"}, nil)
+		Expect(paramsProblems(err)).To(Equal([]string{
+			"parameter 'email': expected 'ops' to match /^[^@]+@[^@]+$/",
+		}))
+	})
+
+	It("Keeps the values of the installation and leaves out the parameters that can't be modified", func() {
+		current := Params{"email": "ops@example.com", "replicas": "5", "size": "large"}
+		arguments, err := ResolveParams(parameters, Params{"metrics": "true"}, current)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arguments).To(Equal([]ocm.AddOnParam{
+			{Key: "email", Val: "ops@example.com"},
+			{Key: "replicas", Val: "5"},
+			{Key: "metrics", Val: "true"},
+		}))
+	})
+
+	It("Fails to modify a parameter that can't be modified", func() {
+		current := Params{"email": "ops@example.com", "size": "large"}
+		_, err := ResolveParams(parameters, Params{"size": "small"}, current)
+		Expect(paramsProblems(err)).To(Equal([]string{"parameter 'size' can't be modified"}))
+	})
+})
+
+var _ = Describe("ParamsFromFile", func() {
+	It("Overrides the values of the file with the parameters set as flags", func() {
+		parameters := buildParameters(
+			asv1.NewAddonParameter().ID("email").ValueType(asv1.AddonParameterValueTypeString).Editable(true),
+			asv1.NewAddonParameter().ID("replicas").ValueType(asv1.AddonParameterValueTypeNumber).Editable(true),
+		)
+		path := filepath.Join(GinkgoT().TempDir(), "params.yaml")
+		Expect(os.WriteFile(path, []byte("email: ops@example.com\nreplicas: 3\n"), 0600)).To(Succeed())
+		flags := pflag.NewFlagSet("install", pflag.ContinueOnError)
+		flags.String("email", "", "")
+		flags.String("replicas", "", "")
+		Expect(flags.Set("replicas", "5")).To(Succeed())
+
+		arguments, err := ParamsFromFile(flags, parameters, path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(arguments).To(Equal([]ocm.AddOnParam{
+			{Key: "email", Val: "ops@example.com"},
+			{Key: "replicas", Val: "5"},
+		}))
+	})
+
+	It("Fails for a missing file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "params.yaml")
+		_, err := ParamsFromFile(pflag.NewFlagSet("install", pflag.ContinueOnError), buildParameters(), path, nil)
+		Expect(err).To(MatchError(ContainSubstring("failed to read parameters file '" + path + "'")))
+	})
+})
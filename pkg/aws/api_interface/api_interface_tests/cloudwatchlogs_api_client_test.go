@@ -0,0 +1,21 @@
+package aws_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	. "github.com/onsi/ginkgo/v2"
+
+	client "github.com/openshift/rosa/pkg/aws/api_interface"
+	m "github.com/openshift/rosa/pkg/aws/mocks"
+)
+
+var _ = Describe("CloudWatchLogsApiClient", func() {
+	It("is implemented by AWS SDK CloudWatch Logs Client", func() {
+		awsCloudWatchLogsClient := &cloudwatchlogs.Client{}
+		var _ client.CloudWatchLogsApiClient = awsCloudWatchLogsClient
+	})
+
+	It("is implemented by MockCloudWatchLogsApiClient", func() {
+		mockCloudWatchLogsApiClient := &m.MockCloudWatchLogsApiClient{}
+		var _ client.CloudWatchLogsApiClient = mockCloudWatchLogsApiClient
+	})
+})
@@ -0,0 +1,31 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+)
+
+// CloudWatchLogsApiClient is an interface that defines the methods that we want to use
+// from the Client type in the AWS SDK ("github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs")
+// The aim is to only contain methods that are defined in the AWS SDK's CloudWatch Logs
+// Client.
+// For the cases where logic is desired to be implemened combining CloudWatch Logs calls
+// and other logic use the pkg/aws.Client type.
+// If you need to use a method provided by the AWS SDK's CloudWatch Logs Client but it
+// is not defined in this interface then it has to be added and all
+// the types implementing this interface have to implement the new method.
+// The reason this interface has been defined is so we can perform unit testing
+// on methods that make use of the AWS CloudWatch Logs service.
+//
+
+type CloudWatchLogsApiClient interface {
+	DescribeLogGroups(ctx context.Context,
+		params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options),
+	) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+}
+
+// interface guard to ensure that all methods defined in the CloudWatchLogsApiClient
+// interface are implemented by the real AWS CloudWatch Logs client. This interface
+// guard should always compile
+var _ CloudWatchLogsApiClient = (*cloudwatchlogs.Client)(nil)
@@ -36,6 +36,9 @@ type Ec2ApiClient interface {
 	DescribeSubnets(ctx context.Context, params *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options),
 	) (*ec2.DescribeSubnetsOutput, error)
 
+	DescribeVpcs(ctx context.Context, params *ec2.DescribeVpcsInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeVpcsOutput, error)
+
 	DescribeInstanceTypeOfferings(ctx context.Context,
 		params *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options),
 	) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
@@ -43,6 +46,13 @@ type Ec2ApiClient interface {
 	DescribeInstances(ctx context.Context,
 		params *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options),
 	) (*ec2.DescribeInstancesOutput, error)
+
+	DescribeCapacityReservations(ctx context.Context,
+		params *ec2.DescribeCapacityReservationsInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeCapacityReservationsOutput, error)
+
+	CreateTags(ctx context.Context, params *ec2.CreateTagsInput, optFns ...func(*ec2.Options),
+	) (*ec2.CreateTagsOutput, error)
 }
 
 // interface guard to ensure that all methods defined in the Ec2ApiClient
@@ -164,6 +164,10 @@ type IamApiClient interface {
 		params *iam.TagRoleInput, optFns ...func(*iam.Options),
 	) (*iam.TagRoleOutput, error)
 
+	TagOpenIDConnectProvider(ctx context.Context,
+		params *iam.TagOpenIDConnectProviderInput, optFns ...func(*iam.Options),
+	) (*iam.TagOpenIDConnectProviderOutput, error)
+
 	UpdateAssumeRolePolicy(ctx context.Context,
 		params *iam.UpdateAssumeRolePolicyInput, optFns ...func(*iam.Options),
 	) (*iam.UpdateAssumeRolePolicyOutput, error)
@@ -31,6 +31,14 @@ type S3ApiClient interface {
 		params *s3.DeleteObjectInput, optFns ...func(*s3.Options),
 	) (*s3.DeleteObjectOutput, error)
 
+	GetBucketEncryption(ctx context.Context,
+		params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options),
+	) (*s3.GetBucketEncryptionOutput, error)
+
+	GetBucketLocation(ctx context.Context,
+		params *s3.GetBucketLocationInput, optFns ...func(*s3.Options),
+	) (*s3.GetBucketLocationOutput, error)
+
 	HeadBucket(context.Context,
 		*s3.HeadBucketInput, ...func(*s3.Options),
 	) (*s3.HeadBucketOutput, error)
@@ -34,6 +34,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	cftypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -115,6 +117,8 @@ type Client interface {
 	GetCreator() (*Creator, error)
 	ValidateSCP(*string, map[string]*cmv1.AWSSTSPolicy) (bool, error)
 	ListSubnets(subnetIds ...string) ([]ec2types.Subnet, error)
+	ListVpcs(vpcIds ...string) ([]ec2types.Vpc, error)
+	ListVpcRouteTables(vpcID string) ([]ec2types.RouteTable, error)
 	GetSubnetAvailabilityZone(subnetID string) (string, error)
 	GetAvailabilityZoneType(availabilityZoneName string) (string, error)
 	GetVPCSubnets(subnetID string) ([]ec2types.Subnet, error)
@@ -141,10 +145,12 @@ type Client interface {
 	FindPolicyARN(operator Operator, version string) (string, error)
 	ListUserRoles() ([]Role, error)
 	ListOCMRoles() ([]Role, error)
+	ListRoles() ([]iamtypes.Role, error)
 	ListAccountRoles(version string) ([]Role, error)
 	ListOperatorRoles(version string, clusterID string, prefix string) (map[string][]OperatorRoleDetail, error)
 	ListAttachedRolePolicies(roleName string) ([]string, error)
 	ListOidcProviders(targetClusterId string, config *cmv1.OidcConfig) ([]OidcProviderOutput, error)
+	GetOpenIDConnectProviderCreateDate(providerArn string) (time.Time, error)
 	GetRoleByARN(roleARN string) (iamtypes.Role, error)
 	GetRoleByName(roleName string) (iamtypes.Role, error)
 	DeleteOperatorRole(roles string, managedPolicies bool, deleteHcpSharedVpcPolicies bool) (map[string]bool, error)
@@ -188,6 +194,12 @@ type Client interface {
 	) (bool, error)
 	UpdateTag(roleName string, defaultPolicyVersion string) error
 	AddRoleTag(roleName string, key string, value string) error
+	GetRoleTags(roleName string) (map[string]string, error)
+	GetPolicyTags(policyArn string) (map[string]string, error)
+	AddPolicyTags(policyArn string, tagList map[string]string) error
+	GetOpenIDConnectProviderTags(providerArn string) (map[string]string, error)
+	AddOpenIDConnectProviderTags(providerArn string, tagList map[string]string) error
+	AddSubnetTags(subnetID string, tagList map[string]string) error
 	IsPolicyCompatible(policyArn string, version string) (bool, error)
 	GetAccountRoleVersion(roleName string) (string, error)
 	IsPolicyExists(policyARN string) (*iam.GetPolicyOutput, error)
@@ -224,9 +236,17 @@ type Client interface {
 	ListPolicyVersions(policyArn string) ([]PolicyVersion, error)
 	GetCallerIdentity() (*sts.GetCallerIdentityOutput, error)
 	CheckIfMachinePoolHasDedicatedHost(instanceIDs []string) (bool, error)
+	ListMachinePoolInstances(infraID string, machinePoolID string) ([]ec2types.Instance, error)
+	CountMachinePoolSpotInterruptions(infraID string, machinePoolID string) (int, error)
+	ListCapacityReservations(capacityReservationIDs ...string) ([]ec2types.CapacityReservation, error)
+	GetBucketRegion(bucketName string) (string, error)
+	GetBucketEncryption(bucketName string, region string) (*BucketEncryption, error)
+	GetLogGroup(logGroupName string) (*cwltypes.LogGroup, error)
+	FindDeniedActions(principalARN string, actions []string, resourceARNs []string) ([]string, error)
 	CreateStackWithParamsTags(ctx context.Context, cfTemplateBody, stackName string,
 		stackParams, stackTags map[string]string) (*string, error)
 	GetCFStack(ctx context.Context, stackName string) (*cftypes.Stack, error)
+	ListCFStacks(ctx context.Context) ([]cftypes.Stack, error)
 	DescribeCFStackResources(ctx context.Context, stackName string) (*[]cftypes.StackResource, error)
 	DeleteCFStack(ctx context.Context, stackName string) error
 	// Service account role filtering (only add the filtering functionality we need)
@@ -260,6 +280,7 @@ type awsClient struct {
 	smClient            client.SecretsManagerApiClient
 	stsClient           client.StsApiClient
 	cfClient            client.CloudFormationApiClient
+	cwlClient           client.CloudWatchLogsApiClient
 	serviceQuotasClient client.ServiceQuotasApiClient
 	iamQuotaClient      client.ServiceQuotasApiClient
 	awsAccessKeys       *AccessKey
@@ -293,6 +314,7 @@ func New(
 	smClient client.SecretsManagerApiClient,
 	stsClient client.StsApiClient,
 	cfClient client.CloudFormationApiClient,
+	cwlClient client.CloudWatchLogsApiClient,
 	serviceQuotasClient client.ServiceQuotasApiClient,
 	iamQuotaClient client.ServiceQuotasApiClient,
 	awsAccessKeys *AccessKey,
@@ -308,6 +330,7 @@ func New(
 		smClient,
 		stsClient,
 		cfClient,
+		cwlClient,
 		serviceQuotasClient,
 		iamQuotaClient,
 		awsAccessKeys,
@@ -341,6 +364,111 @@ func (c *awsClient) CheckIfMachinePoolHasDedicatedHost(instanceIDs []string) (bo
 	return false, nil
 }
 
+// ListMachinePoolInstances returns the running instances of a classic machine pool. The machine API
+// names the instances '<infra-id>-<machine-pool>-<zone>-<suffix>' and tags them as owned by the cluster.
+// The name filter of EC2 only supports wildcards, which also match pools whose name starts with the
+// name of this one, so the instances are matched again against the zone they are placed in.
+func (c *awsClient) ListMachinePoolInstances(infraID string, machinePoolID string) ([]ec2types.Instance, error) {
+	return c.describeMachinePoolInstances(infraID, machinePoolID, ec2types.InstanceStateNameRunning)
+}
+
+// CountMachinePoolSpotInterruptions returns the number of spot instances of a classic machine pool that
+// were reclaimed by EC2. Terminated instances are only listed by EC2 for about an hour, so this only
+// covers recent interruptions.
+func (c *awsClient) CountMachinePoolSpotInterruptions(infraID string, machinePoolID string) (int, error) {
+	instances, err := c.describeMachinePoolInstances(infraID, machinePoolID,
+		ec2types.InstanceStateNameShuttingDown, ec2types.InstanceStateNameTerminated)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, instance := range instances {
+		if instance.StateReason != nil &&
+			aws.ToString(instance.StateReason.Code) == "Server.SpotInstanceTermination" {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (c *awsClient) describeMachinePoolInstances(infraID string, machinePoolID string,
+	states ...ec2types.InstanceStateName) ([]ec2types.Instance, error) {
+	stateNames := []string{}
+	for _, state := range states {
+		stateNames = append(stateNames, string(state))
+	}
+	input := &ec2.DescribeInstancesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String(fmt.Sprintf("tag:kubernetes.io/cluster/%s", infraID)),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("tag:Name"),
+				Values: []string{fmt.Sprintf("%s-%s-*", infraID, machinePoolID)},
+			},
+			{
+				Name:   aws.String("instance-state-name"),
+				Values: stateNames,
+			},
+		},
+	}
+
+	instances := []ec2types.Instance{}
+	paginator := ec2.NewDescribeInstancesPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe instances: %w", err)
+		}
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if isMachinePoolInstance(instance, infraID, machinePoolID) {
+					instances = append(instances, instance)
+				}
+			}
+		}
+	}
+	return instances, nil
+}
+
+// isMachinePoolInstance checks that the name of the instance is exactly the name of a machine of the
+// machine pool, '<infra-id>-<machine-pool>-<zone>-<suffix>' where the suffix contains no dashes
+func isMachinePoolInstance(instance ec2types.Instance, infraID string, machinePoolID string) bool {
+	if instance.Placement == nil || aws.ToString(instance.Placement.AvailabilityZone) == "" {
+		return false
+	}
+	name := ""
+	for _, tag := range instance.Tags {
+		if aws.ToString(tag.Key) == "Name" {
+			name = aws.ToString(tag.Value)
+			break
+		}
+	}
+	prefix := fmt.Sprintf("%s-%s-%s-", infraID, machinePoolID, aws.ToString(instance.Placement.AvailabilityZone))
+	suffix, found := strings.CutPrefix(name, prefix)
+	return found && suffix != "" && !strings.Contains(suffix, "-")
+}
+
+// ListCapacityReservations returns the capacity reservations of the region, or only the given ones
+func (c *awsClient) ListCapacityReservations(
+	capacityReservationIDs ...string) ([]ec2types.CapacityReservation, error) {
+	input := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: capacityReservationIDs,
+	}
+
+	capacityReservations := []ec2types.CapacityReservation{}
+	paginator := ec2.NewDescribeCapacityReservationsPaginator(c.ec2Client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe capacity reservations: %w", err)
+		}
+		capacityReservations = append(capacityReservations, output.CapacityReservations...)
+	}
+	return capacityReservations, nil
+}
+
 // Logger sets the logger that the AWS client will use to send messages to the log.
 func (b *ClientBuilder) Logger(value *logrus.Logger) *ClientBuilder {
 	b.logger = value
@@ -510,6 +638,7 @@ func (b *ClientBuilder) Build() (Client, error) {
 		smClient:            secretsmanager.NewFromConfig(cfg),
 		stsClient:           sts.NewFromConfig(cfg),
 		cfClient:            cloudformation.NewFromConfig(cfg),
+		cwlClient:           cloudwatchlogs.NewFromConfig(cfg),
 		serviceQuotasClient: servicequotas.NewFromConfig(cfg),
 		iamQuotaClient:      servicequotas.NewFromConfig(iamCfg),
 		useLocalCredentials: b.useLocalCredentials,
@@ -600,6 +729,43 @@ func (c *awsClient) ListSubnets(subnetIds ...string) ([]ec2types.Subnet, error)
 	})
 }
 
+// ListVpcs returns the given VPCs, or all the VPCs in the region when no IDs are given
+func (c *awsClient) ListVpcs(vpcIds ...string) ([]ec2types.Vpc, error) {
+	vpcs := []ec2types.Vpc{}
+	paginator := ec2.NewDescribeVpcsPaginator(c.ec2Client, &ec2.DescribeVpcsInput{
+		VpcIds: vpcIds,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		vpcs = append(vpcs, output.Vpcs...)
+	}
+	return vpcs, nil
+}
+
+// ListVpcRouteTables returns all the route tables of the given VPC
+func (c *awsClient) ListVpcRouteTables(vpcID string) ([]ec2types.RouteTable, error) {
+	routeTables := []ec2types.RouteTable{}
+	paginator := ec2.NewDescribeRouteTablesPaginator(c.ec2Client, &ec2.DescribeRouteTablesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{vpcID},
+			},
+		},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		routeTables = append(routeTables, output.RouteTables...)
+	}
+	return routeTables, nil
+}
+
 func (c *awsClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
 	res, err := c.ec2Client.DescribeSubnets(
 		context.Background(),
@@ -13,12 +13,14 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	aws "github.com/aws/aws-sdk-go-v2/aws"
 	types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
-	types0 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	types0 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	types1 "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	iam "github.com/aws/aws-sdk-go-v2/service/iam"
-	types1 "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	types2 "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	servicequotas "github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	sts "github.com/aws/aws-sdk-go-v2/service/sts"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -49,6 +51,34 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// AddOpenIDConnectProviderTags mocks base method.
+func (m *MockClient) AddOpenIDConnectProviderTags(providerArn string, tagList map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOpenIDConnectProviderTags", providerArn, tagList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOpenIDConnectProviderTags indicates an expected call of AddOpenIDConnectProviderTags.
+func (mr *MockClientMockRecorder) AddOpenIDConnectProviderTags(providerArn, tagList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenIDConnectProviderTags", reflect.TypeOf((*MockClient)(nil).AddOpenIDConnectProviderTags), providerArn, tagList)
+}
+
+// AddPolicyTags mocks base method.
+func (m *MockClient) AddPolicyTags(policyArn string, tagList map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPolicyTags", policyArn, tagList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPolicyTags indicates an expected call of AddPolicyTags.
+func (mr *MockClientMockRecorder) AddPolicyTags(policyArn, tagList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicyTags", reflect.TypeOf((*MockClient)(nil).AddPolicyTags), policyArn, tagList)
+}
+
 // AddRoleTag mocks base method.
 func (m *MockClient) AddRoleTag(roleName, key, value string) error {
 	m.ctrl.T.Helper()
@@ -63,6 +93,20 @@ func (mr *MockClientMockRecorder) AddRoleTag(roleName, key, value any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoleTag", reflect.TypeOf((*MockClient)(nil).AddRoleTag), roleName, key, value)
 }
 
+// AddSubnetTags mocks base method.
+func (m *MockClient) AddSubnetTags(subnetID string, tagList map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubnetTags", subnetID, tagList)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubnetTags indicates an expected call of AddSubnetTags.
+func (mr *MockClientMockRecorder) AddSubnetTags(subnetID, tagList any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubnetTags", reflect.TypeOf((*MockClient)(nil).AddSubnetTags), subnetID, tagList)
+}
+
 // AttachRolePolicy mocks base method.
 func (m *MockClient) AttachRolePolicy(reporter reporter.Logger, roleName, policyARN string) error {
 	m.ctrl.T.Helper()
@@ -152,6 +196,21 @@ func (mr *MockClientMockRecorder) CheckStackReadyOrNotExisting(stackName any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStackReadyOrNotExisting", reflect.TypeOf((*MockClient)(nil).CheckStackReadyOrNotExisting), stackName)
 }
 
+// CountMachinePoolSpotInterruptions mocks base method.
+func (m *MockClient) CountMachinePoolSpotInterruptions(infraID, machinePoolID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMachinePoolSpotInterruptions", infraID, machinePoolID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMachinePoolSpotInterruptions indicates an expected call of CountMachinePoolSpotInterruptions.
+func (mr *MockClientMockRecorder) CountMachinePoolSpotInterruptions(infraID, machinePoolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMachinePoolSpotInterruptions", reflect.TypeOf((*MockClient)(nil).CountMachinePoolSpotInterruptions), infraID, machinePoolID)
+}
+
 // CreateOpenIDConnectProvider mocks base method.
 func (m *MockClient) CreateOpenIDConnectProvider(issuerURL, thumbprint, clusterID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -470,7 +529,7 @@ func (mr *MockClientMockRecorder) EnsureRole(reporter, name, policy, permissions
 }
 
 // FetchPublicSubnetMap mocks base method.
-func (m *MockClient) FetchPublicSubnetMap(subnets []types0.Subnet) (map[string]bool, error) {
+func (m *MockClient) FetchPublicSubnetMap(subnets []types1.Subnet) (map[string]bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FetchPublicSubnetMap", subnets)
 	ret0, _ := ret[0].(map[string]bool)
@@ -485,10 +544,10 @@ func (mr *MockClientMockRecorder) FetchPublicSubnetMap(subnets any) *gomock.Call
 }
 
 // FilterVPCsPrivateSubnets mocks base method.
-func (m *MockClient) FilterVPCsPrivateSubnets(subnets []types0.Subnet) ([]types0.Subnet, error) {
+func (m *MockClient) FilterVPCsPrivateSubnets(subnets []types1.Subnet) ([]types1.Subnet, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FilterVPCsPrivateSubnets", subnets)
-	ret0, _ := ret[0].([]types0.Subnet)
+	ret0, _ := ret[0].([]types1.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -499,6 +558,21 @@ func (mr *MockClientMockRecorder) FilterVPCsPrivateSubnets(subnets any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterVPCsPrivateSubnets", reflect.TypeOf((*MockClient)(nil).FilterVPCsPrivateSubnets), subnets)
 }
 
+// FindDeniedActions mocks base method.
+func (m *MockClient) FindDeniedActions(principalARN string, actions, resourceARNs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeniedActions", principalARN, actions, resourceARNs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeniedActions indicates an expected call of FindDeniedActions.
+func (mr *MockClientMockRecorder) FindDeniedActions(principalARN, actions, resourceARNs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeniedActions", reflect.TypeOf((*MockClient)(nil).FindDeniedActions), principalARN, actions, resourceARNs)
+}
+
 // FindPolicyARN mocks base method.
 func (m *MockClient) FindPolicyARN(operator Operator, version string) (string, error) {
 	m.ctrl.T.Helper()
@@ -740,6 +814,36 @@ func (mr *MockClientMockRecorder) GetAvailabilityZoneType(availabilityZoneName a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailabilityZoneType", reflect.TypeOf((*MockClient)(nil).GetAvailabilityZoneType), availabilityZoneName)
 }
 
+// GetBucketEncryption mocks base method.
+func (m *MockClient) GetBucketEncryption(bucketName, region string) (*BucketEncryption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketEncryption", bucketName, region)
+	ret0, _ := ret[0].(*BucketEncryption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketEncryption indicates an expected call of GetBucketEncryption.
+func (mr *MockClientMockRecorder) GetBucketEncryption(bucketName, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketEncryption", reflect.TypeOf((*MockClient)(nil).GetBucketEncryption), bucketName, region)
+}
+
+// GetBucketRegion mocks base method.
+func (m *MockClient) GetBucketRegion(bucketName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucketRegion", bucketName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketRegion indicates an expected call of GetBucketRegion.
+func (mr *MockClientMockRecorder) GetBucketRegion(bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketRegion", reflect.TypeOf((*MockClient)(nil).GetBucketRegion), bucketName)
+}
+
 // GetCFStack mocks base method.
 func (m *MockClient) GetCFStack(ctx context.Context, stackName string) (*types.Stack, error) {
 	m.ctrl.T.Helper()
@@ -875,6 +979,21 @@ func (mr *MockClientMockRecorder) GetLocalAWSAccessKeys() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalAWSAccessKeys", reflect.TypeOf((*MockClient)(nil).GetLocalAWSAccessKeys))
 }
 
+// GetLogGroup mocks base method.
+func (m *MockClient) GetLogGroup(logGroupName string) (*types0.LogGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogGroup", logGroupName)
+	ret0, _ := ret[0].(*types0.LogGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogGroup indicates an expected call of GetLogGroup.
+func (mr *MockClientMockRecorder) GetLogGroup(logGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogGroup", reflect.TypeOf((*MockClient)(nil).GetLogGroup), logGroupName)
+}
+
 // GetOpenIDConnectProviderByClusterIdTag mocks base method.
 func (m *MockClient) GetOpenIDConnectProviderByClusterIdTag(clusterID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -905,6 +1024,36 @@ func (mr *MockClientMockRecorder) GetOpenIDConnectProviderByOidcEndpointUrl(oidc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderByOidcEndpointUrl", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderByOidcEndpointUrl), oidcEndpointUrl)
 }
 
+// GetOpenIDConnectProviderCreateDate mocks base method.
+func (m *MockClient) GetOpenIDConnectProviderCreateDate(providerArn string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenIDConnectProviderCreateDate", providerArn)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenIDConnectProviderCreateDate indicates an expected call of GetOpenIDConnectProviderCreateDate.
+func (mr *MockClientMockRecorder) GetOpenIDConnectProviderCreateDate(providerArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderCreateDate", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderCreateDate), providerArn)
+}
+
+// GetOpenIDConnectProviderTags mocks base method.
+func (m *MockClient) GetOpenIDConnectProviderTags(providerArn string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenIDConnectProviderTags", providerArn)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenIDConnectProviderTags indicates an expected call of GetOpenIDConnectProviderTags.
+func (mr *MockClientMockRecorder) GetOpenIDConnectProviderTags(providerArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderTags", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderTags), providerArn)
+}
+
 // GetOperatorRoleDefaultPolicy mocks base method.
 func (m *MockClient) GetOperatorRoleDefaultPolicy(roleName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -981,6 +1130,21 @@ func (mr *MockClientMockRecorder) GetPolicyDetailsFromRole(role any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyDetailsFromRole", reflect.TypeOf((*MockClient)(nil).GetPolicyDetailsFromRole), role)
 }
 
+// GetPolicyTags mocks base method.
+func (m *MockClient) GetPolicyTags(policyArn string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicyTags", policyArn)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyTags indicates an expected call of GetPolicyTags.
+func (mr *MockClientMockRecorder) GetPolicyTags(policyArn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyTags", reflect.TypeOf((*MockClient)(nil).GetPolicyTags), policyArn)
+}
+
 // GetRegion mocks base method.
 func (m *MockClient) GetRegion() string {
 	m.ctrl.T.Helper()
@@ -1011,10 +1175,10 @@ func (mr *MockClientMockRecorder) GetRoleARNPath(prefix any) *gomock.Call {
 }
 
 // GetRoleByARN mocks base method.
-func (m *MockClient) GetRoleByARN(roleARN string) (types1.Role, error) {
+func (m *MockClient) GetRoleByARN(roleARN string) (types2.Role, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRoleByARN", roleARN)
-	ret0, _ := ret[0].(types1.Role)
+	ret0, _ := ret[0].(types2.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1026,10 +1190,10 @@ func (mr *MockClientMockRecorder) GetRoleByARN(roleARN any) *gomock.Call {
 }
 
 // GetRoleByName mocks base method.
-func (m *MockClient) GetRoleByName(roleName string) (types1.Role, error) {
+func (m *MockClient) GetRoleByName(roleName string) (types2.Role, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRoleByName", roleName)
-	ret0, _ := ret[0].(types1.Role)
+	ret0, _ := ret[0].(types2.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1040,11 +1204,26 @@ func (mr *MockClientMockRecorder) GetRoleByName(roleName any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByName", reflect.TypeOf((*MockClient)(nil).GetRoleByName), roleName)
 }
 
+// GetRoleTags mocks base method.
+func (m *MockClient) GetRoleTags(roleName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleTags", roleName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleTags indicates an expected call of GetRoleTags.
+func (mr *MockClientMockRecorder) GetRoleTags(roleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleTags", reflect.TypeOf((*MockClient)(nil).GetRoleTags), roleName)
+}
+
 // GetSecurityGroupIds mocks base method.
-func (m *MockClient) GetSecurityGroupIds(vpcId string) ([]types0.SecurityGroup, error) {
+func (m *MockClient) GetSecurityGroupIds(vpcId string) ([]types1.SecurityGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecurityGroupIds", vpcId)
-	ret0, _ := ret[0].([]types0.SecurityGroup)
+	ret0, _ := ret[0].([]types1.SecurityGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1056,11 +1235,11 @@ func (mr *MockClientMockRecorder) GetSecurityGroupIds(vpcId any) *gomock.Call {
 }
 
 // GetServiceAccountRoleDetails mocks base method.
-func (m *MockClient) GetServiceAccountRoleDetails(roleName string) (*types1.Role, []types1.AttachedPolicy, []string, error) {
+func (m *MockClient) GetServiceAccountRoleDetails(roleName string) (*types2.Role, []types2.AttachedPolicy, []string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetServiceAccountRoleDetails", roleName)
-	ret0, _ := ret[0].(*types1.Role)
-	ret1, _ := ret[1].([]types1.AttachedPolicy)
+	ret0, _ := ret[0].(*types2.Role)
+	ret1, _ := ret[1].([]types2.AttachedPolicy)
 	ret2, _ := ret[2].([]string)
 	ret3, _ := ret[3].(error)
 	return ret0, ret1, ret2, ret3
@@ -1088,10 +1267,10 @@ func (mr *MockClientMockRecorder) GetSubnetAvailabilityZone(subnetID any) *gomoc
 }
 
 // GetVPCPrivateSubnets mocks base method.
-func (m *MockClient) GetVPCPrivateSubnets(subnetID string) ([]types0.Subnet, error) {
+func (m *MockClient) GetVPCPrivateSubnets(subnetID string) ([]types1.Subnet, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetVPCPrivateSubnets", subnetID)
-	ret0, _ := ret[0].([]types0.Subnet)
+	ret0, _ := ret[0].([]types1.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1103,10 +1282,10 @@ func (mr *MockClientMockRecorder) GetVPCPrivateSubnets(subnetID any) *gomock.Cal
 }
 
 // GetVPCSubnets mocks base method.
-func (m *MockClient) GetVPCSubnets(subnetID string) ([]types0.Subnet, error) {
+func (m *MockClient) GetVPCSubnets(subnetID string) ([]types1.Subnet, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetVPCSubnets", subnetID)
-	ret0, _ := ret[0].([]types0.Subnet)
+	ret0, _ := ret[0].([]types1.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1372,6 +1551,55 @@ func (mr *MockClientMockRecorder) ListAttachedRolePolicies(roleName any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachedRolePolicies", reflect.TypeOf((*MockClient)(nil).ListAttachedRolePolicies), roleName)
 }
 
+// ListCFStacks mocks base method.
+func (m *MockClient) ListCFStacks(ctx context.Context) ([]types.Stack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCFStacks", ctx)
+	ret0, _ := ret[0].([]types.Stack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCFStacks indicates an expected call of ListCFStacks.
+func (mr *MockClientMockRecorder) ListCFStacks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCFStacks", reflect.TypeOf((*MockClient)(nil).ListCFStacks), ctx)
+}
+
+// ListCapacityReservations mocks base method.
+func (m *MockClient) ListCapacityReservations(capacityReservationIDs ...string) ([]types1.CapacityReservation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range capacityReservationIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapacityReservations", varargs...)
+	ret0, _ := ret[0].([]types1.CapacityReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityReservations indicates an expected call of ListCapacityReservations.
+func (mr *MockClientMockRecorder) ListCapacityReservations(capacityReservationIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityReservations", reflect.TypeOf((*MockClient)(nil).ListCapacityReservations), capacityReservationIDs...)
+}
+
+// ListMachinePoolInstances mocks base method.
+func (m *MockClient) ListMachinePoolInstances(infraID, machinePoolID string) ([]types1.Instance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMachinePoolInstances", infraID, machinePoolID)
+	ret0, _ := ret[0].([]types1.Instance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMachinePoolInstances indicates an expected call of ListMachinePoolInstances.
+func (mr *MockClientMockRecorder) ListMachinePoolInstances(infraID, machinePoolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMachinePoolInstances", reflect.TypeOf((*MockClient)(nil).ListMachinePoolInstances), infraID, machinePoolID)
+}
+
 // ListOCMRoles mocks base method.
 func (m *MockClient) ListOCMRoles() ([]Role, error) {
 	m.ctrl.T.Helper()
@@ -1432,11 +1660,26 @@ func (mr *MockClientMockRecorder) ListPolicyVersions(policyArn any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyVersions", reflect.TypeOf((*MockClient)(nil).ListPolicyVersions), policyArn)
 }
 
+// ListRoles mocks base method.
+func (m *MockClient) ListRoles() ([]types2.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles")
+	ret0, _ := ret[0].([]types2.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockClientMockRecorder) ListRoles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockClient)(nil).ListRoles))
+}
+
 // ListServiceAccountRoles mocks base method.
-func (m *MockClient) ListServiceAccountRoles(clusterName string) ([]types1.Role, error) {
+func (m *MockClient) ListServiceAccountRoles(clusterName string) ([]types2.Role, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListServiceAccountRoles", clusterName)
-	ret0, _ := ret[0].([]types1.Role)
+	ret0, _ := ret[0].([]types2.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1448,14 +1691,14 @@ func (mr *MockClientMockRecorder) ListServiceAccountRoles(clusterName any) *gomo
 }
 
 // ListSubnets mocks base method.
-func (m *MockClient) ListSubnets(subnetIds ...string) ([]types0.Subnet, error) {
+func (m *MockClient) ListSubnets(subnetIds ...string) ([]types1.Subnet, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{}
 	for _, a := range subnetIds {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ListSubnets", varargs...)
-	ret0, _ := ret[0].([]types0.Subnet)
+	ret0, _ := ret[0].([]types1.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1481,6 +1724,40 @@ func (mr *MockClientMockRecorder) ListUserRoles() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserRoles", reflect.TypeOf((*MockClient)(nil).ListUserRoles))
 }
 
+// ListVpcRouteTables mocks base method.
+func (m *MockClient) ListVpcRouteTables(vpcID string) ([]types1.RouteTable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpcRouteTables", vpcID)
+	ret0, _ := ret[0].([]types1.RouteTable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcRouteTables indicates an expected call of ListVpcRouteTables.
+func (mr *MockClientMockRecorder) ListVpcRouteTables(vpcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcRouteTables", reflect.TypeOf((*MockClient)(nil).ListVpcRouteTables), vpcID)
+}
+
+// ListVpcs mocks base method.
+func (m *MockClient) ListVpcs(vpcIds ...string) ([]types1.Vpc, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range vpcIds {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpcs", varargs...)
+	ret0, _ := ret[0].([]types1.Vpc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpcs indicates an expected call of ListVpcs.
+func (mr *MockClientMockRecorder) ListVpcs(vpcIds ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpcs", reflect.TypeOf((*MockClient)(nil).ListVpcs), vpcIds...)
+}
+
 // PutPublicReadObjectInS3Bucket mocks base method.
 func (m *MockClient) PutPublicReadObjectInS3Bucket(bucketName string, body io.ReadSeeker, key string) error {
 	m.ctrl.T.Helper()
@@ -66,6 +66,7 @@ var _ = Describe("Client", func() {
 			mockSecretsManagerAPI,
 			mockSTSApi,
 			mockCfAPI,
+			mocks.NewMockCloudWatchLogsApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			&AccessKey{},
@@ -1547,4 +1548,153 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Context("ListMachinePoolInstances", func() {
+		It("returns the running instances of the machine pool", func() {
+			mockEC2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, input *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (
+					*ec2.DescribeInstancesOutput, error) {
+					Expect(input.Filters).To(HaveLen(3))
+					Expect(awsSdk.ToString(input.Filters[0].Name)).To(Equal("tag:kubernetes.io/cluster/infra-abcde"))
+					Expect(input.Filters[1].Values).To(Equal([]string{"infra-abcde-workers-*"}))
+					Expect(input.Filters[2].Values).To(Equal([]string{"running"}))
+					return &ec2.DescribeInstancesOutput{
+						Reservations: []ec2types.Reservation{
+							{Instances: []ec2types.Instance{
+								machinePoolInstance("i-1", "infra-abcde-workers-us-east-1a-x7k2p", "us-east-1a")}},
+							{Instances: []ec2types.Instance{
+								machinePoolInstance("i-2", "infra-abcde-workers-us-east-1b-q9w4z", "us-east-1b")}},
+						},
+					}, nil
+				})
+
+			instances, err := client.ListMachinePoolInstances("infra-abcde", "workers")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(2))
+		})
+
+		It("ignores the instances of a pool whose name starts with the name of the pool", func() {
+			mockEC2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&ec2.DescribeInstancesOutput{
+					Reservations: []ec2types.Reservation{
+						{Instances: []ec2types.Instance{
+							machinePoolInstance("i-1", "infra-abcde-workers-us-east-1a-x7k2p", "us-east-1a"),
+							machinePoolInstance("i-2", "infra-abcde-workers-m5-2xlarge-us-east-1a-b3n8d", "us-east-1a"),
+							machinePoolInstance("i-3", "infra-abcde-workers-spot-us-east-1b-h5t6y", "us-east-1b"),
+						}},
+					},
+				}, nil)
+
+			instances, err := client.ListMachinePoolInstances("infra-abcde", "workers")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+			Expect(awsSdk.ToString(instances[0].InstanceId)).To(Equal("i-1"))
+		})
+
+		It("returns the instances of the pool whose name extends the name of another pool", func() {
+			mockEC2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, input *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (
+					*ec2.DescribeInstancesOutput, error) {
+					Expect(input.Filters[1].Values).To(Equal([]string{"infra-abcde-workers-m5-2xlarge-*"}))
+					return &ec2.DescribeInstancesOutput{
+						Reservations: []ec2types.Reservation{
+							{Instances: []ec2types.Instance{
+								machinePoolInstance("i-2", "infra-abcde-workers-m5-2xlarge-us-east-1a-b3n8d", "us-east-1a"),
+							}},
+						},
+					}, nil
+				})
+
+			instances, err := client.ListMachinePoolInstances("infra-abcde", "workers-m5-2xlarge")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instances).To(HaveLen(1))
+			Expect(awsSdk.ToString(instances[0].InstanceId)).To(Equal("i-2"))
+		})
+
+		It("propagates the error", func() {
+			mockEC2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				nil, fmt.Errorf("access denied"))
+
+			_, err := client.ListMachinePoolInstances("infra-abcde", "workers")
+			Expect(err).To(MatchError(ContainSubstring("access denied")))
+		})
+	})
+
+	Context("CountMachinePoolSpotInterruptions", func() {
+		It("counts the instances terminated by a spot interruption", func() {
+			mockEC2API.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, input *ec2.DescribeInstancesInput, _ ...func(*ec2.Options)) (
+					*ec2.DescribeInstancesOutput, error) {
+					Expect(input.Filters[2].Values).To(Equal([]string{"shutting-down", "terminated"}))
+					return &ec2.DescribeInstancesOutput{
+						Reservations: []ec2types.Reservation{
+							{Instances: []ec2types.Instance{
+								spotInterruptedInstance("infra-abcde-workers-us-east-1a-x7k2p",
+									"Server.SpotInstanceTermination"),
+								spotInterruptedInstance("infra-abcde-workers-us-east-1a-q9w4z",
+									"Client.UserInitiatedShutdown"),
+								spotInterruptedInstance("infra-abcde-workers-spot-1-us-east-1a-h5t6y",
+									"Server.SpotInstanceTermination"),
+								machinePoolInstance("i-4", "infra-abcde-workers-us-east-1a-b3n8d", "us-east-1a"),
+							}},
+						},
+					}, nil
+				})
+
+			count, err := client.CountMachinePoolSpotInterruptions("infra-abcde", "workers")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(1))
+		})
+	})
+
+	Context("ListCapacityReservations", func() {
+		It("lists the given capacity reservations across pages", func() {
+			gomock.InOrder(
+				mockEC2API.EXPECT().DescribeCapacityReservations(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, input *ec2.DescribeCapacityReservationsInput, _ ...func(*ec2.Options)) (
+						*ec2.DescribeCapacityReservationsOutput, error) {
+						Expect(input.CapacityReservationIds).To(Equal([]string{"cr-1", "cr-2"}))
+						return &ec2.DescribeCapacityReservationsOutput{
+							CapacityReservations: []ec2types.CapacityReservation{
+								{CapacityReservationId: awsSdk.String("cr-1")},
+							},
+							NextToken: awsSdk.String("next"),
+						}, nil
+					}),
+				mockEC2API.EXPECT().DescribeCapacityReservations(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					&ec2.DescribeCapacityReservationsOutput{
+						CapacityReservations: []ec2types.CapacityReservation{
+							{CapacityReservationId: awsSdk.String("cr-2")},
+						},
+					}, nil),
+			)
+
+			capacityReservations, err := client.ListCapacityReservations("cr-1", "cr-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(capacityReservations).To(HaveLen(2))
+			Expect(awsSdk.ToString(capacityReservations[1].CapacityReservationId)).To(Equal("cr-2"))
+		})
+
+		It("returns the error of EC2", func() {
+			mockEC2API.EXPECT().DescribeCapacityReservations(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				nil, fmt.Errorf("access denied"))
+
+			_, err := client.ListCapacityReservations()
+			Expect(err).To(MatchError(ContainSubstring("failed to describe capacity reservations: access denied")))
+		})
+	})
 })
+
+func machinePoolInstance(id string, name string, zone string) ec2types.Instance {
+	return ec2types.Instance{
+		InstanceId: awsSdk.String(id),
+		Placement:  &ec2types.Placement{AvailabilityZone: awsSdk.String(zone)},
+		Tags:       []ec2types.Tag{{Key: awsSdk.String("Name"), Value: awsSdk.String(name)}},
+	}
+}
+
+func spotInterruptedInstance(name string, reason string) ec2types.Instance {
+	instance := machinePoolInstance("", name, "us-east-1a")
+	instance.StateReason = &ec2types.StateReason{Code: awsSdk.String(reason)}
+	return instance
+}
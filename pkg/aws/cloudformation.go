@@ -171,6 +171,20 @@ func (c *awsClient) GetCFStack(ctx context.Context, stackName string) (*cloudfor
 	return &output.Stacks[0], nil
 }
 
+// ListCFStacks returns all the stacks of the region that have not been deleted
+func (c *awsClient) ListCFStacks(ctx context.Context) ([]cloudformationtypes.Stack, error) {
+	stacks := []cloudformationtypes.Stack{}
+	paginator := cloudformation.NewDescribeStacksPaginator(c.cfClient, &cloudformation.DescribeStacksInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		stacks = append(stacks, output.Stacks...)
+	}
+	return stacks, nil
+}
+
 func (c *awsClient) DescribeCFStackResources(ctx context.Context, stackName string) (*[]cloudformationtypes.StackResource, error) {
 	output, err := c.cfClient.DescribeStackResources(ctx, &cloudformation.DescribeStackResourcesInput{
 		StackName: aws.String(stackName),
@@ -37,6 +37,7 @@ var _ = Describe("CloudFormation", func() {
 			mocks.NewMockSecretsManagerApiClient(mockCtrl),
 			mocks.NewMockStsApiClient(mockCtrl),
 			mockCfAPI,
+			mocks.NewMockCloudWatchLogsApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			&AccessKey{},
@@ -349,6 +350,39 @@ var _ = Describe("CloudFormation", func() {
 		})
 	})
 
+	Context("ListCFStacks", func() {
+		It("Returns the stacks of every page", func() {
+			gomock.InOrder(
+				mockCfAPI.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&cloudformation.DescribeStacksOutput{
+						Stacks:    []cloudformationtypes.Stack{{StackName: awsSdk.String("stack-1")}},
+						NextToken: awsSdk.String("token"),
+					}, nil),
+				mockCfAPI.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input *cloudformation.DescribeStacksInput,
+						_ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+						Expect(*input.NextToken).To(Equal("token"))
+						return &cloudformation.DescribeStacksOutput{
+							Stacks: []cloudformationtypes.Stack{{StackName: awsSdk.String("stack-2")}},
+						}, nil
+					}),
+			)
+
+			stacks, err := client.ListCFStacks(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(HaveLen(2))
+			Expect(*stacks[1].StackName).To(Equal("stack-2"))
+		})
+
+		It("Propagates DescribeStacks API error", func() {
+			mockCfAPI.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, fmt.Errorf("access denied"))
+
+			_, err := client.ListCFStacks(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("access denied")))
+		})
+	})
+
 	Context("DescribeCFStackResources", func() {
 		stackName := "my-stack"
 
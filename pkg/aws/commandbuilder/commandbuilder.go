@@ -15,6 +15,7 @@ const (
 	S3Api Service = "s3api"
 	S3    Service = "s3"
 	SM    Service = "secretsmanager"
+	EC2   Service = "ec2"
 )
 
 type Command string
@@ -32,6 +33,7 @@ const (
 	DeletePolicyVersion           Command = "delete-policy-version"
 	TagPolicy                     Command = "tag-policy"
 	TagRole                       Command = "tag-role"
+	TagOpenIdConnectProvider      Command = "tag-open-id-connect-provider"
 	CreateOpenIdConnectProvider   Command = "create-open-id-connect-provider"
 	DeleteOpenIdConnectProvider   Command = "delete-open-id-connect-provider"
 	DeleteRolePermissionsBoundary Command = "delete-role-permissions-boundary"
@@ -47,6 +49,8 @@ const (
 	//SecretsManager
 	CreateSecret Command = "create-secret"
 	DeleteSecret Command = "delete-secret"
+	//EC2
+	CreateTags Command = "create-tags"
 )
 
 type Param string
@@ -85,6 +89,9 @@ const (
 	Description  Param = "description"
 	SecretID     Param = "secret-id"
 	Recursive    Param = "recursive"
+
+	//EC2
+	Resources Param = "resources"
 )
 
 type Redirect string
@@ -193,6 +200,10 @@ func NewSecretsManagerCommandBuilder() *CommandBuilder {
 	return &CommandBuilder{service: SM}
 }
 
+func NewEC2CommandBuilder() *CommandBuilder {
+	return &CommandBuilder{service: EC2}
+}
+
 func createParamString(awsParam Param, value string) string {
 	return fmt.Sprintf("\t--%s %s", awsParam, value)
 }
@@ -918,6 +918,28 @@ func GetResourceIdFromSecretArn(secretArn string) (string, error) {
 	return parsedARN.Resource[index+1:], nil
 }
 
+// PrivateKeySecretPrefix is the prefix of the secrets manager secret holding the private key of
+// an unmanaged OIDC configuration created by ROSA
+const PrivateKeySecretPrefix = "rosa-private-key-"
+
+// GetBucketNameFromPrivateKeySecretArn derives the S3 bucket of an unmanaged OIDC configuration from
+// its private key secret ARN.
+// The secret when creating from ROSA options has the following format
+// rosa-private-key-<prefix>-oidc-<random-hash-length-4>-<random-aws-created-hash>
+// The bucket is expected to be <prefix>-oidc-<random-hash-length-4>
+func GetBucketNameFromPrivateKeySecretArn(secretArn string) (string, error) {
+	secretResourceName, err := GetResourceIdFromSecretArn(secretArn)
+	if err != nil {
+		return "", err
+	}
+	bucketName := strings.TrimPrefix(secretResourceName, PrivateKeySecretPrefix)
+	index := strings.LastIndex(bucketName, "-")
+	if index != -1 {
+		bucketName = bucketName[:index]
+	}
+	return bucketName, nil
+}
+
 func FindOperatorRoleNameBySTSOperator(cluster *cmv1.Cluster, operator *cmv1.STSOperator) (string, bool) {
 	for _, role := range cluster.AWS().STS().OperatorIAMRoles() {
 		if role.Namespace() == operator.Namespace() && role.Name() == operator.Name() {
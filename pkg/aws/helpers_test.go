@@ -625,6 +625,24 @@ var _ = Describe("GetResourceIdFromOidcProviderARN", func() {
 	})
 })
 
+var _ = Describe("GetBucketNameFromPrivateKeySecretArn", func() {
+	When("the secret was created by ROSA", func() {
+		It("should return the bucket name", func() {
+			bucketName, err := GetBucketNameFromPrivateKeySecretArn(
+				"arn:aws:secretsmanager:us-east-1:123456789012:secret:rosa-private-key-foo-oidc-ab12-Xyz123")
+			Expect(err).To(BeNil())
+			Expect(bucketName).To(Equal("foo-oidc-ab12"))
+		})
+	})
+
+	When("the ARN is invalid", func() {
+		It("should return an error", func() {
+			_, err := GetBucketNameFromPrivateKeySecretArn("not-an-arn")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
 var _ = Describe("GetRoleARN", func() {
 	It("should build an ARN without a path", func() {
 		result := GetRoleARN("123456789012", "MyRole", "", "aws")
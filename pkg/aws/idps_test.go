@@ -36,6 +36,7 @@ var _ = Describe("OIDC Provider", func() {
 			mocks.NewMockSecretsManagerApiClient(mockCtrl),
 			mocks.NewMockStsApiClient(mockCtrl),
 			mocks.NewMockCloudFormationApiClient(mockCtrl),
+			mocks.NewMockCloudWatchLogsApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			&AccessKey{},
@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	weberr "github.com/zgalor/weberr"
+)
+
+// BucketEncryption is the default encryption of the objects of an S3 bucket
+type BucketEncryption struct {
+	Algorithm string
+	KMSKeyID  string
+}
+
+// GetBucketRegion returns the region of an S3 bucket, or a NotFound error when the bucket doesn't exist
+func (c *awsClient) GetBucketRegion(bucketName string) (string, error) {
+	output, err := c.s3Client.GetBucketLocation(context.Background(), &s3.GetBucketLocationInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		var noSuchBucket *s3types.NoSuchBucket
+		if errors.As(err, &noSuchBucket) {
+			return "", weberr.NotFound.Errorf("Bucket '%s' not found", bucketName)
+		}
+		return "", err
+	}
+	// Buckets in us-east-1 have no location constraint and the legacy 'EU' constraint is eu-west-1
+	switch output.LocationConstraint {
+	case "":
+		return DefaultRegion, nil
+	case s3types.BucketLocationConstraintEu:
+		return "eu-west-1", nil
+	}
+	return string(output.LocationConstraint), nil
+}
+
+// GetBucketEncryption returns the default encryption of an S3 bucket in the given region, or nil when the
+// bucket has no default encryption
+func (c *awsClient) GetBucketEncryption(bucketName string, region string) (*BucketEncryption, error) {
+	output, err := c.s3Client.GetBucketEncryption(context.Background(), &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucketName),
+	}, func(options *s3.Options) {
+		options.Region = region
+	})
+	if err != nil {
+		return nil, err
+	}
+	if output.ServerSideEncryptionConfiguration == nil {
+		return nil, nil
+	}
+	for _, rule := range output.ServerSideEncryptionConfiguration.Rules {
+		if rule.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+		return &BucketEncryption{
+			Algorithm: string(rule.ApplyServerSideEncryptionByDefault.SSEAlgorithm),
+			KMSKeyID:  aws.ToString(rule.ApplyServerSideEncryptionByDefault.KMSMasterKeyID),
+		}, nil
+	}
+	return nil, nil
+}
+
+// GetLogGroup returns the CloudWatch log group with the given name in the region of the client, or a NotFound
+// error when it doesn't exist
+func (c *awsClient) GetLogGroup(logGroupName string) (*cwltypes.LogGroup, error) {
+	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(c.cwlClient, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(logGroupName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		for _, logGroup := range output.LogGroups {
+			if aws.ToString(logGroup.LogGroupName) == logGroupName {
+				return &logGroup, nil
+			}
+		}
+	}
+	return nil, weberr.NotFound.Errorf("Log group '%s' not found", logGroupName)
+}
+
+// FindDeniedActions simulates the policies of a principal for the given actions on the given resources and
+// returns the actions that are not allowed on every resource
+func (c *awsClient) FindDeniedActions(principalARN string, actions []string, resourceARNs []string) ([]string,
+	error) {
+	input := &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     actions,
+		ResourceArns:    resourceARNs,
+	}
+	denied := []string{}
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(c.iamClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range output.EvaluationResults {
+			allowed := result.EvalDecision == iamtypes.PolicyEvaluationDecisionTypeAllowed
+			for _, resourceResult := range result.ResourceSpecificResults {
+				if resourceResult.EvalResourceDecision != iamtypes.PolicyEvaluationDecisionTypeAllowed {
+					allowed = false
+				}
+			}
+			action := aws.ToString(result.EvalActionName)
+			if !allowed && !slices.Contains(denied, action) {
+				denied = append(denied, action)
+			}
+		}
+	}
+	return denied, nil
+}
@@ -780,6 +780,26 @@ func (mr *MockIamApiClientMockRecorder) SimulatePrincipalPolicy(ctx, params any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulatePrincipalPolicy", reflect.TypeOf((*MockIamApiClient)(nil).SimulatePrincipalPolicy), varargs...)
 }
 
+// TagOpenIDConnectProvider mocks base method.
+func (m *MockIamApiClient) TagOpenIDConnectProvider(ctx context.Context, params *iam.TagOpenIDConnectProviderInput, optFns ...func(*iam.Options)) (*iam.TagOpenIDConnectProviderOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagOpenIDConnectProvider", varargs...)
+	ret0, _ := ret[0].(*iam.TagOpenIDConnectProviderOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagOpenIDConnectProvider indicates an expected call of TagOpenIDConnectProvider.
+func (mr *MockIamApiClientMockRecorder) TagOpenIDConnectProvider(ctx, params any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagOpenIDConnectProvider", reflect.TypeOf((*MockIamApiClient)(nil).TagOpenIDConnectProvider), varargs...)
+}
+
 // TagPolicy mocks base method.
 func (m *MockIamApiClient) TagPolicy(ctx context.Context, params *iam.TagPolicyInput, optFns ...func(*iam.Options)) (*iam.TagPolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +100,46 @@ func (mr *MockS3ApiClientMockRecorder) DeleteObject(ctx, params any, optFns ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockS3ApiClient)(nil).DeleteObject), varargs...)
 }
 
+// GetBucketEncryption mocks base method.
+func (m *MockS3ApiClient) GetBucketEncryption(ctx context.Context, params *s3.GetBucketEncryptionInput, optFns ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketEncryption", varargs...)
+	ret0, _ := ret[0].(*s3.GetBucketEncryptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketEncryption indicates an expected call of GetBucketEncryption.
+func (mr *MockS3ApiClientMockRecorder) GetBucketEncryption(ctx, params any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketEncryption", reflect.TypeOf((*MockS3ApiClient)(nil).GetBucketEncryption), varargs...)
+}
+
+// GetBucketLocation mocks base method.
+func (m *MockS3ApiClient) GetBucketLocation(ctx context.Context, params *s3.GetBucketLocationInput, optFns ...func(*s3.Options)) (*s3.GetBucketLocationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketLocation", varargs...)
+	ret0, _ := ret[0].(*s3.GetBucketLocationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketLocation indicates an expected call of GetBucketLocation.
+func (mr *MockS3ApiClientMockRecorder) GetBucketLocation(ctx, params any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketLocation", reflect.TypeOf((*MockS3ApiClient)(nil).GetBucketLocation), varargs...)
+}
+
 // HeadBucket mocks base method.
 func (m *MockS3ApiClient) HeadBucket(arg0 context.Context, arg1 *s3.HeadBucketInput, arg2 ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +259,7 @@ var _ = Describe("Is Policy Compatible", func() {
 			mockSecretsManagerAPI,
 			mockSTSApi,
 			mockCfAPI,
+			mocks.NewMockCloudWatchLogsApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			&AccessKey{},
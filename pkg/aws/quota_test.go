@@ -103,6 +103,7 @@ var _ = Describe("Quota", func() {
 				mocks.NewMockSecretsManagerApiClient(mockCtrl),
 				mocks.NewMockStsApiClient(mockCtrl),
 				mocks.NewMockCloudFormationApiClient(mockCtrl),
+				mocks.NewMockCloudWatchLogsApiClient(mockCtrl),
 				mocks.NewMockServiceQuotasApiClient(mockCtrl),
 				mockIamQuotaAPI,
 				&AccessKey{},
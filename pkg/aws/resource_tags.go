@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// GetRoleTags returns all the tags of the given role
+func (c *awsClient) GetRoleTags(roleName string) (map[string]string, error) {
+	tagList := map[string]string{}
+	paginator := iam.NewListRoleTagsPaginator(c.iamClient, &iam.ListRoleTagsInput{
+		RoleName: aws.String(roleName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		addIamTags(tagList, output.Tags)
+	}
+	return tagList, nil
+}
+
+// GetPolicyTags returns all the tags of the given customer managed policy
+func (c *awsClient) GetPolicyTags(policyArn string) (map[string]string, error) {
+	tagList := map[string]string{}
+	paginator := iam.NewListPolicyTagsPaginator(c.iamClient, &iam.ListPolicyTagsInput{
+		PolicyArn: aws.String(policyArn),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		addIamTags(tagList, output.Tags)
+	}
+	return tagList, nil
+}
+
+// GetOpenIDConnectProviderTags returns all the tags of the given OIDC provider
+func (c *awsClient) GetOpenIDConnectProviderTags(providerArn string) (map[string]string, error) {
+	tagList := map[string]string{}
+	paginator := iam.NewListOpenIDConnectProviderTagsPaginator(c.iamClient,
+		&iam.ListOpenIDConnectProviderTagsInput{
+			OpenIDConnectProviderArn: aws.String(providerArn),
+		})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		addIamTags(tagList, output.Tags)
+	}
+	return tagList, nil
+}
+
+// AddPolicyTags adds or overwrites the given tags on a customer managed policy
+func (c *awsClient) AddPolicyTags(policyArn string, tagList map[string]string) error {
+	_, err := c.iamClient.TagPolicy(context.Background(), &iam.TagPolicyInput{
+		PolicyArn: aws.String(policyArn),
+		Tags:      getTags(tagList),
+	})
+	return err
+}
+
+// AddOpenIDConnectProviderTags adds or overwrites the given tags on an OIDC provider
+func (c *awsClient) AddOpenIDConnectProviderTags(providerArn string, tagList map[string]string) error {
+	_, err := c.iamClient.TagOpenIDConnectProvider(context.Background(), &iam.TagOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(providerArn),
+		Tags:                     getTags(tagList),
+	})
+	return err
+}
+
+// AddSubnetTags adds or overwrites the given tags on a subnet
+func (c *awsClient) AddSubnetTags(subnetID string, tagList map[string]string) error {
+	ec2Tags := []ec2types.Tag{}
+	for k, v := range tagList {
+		ec2Tags = append(ec2Tags, ec2types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	_, err := c.ec2Client.CreateTags(context.Background(), &ec2.CreateTagsInput{
+		Resources: []string{subnetID},
+		Tags:      ec2Tags,
+	})
+	return err
+}
+
+func addIamTags(tagList map[string]string, iamTags []iamtypes.Tag) {
+	for _, tag := range iamTags {
+		tagList[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+}
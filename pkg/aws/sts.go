@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -280,3 +281,14 @@ func (c *awsClient) ListOidcProviders(targetClusterId string, config *cmv1.OidcC
 	}
 	return providers, nil
 }
+
+func (c *awsClient) GetOpenIDConnectProviderCreateDate(providerArn string) (time.Time, error) {
+	output, err := c.iamClient.GetOpenIDConnectProvider(context.Background(),
+		&iam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(providerArn),
+		})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return aws.ToTime(output.CreateDate), nil
+}
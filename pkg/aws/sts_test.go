@@ -39,6 +39,7 @@ var _ = Describe("STS", func() {
 			mocks.NewMockSecretsManagerApiClient(mockCtrl),
 			mockSTSApi,
 			mocks.NewMockCloudFormationApiClient(mockCtrl),
+			mocks.NewMockCloudWatchLogsApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
 			&AccessKey{},
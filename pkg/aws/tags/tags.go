@@ -60,4 +60,10 @@ const OperatorName = "operator_name"
 
 const InUse = "in_use"
 
+// ElbRole marks a public subnet as usable for internet-facing load balancers
+const ElbRole = "kubernetes.io/role/elb"
+
+// InternalElbRole marks a private subnet as usable for internal load balancers
+const InternalElbRole = "kubernetes.io/role/internal-elb"
+
 const True = "true"
@@ -0,0 +1,110 @@
+package clusterautoscaler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const gibibyte = 1 << 30
+
+// Capacity is what the nodes of a cluster provide when its machine pools are scaled to their maximum replicas
+type Capacity struct {
+	Nodes     int
+	Cores     int
+	MemoryGiB int
+	// UnknownInstanceTypes are instance types whose cores and memory are unknown, their nodes are only
+	// counted in the nodes
+	UnknownInstanceTypes []string
+}
+
+// ClusterCapacity sums the control plane and infra nodes of a cluster and the max replicas of its machine
+// pools, or the replicas of the pools that aren't autoscaled, multiplied by the cores and memory of their
+// instance types. The limits of the autoscaler count all the nodes of the cluster, not only the workers.
+func ClusterCapacity(cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool,
+	machineTypes ocm.MachineTypeList) *Capacity {
+	capacity := &Capacity{}
+	unknown := map[string]bool{}
+	add := func(instanceType string, replicas int) {
+		if replicas == 0 {
+			return
+		}
+		capacity.Nodes += replicas
+		machineType := machineTypes.Find(instanceType)
+		if machineType == nil {
+			unknown[instanceType] = true
+			return
+		}
+		capacity.Cores += replicas * int(machineType.MachineType.CPU().Value())
+		capacity.MemoryGiB += replicas * int(machineType.MachineType.Memory().Value()/gibibyte)
+	}
+
+	nodes := cluster.Nodes()
+	add(nodes.MasterMachineType().ID(), nodes.Master())
+	add(nodes.InfraMachineType().ID(), nodes.Infra())
+	for _, machinePool := range machinePools {
+		replicas := machinePool.Replicas()
+		if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+			replicas = autoscaling.MaxReplicas()
+		}
+		add(machinePool.InstanceType(), replicas)
+	}
+	for instanceType := range unknown {
+		capacity.UnknownInstanceTypes = append(capacity.UnknownInstanceTypes, instanceType)
+	}
+	sort.Strings(capacity.UnknownInstanceTypes)
+	return capacity
+}
+
+// ValidateResourceLimits checks the resource limits of the autoscaler against the capacity of the cluster.
+// Minimums that the cluster can't reach are errors, maximums that stop the machine pools before their max
+// replicas are returned as warnings.
+func ValidateResourceLimits(limits ResourceLimits, capacity *Capacity) ([]string, error) {
+	problems := []string{}
+	warnings := []string{}
+	if limits.MaxNodesTotal > 0 && limits.MaxNodesTotal < capacity.Nodes {
+		warnings = append(warnings, fmt.Sprintf("The cluster can scale to %d nodes, but the maximum "+
+			"nodes is %d: the machine pools won't reach their max replicas", capacity.Nodes,
+			limits.MaxNodesTotal))
+	}
+	if len(capacity.UnknownInstanceTypes) == 0 {
+		for _, resource := range []struct {
+			name     string
+			unit     string
+			limits   ResourceRange
+			capacity int
+		}{
+			{"cores", "cores", limits.Cores, capacity.Cores},
+			{"memory", "GiB of memory", limits.Memory, capacity.MemoryGiB},
+		} {
+			if resource.limits.Min > resource.capacity {
+				problems = append(problems, fmt.Sprintf("the minimum %s of %d exceeds the %d %s of the "+
+					"cluster with its machine pools at their max replicas", resource.name, resource.limits.Min, resource.capacity,
+					resource.unit))
+			}
+			if resource.limits.Max > 0 && resource.limits.Max < resource.capacity {
+				warnings = append(warnings, fmt.Sprintf("The cluster can scale to %d %s, but the "+
+					"maximum %s is %d: the machine pools won't reach their max replicas", resource.capacity,
+					resource.unit, resource.name, resource.limits.Max))
+			}
+		}
+	} else {
+		warnings = append(warnings, fmt.Sprintf("The cores and memory limits aren't checked against the "+
+			"cluster, the capacity of instance types %s is unknown",
+			strings.Join(capacity.UnknownInstanceTypes, ", ")))
+	}
+	if len(problems) > 0 {
+		return warnings, fmt.Errorf("Resource limits can't be reached by the cluster: %s",
+			strings.Join(problems, "; "))
+	}
+	return warnings, nil
+}
+
+// MaxNodesTotalDefault returns the maximum number of nodes of the autoscaler of a cluster when it isn't set
+func MaxNodesTotalDefault(validationArgs *AutoscalerValidationArgs) int {
+	return getAutoscalerMaxNodesTotalDefaultValue(0, validationArgs)
+}
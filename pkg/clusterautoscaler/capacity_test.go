@@ -0,0 +1,122 @@
+package clusterautoscaler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+var _ = Describe("Cluster Autoscaler resource limits", func() {
+	var machineTypes ocm.MachineTypeList
+
+	machineType := func(id string, cores int, memoryGiB int) *ocm.MachineType {
+		machineType, err := cmv1.NewMachineType().ID(id).
+			CPU(cmv1.NewValue().Value(float64(cores)).Unit("vCPU")).
+			Memory(cmv1.NewValue().Value(float64(memoryGiB) * gibibyte).Unit("B")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return &ocm.MachineType{MachineType: machineType}
+	}
+	machinePool := func(instanceType string, builder func(*cmv1.MachinePoolBuilder)) *cmv1.MachinePool {
+		b := cmv1.NewMachinePool().InstanceType(instanceType)
+		builder(b)
+		machinePool, err := b.Build()
+		Expect(err).NotTo(HaveOccurred())
+		return machinePool
+	}
+
+	cluster := func(builder func(*cmv1.ClusterNodesBuilder)) *cmv1.Cluster {
+		nodes := cmv1.NewClusterNodes()
+		builder(nodes)
+		cluster, err := cmv1.NewCluster().Nodes(nodes).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return cluster
+	}
+
+	BeforeEach(func() {
+		machineTypes = ocm.MachineTypeList{Items: []*ocm.MachineType{
+			machineType("m5.xlarge", 4, 16),
+			machineType("r5.2xlarge", 8, 64),
+			machineType("m5.2xlarge", 8, 32),
+		}}
+	})
+
+	It("Sums the capacity of the machine pools at their max replicas", func() {
+		capacity := ClusterCapacity(cluster(func(*cmv1.ClusterNodesBuilder) {}), []*cmv1.MachinePool{
+			machinePool("m5.xlarge", func(b *cmv1.MachinePoolBuilder) {
+				b.Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(6))
+			}),
+			machinePool("r5.2xlarge", func(b *cmv1.MachinePoolBuilder) { b.Replicas(2) }),
+		}, machineTypes)
+		Expect(*capacity).To(Equal(Capacity{Nodes: 8, Cores: 40, MemoryGiB: 224}))
+	})
+
+	It("Counts the control plane and infra nodes of the cluster", func() {
+		capacity := ClusterCapacity(cluster(func(b *cmv1.ClusterNodesBuilder) {
+			b.Master(3).MasterMachineType(cmv1.NewMachineType().ID("m5.2xlarge")).
+				Infra(2).InfraMachineType(cmv1.NewMachineType().ID("r5.2xlarge"))
+		}), []*cmv1.MachinePool{
+			machinePool("m5.xlarge", func(b *cmv1.MachinePoolBuilder) { b.Replicas(2) }),
+		}, machineTypes)
+		Expect(*capacity).To(Equal(Capacity{Nodes: 7, Cores: 48, MemoryGiB: 256}))
+
+		// The 8 cores of the machine pool alone don't reach the minimum
+		warnings, err := ValidateResourceLimits(ResourceLimits{
+			MaxNodesTotal: 7,
+			Cores:         ResourceRange{Min: 40, Max: 48},
+			Memory:        ResourceRange{Min: 200, Max: 256},
+		}, capacity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("Reports instance types whose capacity is unknown", func() {
+		capacity := ClusterCapacity(cluster(func(*cmv1.ClusterNodesBuilder) {}), []*cmv1.MachinePool{
+			machinePool("g4dn.xlarge", func(b *cmv1.MachinePoolBuilder) { b.Replicas(3) }),
+		}, machineTypes)
+		Expect(capacity.Nodes).To(Equal(3))
+		Expect(capacity.UnknownInstanceTypes).To(Equal([]string{"g4dn.xlarge"}))
+
+		warnings, err := ValidateResourceLimits(ResourceLimits{Cores: ResourceRange{Min: 100, Max: 200}}, capacity)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(Equal([]string{"The cores and memory limits aren't checked against the " +
+			"cluster, the capacity of instance types g4dn.xlarge is unknown"}))
+	})
+
+	It("Accepts limits that the machine pools can reach", func() {
+		warnings, err := ValidateResourceLimits(ResourceLimits{
+			MaxNodesTotal: 20,
+			Cores:         ResourceRange{Min: 8, Max: 100},
+			Memory:        ResourceRange{Min: 32, Max: 1000},
+		}, &Capacity{Nodes: 8, Cores: 40, MemoryGiB: 224})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+
+	It("Warns about maximums that stop the machine pools before their max replicas", func() {
+		warnings, err := ValidateResourceLimits(ResourceLimits{
+			MaxNodesTotal: 6,
+			Cores:         ResourceRange{Max: 32},
+			Memory:        ResourceRange{Max: 1000},
+		}, &Capacity{Nodes: 8, Cores: 40, MemoryGiB: 224})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(Equal([]string{
+			"The cluster can scale to 8 nodes, but the maximum nodes is 6: the machine pools won't reach " +
+				"their max replicas",
+			"The cluster can scale to 40 cores, but the maximum cores is 32: the machine pools won't " +
+				"reach their max replicas",
+		}))
+	})
+
+	It("Rejects minimums that the machine pools can't reach", func() {
+		_, err := ValidateResourceLimits(ResourceLimits{
+			Cores:  ResourceRange{Min: 64, Max: 100},
+			Memory: ResourceRange{Min: 256, Max: 1000},
+		}, &Capacity{Nodes: 8, Cores: 40, MemoryGiB: 224})
+		Expect(err).To(MatchError("Resource limits can't be reached by the cluster: " +
+			"the minimum cores of 64 exceeds the 40 cores of the cluster with its machine pools at their max " +
+			"replicas; the minimum memory of 256 exceeds the 224 GiB of memory of the cluster with its machine " +
+			"pools at their max replicas"))
+	})
+})
@@ -0,0 +1,95 @@
+package clusterautoscaler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Names of the autoscaler profiles
+const (
+	ProfileCostOptimized = "cost-optimized"
+	ProfileResponsive    = "responsive"
+)
+
+// profileSettings are the values of the settings of each profile, named like the flags of the settings
+var profileSettings = map[string]map[string]string{
+	// Removes underutilized nodes quickly and packs workloads on fewer nodes
+	ProfileCostOptimized: {
+		balanceSimilarNodeGroupsFlag:      "true",
+		ignoreDaemonsetsUtilizationFlag:   "true",
+		scaleDownEnabledFlag:              "true",
+		scaleDownUnneededTimeFlag:         "5m",
+		scaleDownUtilizationThresholdFlag: "0.7",
+		scaleDownDelayAfterAddFlag:        "5m",
+		scaleDownDelayAfterDeleteFlag:     "10s",
+		scaleDownDelayAfterFailureFlag:    "1m",
+	},
+	// Adds nodes quickly and keeps them around to absorb bursts of pods
+	ProfileResponsive: {
+		balanceSimilarNodeGroupsFlag:      "true",
+		maxNodeProvisionTimeFlag:          "10m",
+		scaleDownEnabledFlag:              "true",
+		scaleDownUnneededTimeFlag:         "30m",
+		scaleDownUtilizationThresholdFlag: "0.4",
+		scaleDownDelayAfterAddFlag:        "30m",
+		scaleDownDelayAfterDeleteFlag:     "5m",
+		scaleDownDelayAfterFailureFlag:    "5m",
+	},
+}
+
+// Profiles returns the names of the autoscaler profiles
+func Profiles() []string {
+	profiles := make([]string, 0, len(profileSettings))
+	for profile := range profileSettings {
+		profiles = append(profiles, profile)
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
+// ApplyProfile sets the autoscaler arguments of a profile, the settings in explicit were set by the user
+// and are left unchanged
+func ApplyProfile(profile string, explicit map[string]bool, args *AutoscalerArgs) error {
+	if profile == "" {
+		return nil
+	}
+	settings, ok := profileSettings[profile]
+	if !ok {
+		return fmt.Errorf("unknown autoscaler profile '%s', options are %s", profile,
+			strings.Join(Profiles(), ","))
+	}
+	for flag, value := range settings {
+		if explicit[flag] {
+			continue
+		}
+		var err error
+		switch flag {
+		case balanceSimilarNodeGroupsFlag:
+			args.BalanceSimilarNodeGroups, err = strconv.ParseBool(value)
+		case ignoreDaemonsetsUtilizationFlag:
+			args.IgnoreDaemonsetsUtilization, err = strconv.ParseBool(value)
+		case maxNodeProvisionTimeFlag:
+			args.MaxNodeProvisionTime = value
+		case scaleDownEnabledFlag:
+			args.ScaleDown.Enabled, err = strconv.ParseBool(value)
+		case scaleDownUnneededTimeFlag:
+			args.ScaleDown.UnneededTime = value
+		case scaleDownUtilizationThresholdFlag:
+			args.ScaleDown.UtilizationThreshold, err = strconv.ParseFloat(value, 64)
+		case scaleDownDelayAfterAddFlag:
+			args.ScaleDown.DelayAfterAdd = value
+		case scaleDownDelayAfterDeleteFlag:
+			args.ScaleDown.DelayAfterDelete = value
+		case scaleDownDelayAfterFailureFlag:
+			args.ScaleDown.DelayAfterFailure = value
+		default:
+			err = fmt.Errorf("setting '%s' can't be set by a profile", flag)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to apply autoscaler profile '%s': %v", profile, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package clusterautoscaler
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cluster Autoscaler profiles", func() {
+	var args *AutoscalerArgs
+
+	BeforeEach(func() {
+		args = &AutoscalerArgs{MaxNodeProvisionTime: "15m"}
+	})
+
+	It("Lists the profiles", func() {
+		Expect(Profiles()).To(Equal([]string{ProfileCostOptimized, ProfileResponsive}))
+	})
+
+	It("Applies the settings of a profile", func() {
+		Expect(ApplyProfile(ProfileCostOptimized, nil, args)).To(Succeed())
+		Expect(args.BalanceSimilarNodeGroups).To(BeTrue())
+		Expect(args.IgnoreDaemonsetsUtilization).To(BeTrue())
+		Expect(args.ScaleDown).To(Equal(ScaleDownConfig{
+			Enabled:              true,
+			UnneededTime:         "5m",
+			UtilizationThreshold: 0.7,
+			DelayAfterAdd:        "5m",
+			DelayAfterDelete:     "10s",
+			DelayAfterFailure:    "1m",
+		}))
+		Expect(args.MaxNodeProvisionTime).To(Equal("15m"))
+	})
+
+	It("Keeps the settings that are set explicitly", func() {
+		args.ScaleDown.UnneededTime = "1h"
+		args.MaxNodeProvisionTime = "20m"
+		explicit := map[string]bool{scaleDownUnneededTimeFlag: true, maxNodeProvisionTimeFlag: true}
+
+		Expect(ApplyProfile(ProfileResponsive, explicit, args)).To(Succeed())
+		Expect(args.ScaleDown.UnneededTime).To(Equal("1h"))
+		Expect(args.MaxNodeProvisionTime).To(Equal("20m"))
+		Expect(args.ScaleDown.UtilizationThreshold).To(Equal(0.4))
+		Expect(args.ScaleDown.DelayAfterAdd).To(Equal("30m"))
+	})
+
+	It("Leaves the arguments unchanged without a profile", func() {
+		Expect(ApplyProfile("", nil, args)).To(Succeed())
+		Expect(args.ScaleDown.Enabled).To(BeFalse())
+		Expect(args.ScaleDown.UnneededTime).To(BeEmpty())
+	})
+
+	It("Rejects an unknown profile", func() {
+		Expect(ApplyProfile("fast", nil, args)).To(MatchError(
+			"unknown autoscaler profile 'fast', options are cost-optimized,responsive"))
+	})
+})
@@ -19,6 +19,7 @@ package commands
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/analyze"
 	"github.com/openshift/rosa/cmd/attach"
 	"github.com/openshift/rosa/cmd/completion"
 	"github.com/openshift/rosa/cmd/config"
@@ -29,6 +30,8 @@ import (
 	"github.com/openshift/rosa/cmd/docs"
 	"github.com/openshift/rosa/cmd/download"
 	"github.com/openshift/rosa/cmd/edit"
+	"github.com/openshift/rosa/cmd/estimate"
+	"github.com/openshift/rosa/cmd/export"
 	"github.com/openshift/rosa/cmd/grant"
 	"github.com/openshift/rosa/cmd/hibernate"
 	"github.com/openshift/rosa/cmd/initialize"
@@ -38,9 +41,13 @@ import (
 	"github.com/openshift/rosa/cmd/login"
 	"github.com/openshift/rosa/cmd/logout"
 	"github.com/openshift/rosa/cmd/logs"
+	"github.com/openshift/rosa/cmd/migrate"
+	"github.com/openshift/rosa/cmd/plan"
+	"github.com/openshift/rosa/cmd/recommend"
 	"github.com/openshift/rosa/cmd/register"
 	"github.com/openshift/rosa/cmd/resume"
 	"github.com/openshift/rosa/cmd/revoke"
+	"github.com/openshift/rosa/cmd/sync"
 	"github.com/openshift/rosa/cmd/token"
 	"github.com/openshift/rosa/cmd/uninstall"
 	"github.com/openshift/rosa/cmd/unlink"
@@ -55,6 +62,7 @@ import (
 // the main ROSA CLI (cmd/rosa/main.go) and the documentation generation tool
 // (tools/gendocs/gen_rosa_docs.go), ensuring docs stay in sync with the actual CLI.
 func RegisterCommands(root *cobra.Command) {
+	root.AddCommand(analyze.Cmd)
 	root.AddCommand(completion.Cmd)
 	root.AddCommand(create.Cmd)
 	root.AddCommand(describe.Cmd)
@@ -62,6 +70,8 @@ func RegisterCommands(root *cobra.Command) {
 	root.AddCommand(docs.Cmd)
 	root.AddCommand(download.Cmd)
 	root.AddCommand(edit.Cmd)
+	root.AddCommand(estimate.Cmd)
+	root.AddCommand(export.Cmd)
 	root.AddCommand(grant.Cmd)
 	root.AddCommand(list.Cmd)
 	root.AddCommand(initialize.Cmd)
@@ -69,8 +79,12 @@ func RegisterCommands(root *cobra.Command) {
 	root.AddCommand(login.Cmd)
 	root.AddCommand(logout.Cmd)
 	root.AddCommand(logs.Cmd)
+	root.AddCommand(migrate.Cmd)
+	root.AddCommand(plan.Cmd)
+	root.AddCommand(recommend.Cmd)
 	root.AddCommand(register.Cmd)
 	root.AddCommand(revoke.Cmd)
+	root.AddCommand(sync.Cmd)
 	root.AddCommand(uninstall.Cmd)
 	root.AddCommand(upgrade.Cmd)
 	root.AddCommand(verify.Cmd)
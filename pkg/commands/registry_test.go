@@ -28,8 +28,8 @@ var _ = Describe("RegisterCommands", func() {
 			Expect(commands).ToNot(BeEmpty())
 
 			// Verify the expected number of commands are registered
-			// As of this test, there should be 29 top-level commands
-			Expect(len(commands)).To(Equal(29))
+			// As of this test, there should be 36 top-level commands
+			Expect(len(commands)).To(Equal(36))
 
 			// Verify specific critical commands are present
 			commandNames := make(map[string]bool)
@@ -39,6 +39,7 @@ var _ = Describe("RegisterCommands", func() {
 
 			// Check for essential commands
 			expectedCommands := []string{
+				"analyze",
 				"completion",
 				"create",
 				"describe",
@@ -46,6 +47,8 @@ var _ = Describe("RegisterCommands", func() {
 				"docs",
 				"download",
 				"edit",
+				"estimate",
+				"export",
 				"grant",
 				"list",
 				"init",
@@ -53,8 +56,12 @@ var _ = Describe("RegisterCommands", func() {
 				"login",
 				"logout",
 				"logs",
+				"migrate",
+				"plan",
+				"recommend",
 				"register",
 				"revoke",
+				"sync",
 				"uninstall",
 				"upgrade",
 				"verify",
@@ -106,7 +113,7 @@ var _ = Describe("RegisterCommands", func() {
 
 			// Both should have the same number of commands
 			Expect(firstCount).To(Equal(secondCount))
-			Expect(firstCount).To(Equal(29))
+			Expect(firstCount).To(Equal(36))
 		})
 	})
 })
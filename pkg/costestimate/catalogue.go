@@ -0,0 +1,219 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costestimate
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultCurrency is used when the catalogue doesn't set one
+	DefaultCurrency = "USD"
+
+	// HostedControlPlaneRow is the instance type of the CSV row that holds the hourly fee of a hosted
+	// control plane
+	HostedControlPlaneRow = "hosted-control-plane"
+
+	anyRegion = "*"
+)
+
+// CatalogueFormat describes the price catalogue files, it is shown in the help of 'rosa estimate cost'
+const CatalogueFormat = `The price catalogue is a JSON or CSV file, selected by its extension, with hourly prices:
+
+  JSON:
+    {
+      "currency": "USD",
+      "hosted_control_plane": 0.25,
+      "prices": [
+        {"region": "us-east-1", "instance_type": "m5.xlarge", "on_demand": 0.192, "spot": 0.07, "worker_fee": 0.171}
+      ]
+    }
+
+  CSV, with a header row and the 'spot' and 'worker_fee' columns optional:
+    region,instance_type,on_demand,spot,worker_fee
+    us-east-1,m5.xlarge,0.192,0.07,0.171
+    *,hosted-control-plane,0.25,,
+
+'on_demand' and 'spot' are the hourly EC2 prices of one instance, 'worker_fee' is the hourly service fee of
+one worker node of that type and 'hosted_control_plane' the hourly fee of a hosted control plane. A region
+of '*' or an empty region applies to all the regions without a price of their own. The CSV file holds the
+hosted control plane fee in the 'on_demand' column of a row with the 'hosted-control-plane' instance type.
+The currency of CSV files is USD.`
+
+// Price is the hourly price of an instance type in a region
+type Price struct {
+	Region       string   `json:"region"`
+	InstanceType string   `json:"instance_type"`
+	OnDemand     float64  `json:"on_demand"`
+	Spot         *float64 `json:"spot,omitempty"`
+	WorkerFee    float64  `json:"worker_fee,omitempty"`
+}
+
+// Catalogue holds the prices used to estimate the cost of a cluster
+type Catalogue struct {
+	Currency           string  `json:"currency"`
+	HostedControlPlane float64 `json:"hosted_control_plane"`
+	Prices             []Price `json:"prices"`
+}
+
+// LoadCatalogue reads a JSON or CSV price catalogue
+func LoadCatalogue(path string) (*Catalogue, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the price catalogue: %v", err)
+	}
+	defer file.Close()
+
+	var catalogue *Catalogue
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		catalogue, err = ParseJSONCatalogue(file)
+	case ".csv":
+		catalogue, err = ParseCSVCatalogue(file)
+	default:
+		return nil, fmt.Errorf("unsupported price catalogue '%s', expected a '.json' or '.csv' file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the price catalogue '%s': %v", path, err)
+	}
+	return catalogue, nil
+}
+
+// ParseJSONCatalogue parses a catalogue in the JSON format
+func ParseJSONCatalogue(reader io.Reader) (*Catalogue, error) {
+	catalogue := &Catalogue{}
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(catalogue); err != nil {
+		return nil, err
+	}
+	if catalogue.Currency == "" {
+		catalogue.Currency = DefaultCurrency
+	}
+	for i, price := range catalogue.Prices {
+		if err := price.validate(); err != nil {
+			return nil, fmt.Errorf("price %d: %v", i+1, err)
+		}
+	}
+	return catalogue, nil
+}
+
+// ParseCSVCatalogue parses a catalogue in the CSV format
+func ParseCSVCatalogue(reader io.Reader) (*Catalogue, error) {
+	records, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("the file is empty")
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	for _, name := range []string{"region", "instance_type", "on_demand"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("the header is missing the '%s' column", name)
+		}
+	}
+	field := func(record []string, name string) string {
+		index, ok := columns[name]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	catalogue := &Catalogue{Currency: DefaultCurrency}
+	for i, record := range records[1:] {
+		line := i + 2
+		price := Price{
+			Region:       field(record, "region"),
+			InstanceType: field(record, "instance_type"),
+		}
+		price.OnDemand, err = parsePrice(field(record, "on_demand"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid 'on_demand' price: %v", line, err)
+		}
+		if spot := field(record, "spot"); spot != "" {
+			value, err := parsePrice(spot)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid 'spot' price: %v", line, err)
+			}
+			price.Spot = &value
+		}
+		price.WorkerFee, err = parsePrice(field(record, "worker_fee"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid 'worker_fee' price: %v", line, err)
+		}
+		if price.InstanceType == HostedControlPlaneRow {
+			catalogue.HostedControlPlane = price.OnDemand
+			continue
+		}
+		if err := price.validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		catalogue.Prices = append(catalogue.Prices, price)
+	}
+	return catalogue, nil
+}
+
+func parsePrice(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	price, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a number", value)
+	}
+	return price, nil
+}
+
+func (p *Price) validate() error {
+	if p.InstanceType == "" {
+		return fmt.Errorf("the instance type is missing")
+	}
+	if p.OnDemand < 0 || p.WorkerFee < 0 || (p.Spot != nil && *p.Spot < 0) {
+		return fmt.Errorf("the prices of instance type '%s' must not be negative", p.InstanceType)
+	}
+	return nil
+}
+
+// Lookup returns the price of an instance type in a region, falling back to the price for all regions
+func (c *Catalogue) Lookup(region string, instanceType string) (*Price, bool) {
+	var fallback *Price
+	for i := range c.Prices {
+		price := &c.Prices[i]
+		if price.InstanceType != instanceType {
+			continue
+		}
+		if price.Region == region {
+			return price, true
+		}
+		if price.Region == "" || price.Region == anyRegion {
+			fallback = price
+		}
+	}
+	return fallback, fallback != nil
+}
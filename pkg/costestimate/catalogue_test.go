@@ -0,0 +1,85 @@
+package costestimate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Catalogue", func() {
+	It("Parses a JSON catalogue", func() {
+		catalogue, err := ParseJSONCatalogue(strings.NewReader(`{
+			"currency": "EUR",
+			"hosted_control_plane": 0.25,
+			"prices": [
+				{"region": "us-east-1", "instance_type": "m5.xlarge", "on_demand": 0.192, "spot": 0.07,
+				 "worker_fee": 0.171}
+			]
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalogue.Currency).To(Equal("EUR"))
+		Expect(catalogue.HostedControlPlane).To(Equal(0.25))
+		Expect(catalogue.Prices).To(HaveLen(1))
+		Expect(*catalogue.Prices[0].Spot).To(Equal(0.07))
+		Expect(catalogue.Prices[0].WorkerFee).To(Equal(0.171))
+	})
+
+	It("Rejects unknown fields of JSON catalogues", func() {
+		_, err := ParseJSONCatalogue(strings.NewReader(`{"prices": [{"instance_type": "m5.xlarge", "price": 1}]}`))
+		Expect(err).To(MatchError(ContainSubstring("unknown field \"price\"")))
+	})
+
+	It("Parses a CSV catalogue", func() {
+		catalogue, err := ParseCSVCatalogue(strings.NewReader("region,instance_type,on_demand,spot\n" +
+			"us-east-1,m5.xlarge,0.192,0.07\n" +
+			"*,r5.xlarge,0.252,\n" +
+			"*,hosted-control-plane,0.25,\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalogue.Currency).To(Equal(DefaultCurrency))
+		Expect(catalogue.HostedControlPlane).To(Equal(0.25))
+		Expect(catalogue.Prices).To(HaveLen(2))
+		Expect(catalogue.Prices[1].Spot).To(BeNil())
+	})
+
+	It("Reports the line of invalid CSV prices", func() {
+		_, err := ParseCSVCatalogue(strings.NewReader("region,instance_type,on_demand\nus-east-1,m5.xlarge,cheap\n"))
+		Expect(err).To(MatchError("line 2: invalid 'on_demand' price: 'cheap' is not a number"))
+		_, err = ParseCSVCatalogue(strings.NewReader("region,on_demand\nus-east-1,1\n"))
+		Expect(err).To(MatchError("the header is missing the 'instance_type' column"))
+	})
+
+	It("Selects the format from the extension", func() {
+		dir := GinkgoT().TempDir()
+		path := filepath.Join(dir, "prices.csv")
+		Expect(os.WriteFile(path, []byte("region,instance_type,on_demand\nus-east-1,m5.xlarge,0.192\n"),
+			0600)).To(Succeed())
+		catalogue, err := LoadCatalogue(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(catalogue.Prices).To(HaveLen(1))
+
+		_, err = LoadCatalogue(filepath.Join(dir, "prices.xml"))
+		Expect(err).To(MatchError(ContainSubstring("failed to open the price catalogue")))
+		path = filepath.Join(dir, "prices.xml")
+		Expect(os.WriteFile(path, []byte("<prices/>"), 0600)).To(Succeed())
+		_, err = LoadCatalogue(path)
+		Expect(err).To(MatchError(ContainSubstring("expected a '.json' or '.csv' file")))
+	})
+
+	It("Falls back to the prices for all regions", func() {
+		catalogue := &Catalogue{Prices: []Price{
+			{Region: "*", InstanceType: "m5.xlarge", OnDemand: 0.2},
+			{Region: "eu-west-1", InstanceType: "m5.xlarge", OnDemand: 0.214},
+		}}
+		price, ok := catalogue.Lookup("eu-west-1", "m5.xlarge")
+		Expect(ok).To(BeTrue())
+		Expect(price.OnDemand).To(Equal(0.214))
+		price, ok = catalogue.Lookup("us-east-1", "m5.xlarge")
+		Expect(ok).To(BeTrue())
+		Expect(price.OnDemand).To(Equal(0.2))
+		_, ok = catalogue.Lookup("us-east-1", "m5.2xlarge")
+		Expect(ok).To(BeFalse())
+	})
+})
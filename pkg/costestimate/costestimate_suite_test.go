@@ -0,0 +1,13 @@
+package costestimate
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCostEstimate(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cost Estimate Suite")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costestimate
+
+import (
+	"fmt"
+)
+
+// HoursPerMonth is the average number of hours in a month used for monthly estimates
+const HoursPerMonth = 730
+
+// Role is the function of the nodes of a pool
+type Role string
+
+const (
+	RoleControlPlane Role = "control-plane"
+	RoleInfra        Role = "infra"
+	RoleWorker       Role = "worker"
+)
+
+// Pool is a group of nodes of the same instance type. Autoscaling pools run between MinNodes and MaxNodes
+// nodes, other pools have the same value in both.
+type Pool struct {
+	Name         string `json:"name"`
+	Role         Role   `json:"role"`
+	InstanceType string `json:"instance_type"`
+	MinNodes     int    `json:"min_nodes"`
+	MaxNodes     int    `json:"max_nodes"`
+	Spot         bool   `json:"spot"`
+}
+
+// PoolCost is the monthly cost of a pool, for the minimum and maximum number of nodes
+type PoolCost struct {
+	Pool
+	HourlyPrice    float64 `json:"hourly_price_per_node"`
+	HourlyFee      float64 `json:"hourly_fee_per_node"`
+	MinMonthlyCost float64 `json:"min_monthly_cost"`
+	MaxMonthlyCost float64 `json:"max_monthly_cost"`
+	Priced         bool    `json:"priced"`
+}
+
+// Estimate is the monthly cost of a cluster broken down by pool
+type Estimate struct {
+	Region             string      `json:"region"`
+	Currency           string      `json:"currency"`
+	HoursPerMonth      int         `json:"hours_per_month"`
+	HostedControlPlane float64     `json:"hosted_control_plane_monthly_cost,omitempty"`
+	Pools              []*PoolCost `json:"pools"`
+	MinMonthlyCost     float64     `json:"min_monthly_cost"`
+	MaxMonthlyCost     float64     `json:"max_monthly_cost"`
+	Warnings           []string    `json:"warnings,omitempty"`
+}
+
+// Complete returns true if all the pools could be priced
+func (e *Estimate) Complete() bool {
+	for _, pool := range e.Pools {
+		if !pool.Priced {
+			return false
+		}
+	}
+	return true
+}
+
+// EstimateCost multiplies the nodes of the pools by their prices in the catalogue. Pools without a price
+// are kept in the estimate with a warning and don't add to the totals.
+func EstimateCost(catalogue *Catalogue, region string, hostedCP bool, pools []*Pool) *Estimate {
+	estimate := &Estimate{
+		Region:        region,
+		Currency:      catalogue.Currency,
+		HoursPerMonth: HoursPerMonth,
+		Pools:         []*PoolCost{},
+	}
+	if hostedCP {
+		estimate.HostedControlPlane = catalogue.HostedControlPlane * HoursPerMonth
+		if catalogue.HostedControlPlane == 0 {
+			estimate.warn("the catalogue has no hosted control plane fee, the control plane is not included")
+		}
+	}
+	estimate.MinMonthlyCost = estimate.HostedControlPlane
+	estimate.MaxMonthlyCost = estimate.HostedControlPlane
+
+	for _, pool := range pools {
+		cost := &PoolCost{Pool: *pool}
+		estimate.Pools = append(estimate.Pools, cost)
+		price, ok := catalogue.Lookup(region, pool.InstanceType)
+		if !ok {
+			estimate.warn("there is no price for instance type '%s' in region '%s', machine pool '%s' "+
+				"is not included", pool.InstanceType, region, pool.Name)
+			continue
+		}
+		cost.Priced = true
+		cost.HourlyPrice = price.OnDemand
+		if pool.Spot {
+			if price.Spot != nil {
+				cost.HourlyPrice = *price.Spot
+			} else {
+				estimate.warn("there is no spot price for instance type '%s' in region '%s', the on-demand "+
+					"price is used for machine pool '%s'", pool.InstanceType, region, pool.Name)
+			}
+		}
+		if pool.Role == RoleWorker {
+			cost.HourlyFee = price.WorkerFee
+		}
+		hourly := cost.HourlyPrice + cost.HourlyFee
+		cost.MinMonthlyCost = hourly * float64(pool.MinNodes) * HoursPerMonth
+		cost.MaxMonthlyCost = hourly * float64(pool.MaxNodes) * HoursPerMonth
+		estimate.MinMonthlyCost += cost.MinMonthlyCost
+		estimate.MaxMonthlyCost += cost.MaxMonthlyCost
+	}
+	return estimate
+}
+
+func (e *Estimate) warn(format string, args ...interface{}) {
+	e.Warnings = append(e.Warnings, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,120 @@
+package costestimate
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("EstimateCost", func() {
+	spot := 0.05
+	catalogue := &Catalogue{
+		Currency:           DefaultCurrency,
+		HostedControlPlane: 0.25,
+		Prices: []Price{
+			{Region: "us-east-1", InstanceType: "m5.xlarge", OnDemand: 0.2, Spot: &spot, WorkerFee: 0.1},
+			{Region: "us-east-1", InstanceType: "m5.2xlarge", OnDemand: 0.4, WorkerFee: 0.2},
+			{Region: "us-east-1", InstanceType: "r5.xlarge", OnDemand: 0.25},
+		},
+	}
+
+	It("Estimates a classic cluster spec", func() {
+		spec := &Spec{
+			Region:  "us-east-1",
+			MultiAZ: true,
+			MachinePools: []SpecMachinePool{
+				{Name: "workers", InstanceType: "m5.xlarge", Replicas: 3},
+				{Name: "batch", InstanceType: "m5.xlarge", MinReplicas: 0, MaxReplicas: 4, Spot: true},
+			},
+		}
+		pools, err := spec.Pools()
+		Expect(err).ToNot(HaveOccurred())
+		estimate := EstimateCost(catalogue, spec.Region, false, pools)
+		Expect(estimate.Warnings).To(BeEmpty())
+		Expect(estimate.Pools).To(HaveLen(4))
+
+		controlPlane := estimate.Pools[0]
+		Expect(controlPlane.Role).To(Equal(RoleControlPlane))
+		Expect(controlPlane.InstanceType).To(Equal(DefaultControlPlaneInstanceType))
+		// Control plane nodes don't pay the worker fee
+		Expect(controlPlane.HourlyFee).To(BeZero())
+		Expect(controlPlane.MinMonthlyCost).To(BeNumerically("~", 0.4*3*HoursPerMonth))
+
+		infra := estimate.Pools[1]
+		Expect(infra.MinNodes).To(Equal(3))
+		Expect(infra.MinMonthlyCost).To(BeNumerically("~", 0.25*3*HoursPerMonth))
+
+		workers := estimate.Pools[2]
+		Expect(workers.MinMonthlyCost).To(BeNumerically("~", 0.3*3*HoursPerMonth))
+
+		batch := estimate.Pools[3]
+		Expect(batch.HourlyPrice).To(Equal(0.05))
+		Expect(batch.MinMonthlyCost).To(BeZero())
+		Expect(batch.MaxMonthlyCost).To(BeNumerically("~", 0.15*4*HoursPerMonth))
+
+		Expect(estimate.MinMonthlyCost).To(BeNumerically("~", (1.2+0.75+0.9)*HoursPerMonth))
+		Expect(estimate.MaxMonthlyCost).To(BeNumerically("~", (1.2+0.75+0.9+0.6)*HoursPerMonth))
+		Expect(estimate.Complete()).To(BeTrue())
+	})
+
+	It("Adds the hosted control plane fee and reports missing prices", func() {
+		estimate := EstimateCost(catalogue, "us-east-1", true, []*Pool{
+			{Name: "workers", Role: RoleWorker, InstanceType: "m5.2xlarge", MinNodes: 2, MaxNodes: 2, Spot: true},
+			{Name: "gpu", Role: RoleWorker, InstanceType: "g4dn.xlarge", MinNodes: 1, MaxNodes: 1},
+		})
+		Expect(estimate.HostedControlPlane).To(BeNumerically("~", 0.25*HoursPerMonth))
+		Expect(estimate.Pools[0].HourlyPrice).To(Equal(0.4))
+		Expect(estimate.Pools[1].Priced).To(BeFalse())
+		Expect(estimate.Complete()).To(BeFalse())
+		Expect(estimate.MinMonthlyCost).To(BeNumerically("~", (0.25+1.2)*HoursPerMonth))
+		Expect(estimate.Warnings).To(Equal([]string{
+			"there is no spot price for instance type 'm5.2xlarge' in region 'us-east-1', the on-demand " +
+				"price is used for machine pool 'workers'",
+			"there is no price for instance type 'g4dn.xlarge' in region 'us-east-1', machine pool 'gpu' " +
+				"is not included",
+		}))
+	})
+})
+
+var _ = Describe("Pools", func() {
+	It("Rejects invalid spec machine pools", func() {
+		spec := &Spec{MachinePools: []SpecMachinePool{{Name: "workers", InstanceType: "m5.xlarge", Replicas: 2,
+			MaxReplicas: 4}}}
+		_, err := spec.Pools()
+		Expect(err).To(MatchError("machine pool 'workers' sets both replicas and min/max replicas"))
+		spec = &Spec{MachinePools: []SpecMachinePool{{Replicas: 2}}}
+		_, err = spec.Pools()
+		Expect(err).To(MatchError("machine pool 'machine pool 1' has no instance type"))
+	})
+
+	It("Enumerates the nodes of a classic cluster", func() {
+		cluster, err := cmv1.NewCluster().MultiAZ(false).Nodes(cmv1.NewClusterNodes().Master(3).Infra(2).
+			MasterMachineType(cmv1.NewMachineType().ID("m5.4xlarge")).
+			InfraMachineType(cmv1.NewMachineType().ID("r5.2xlarge"))).Build()
+		Expect(err).ToNot(HaveOccurred())
+		machinePool, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(5)).
+			AWS(cmv1.NewAWSMachinePool().SpotMarketOptions(cmv1.NewAWSSpotMarketOptions())).Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		pools := ClusterPools(cluster, []*cmv1.MachinePool{machinePool}, nil)
+		Expect(pools).To(Equal([]*Pool{
+			{Name: "control-plane", Role: RoleControlPlane, InstanceType: "m5.4xlarge", MinNodes: 3, MaxNodes: 3},
+			{Name: "infra", Role: RoleInfra, InstanceType: "r5.2xlarge", MinNodes: 2, MaxNodes: 2},
+			{Name: "workers", Role: RoleWorker, InstanceType: "m5.xlarge", MinNodes: 2, MaxNodes: 5, Spot: true},
+		}))
+	})
+
+	It("Enumerates the node pools of a hosted control plane cluster", func() {
+		cluster, err := cmv1.NewCluster().Hypershift(cmv1.NewHypershift().Enabled(true)).Build()
+		Expect(err).ToNot(HaveOccurred())
+		nodePool, err := cmv1.NewNodePool().ID("workers").Replicas(3).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		pools := ClusterPools(cluster, nil, []*cmv1.NodePool{nodePool})
+		Expect(pools).To(Equal([]*Pool{
+			{Name: "workers", Role: RoleWorker, InstanceType: "m5.xlarge", MinNodes: 3, MaxNodes: 3},
+		}))
+	})
+})
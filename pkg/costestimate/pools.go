@@ -0,0 +1,199 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costestimate
+
+import (
+	"fmt"
+	"os"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Instance types of the control plane and infra nodes of classic clusters created with the defaults
+	DefaultControlPlaneInstanceType = "m5.2xlarge"
+	DefaultInfraInstanceType        = "r5.xlarge"
+
+	controlPlaneNodes = 3
+	controlPlanePool  = "control-plane"
+	infraPool         = "infra"
+)
+
+// Spec describes a cluster that doesn't exist yet
+type Spec struct {
+	Region                   string            `json:"region"`
+	HostedCP                 bool              `json:"hosted_cp,omitempty"`
+	MultiAZ                  bool              `json:"multi_az,omitempty"`
+	ControlPlaneInstanceType string            `json:"control_plane_instance_type,omitempty"`
+	InfraInstanceType        string            `json:"infra_instance_type,omitempty"`
+	MachinePools             []SpecMachinePool `json:"machine_pools"`
+}
+
+// SpecMachinePool describes a machine pool of a cluster spec. Autoscaling pools set the minimum and
+// maximum replicas instead of the replicas.
+type SpecMachinePool struct {
+	Name         string `json:"name"`
+	InstanceType string `json:"instance_type"`
+	Replicas     int    `json:"replicas,omitempty"`
+	MinReplicas  int    `json:"min_replicas,omitempty"`
+	MaxReplicas  int    `json:"max_replicas,omitempty"`
+	Spot         bool   `json:"spot,omitempty"`
+}
+
+// LoadSpec reads a cluster spec from a YAML or JSON file
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the cluster spec: %v", err)
+	}
+	spec := &Spec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse the cluster spec '%s': %v", path, err)
+	}
+	return spec, nil
+}
+
+// Pools returns the pools of nodes of the cluster described by the spec. Classic clusters have control
+// plane and infra nodes besides the machine pools.
+func (s *Spec) Pools() ([]*Pool, error) {
+	if len(s.MachinePools) == 0 {
+		return nil, fmt.Errorf("the cluster spec has no machine pools")
+	}
+	pools := []*Pool{}
+	if !s.HostedCP {
+		controlPlaneType := s.ControlPlaneInstanceType
+		if controlPlaneType == "" {
+			controlPlaneType = DefaultControlPlaneInstanceType
+		}
+		infraType := s.InfraInstanceType
+		if infraType == "" {
+			infraType = DefaultInfraInstanceType
+		}
+		pools = append(pools,
+			fixedPool(controlPlanePool, RoleControlPlane, controlPlaneType, controlPlaneNodes),
+			fixedPool(infraPool, RoleInfra, infraType, defaultInfraNodes(s.MultiAZ)),
+		)
+	}
+	for i, machinePool := range s.MachinePools {
+		name := machinePool.Name
+		if name == "" {
+			name = fmt.Sprintf("machine pool %d", i+1)
+		}
+		if machinePool.InstanceType == "" {
+			return nil, fmt.Errorf("machine pool '%s' has no instance type", name)
+		}
+		pool := &Pool{
+			Name:         name,
+			Role:         RoleWorker,
+			InstanceType: machinePool.InstanceType,
+			MinNodes:     machinePool.Replicas,
+			MaxNodes:     machinePool.Replicas,
+			Spot:         machinePool.Spot,
+		}
+		if machinePool.MinReplicas != 0 || machinePool.MaxReplicas != 0 {
+			if machinePool.Replicas != 0 {
+				return nil, fmt.Errorf("machine pool '%s' sets both replicas and min/max replicas", name)
+			}
+			if machinePool.MinReplicas > machinePool.MaxReplicas {
+				return nil, fmt.Errorf("the min replicas of machine pool '%s' are greater than the max replicas",
+					name)
+			}
+			pool.MinNodes = machinePool.MinReplicas
+			pool.MaxNodes = machinePool.MaxReplicas
+		}
+		if pool.MinNodes < 0 {
+			return nil, fmt.Errorf("the replicas of machine pool '%s' must not be negative", name)
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+// ClusterPools returns the pools of nodes of an existing cluster, the machine pools of classic clusters
+// with their control plane and infra nodes or the node pools of hosted control plane clusters
+func ClusterPools(cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool,
+	nodePools []*cmv1.NodePool) []*Pool {
+	pools := []*Pool{}
+	if cluster.Hypershift().Enabled() {
+		for _, nodePool := range nodePools {
+			pool := &Pool{
+				Name:         nodePool.ID(),
+				Role:         RoleWorker,
+				InstanceType: nodePool.AWSNodePool().InstanceType(),
+				MinNodes:     nodePool.Replicas(),
+				MaxNodes:     nodePool.Replicas(),
+			}
+			if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+				pool.MinNodes = autoscaling.MinReplica()
+				pool.MaxNodes = autoscaling.MaxReplica()
+			}
+			_, pool.Spot = nodePool.AWSNodePool().GetSpotMarketOptions()
+			pools = append(pools, pool)
+		}
+		return pools
+	}
+
+	nodes := cluster.Nodes()
+	controlPlaneCount := nodes.Master()
+	if controlPlaneCount == 0 {
+		controlPlaneCount = controlPlaneNodes
+	}
+	controlPlaneType := nodes.MasterMachineType().ID()
+	if controlPlaneType == "" {
+		controlPlaneType = DefaultControlPlaneInstanceType
+	}
+	infraCount := nodes.Infra()
+	if infraCount == 0 {
+		infraCount = defaultInfraNodes(cluster.MultiAZ())
+	}
+	infraType := nodes.InfraMachineType().ID()
+	if infraType == "" {
+		infraType = DefaultInfraInstanceType
+	}
+	pools = append(pools,
+		fixedPool(controlPlanePool, RoleControlPlane, controlPlaneType, controlPlaneCount),
+		fixedPool(infraPool, RoleInfra, infraType, infraCount),
+	)
+	for _, machinePool := range machinePools {
+		pool := &Pool{
+			Name:         machinePool.ID(),
+			Role:         RoleWorker,
+			InstanceType: machinePool.InstanceType(),
+			MinNodes:     machinePool.Replicas(),
+			MaxNodes:     machinePool.Replicas(),
+		}
+		if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+			pool.MinNodes = autoscaling.MinReplicas()
+			pool.MaxNodes = autoscaling.MaxReplicas()
+		}
+		_, pool.Spot = machinePool.AWS().GetSpotMarketOptions()
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+func fixedPool(name string, role Role, instanceType string, nodes int) *Pool {
+	return &Pool{Name: name, Role: role, InstanceType: instanceType, MinNodes: nodes, MaxNodes: nodes}
+}
+
+func defaultInfraNodes(multiAZ bool) int {
+	if multiAZ {
+		return 3
+	}
+	return 2
+}
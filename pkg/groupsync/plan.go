@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupsync
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Plan is the change that synchronizes the users of a group with a list of users
+type Plan struct {
+	Group     string   `json:"group"`
+	Add       []string `json:"add"`
+	Remove    []string `json:"remove"`
+	Unchanged []string `json:"unchanged"`
+}
+
+// LoadUsers reads the usernames of a file, see ParseUsers
+func LoadUsers(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsers(data)
+}
+
+// ParseUsers parses a list of usernames, one per line. Blank lines and lines starting with '#' are
+// ignored, usernames listed more than once are only returned once.
+func ParseUsers(data []byte) ([]string, error) {
+	users := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	line := 0
+	for scanner.Scan() {
+		line++
+		username := strings.TrimSpace(scanner.Text())
+		if username == "" || strings.HasPrefix(username, "#") {
+			continue
+		}
+		if !ocm.IsValidUsername(username) {
+			return nil, fmt.Errorf("username '%s' on line %d isn't valid: it must contain only letters, "+
+				"digits, dashes and underscores", username, line)
+		}
+		if !slices.Contains(users, username) {
+			users = append(users, username)
+		}
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, fmt.Errorf("expected at least one user")
+	}
+	return users, nil
+}
+
+// NewPlan compares the users of a group with the users it must have. Reserved users are never added or
+// removed.
+func NewPlan(group string, current []*cmv1.User, desired []string, reserved []string) *Plan {
+	plan := &Plan{
+		Group:     group,
+		Add:       []string{},
+		Remove:    []string{},
+		Unchanged: []string{},
+	}
+	currentUsers := []string{}
+	for _, user := range current {
+		currentUsers = append(currentUsers, user.ID())
+	}
+	for _, username := range desired {
+		if slices.Contains(reserved, username) {
+			continue
+		}
+		if slices.Contains(currentUsers, username) {
+			plan.Unchanged = append(plan.Unchanged, username)
+		} else {
+			plan.Add = append(plan.Add, username)
+		}
+	}
+	for _, username := range currentUsers {
+		if !slices.Contains(desired, username) && !slices.Contains(reserved, username) {
+			plan.Remove = append(plan.Remove, username)
+		}
+	}
+	sort.Strings(plan.Add)
+	sort.Strings(plan.Remove)
+	sort.Strings(plan.Unchanged)
+	return plan
+}
+
+// HasChanges tells if users are added to or removed from the group
+func (p *Plan) HasChanges() bool {
+	return len(p.Add) > 0 || len(p.Remove) > 0
+}
+
+// Report returns the users added to and removed from the group, followed by the number of users that
+// are unchanged
+func (p *Plan) Report() string {
+	result := fmt.Sprintf("Changes to group '%s':\n", p.Group)
+	if !p.HasChanges() {
+		result += "  No changes\n"
+	}
+	for _, username := range p.Add {
+		result += fmt.Sprintf("  + %s\n", username)
+	}
+	for _, username := range p.Remove {
+		result += fmt.Sprintf("  - %s\n", username)
+	}
+	result += fmt.Sprintf("Users added: %d, removed: %d, unchanged: %d\n", len(p.Add), len(p.Remove),
+		len(p.Unchanged))
+	return result
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package groupsync
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Group sync", func() {
+	Context("ParseUsers", func() {
+		It("Parses one username per line, ignoring comments, blank lines and duplicates", func() {
+			users, err := ParseUsers([]byte("# admins\nalice\n\n  bob  \nalice\n# carol\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(users).To(Equal([]string{"alice", "bob"}))
+		})
+
+		It("Rejects invalid usernames", func() {
+			_, err := ParseUsers([]byte("alice\nbob:smith\n"))
+			Expect(err).To(MatchError("username 'bob:smith' on line 2 isn't valid: it must contain only " +
+				"letters, digits, dashes and underscores"))
+		})
+
+		It("Rejects files without users", func() {
+			_, err := ParseUsers([]byte("# nobody\n\n"))
+			Expect(err).To(MatchError("expected at least one user"))
+		})
+	})
+
+	Context("NewPlan", func() {
+		users := func(ids ...string) []*cmv1.User {
+			result := []*cmv1.User{}
+			for _, id := range ids {
+				user, err := cmv1.NewUser().ID(id).Build()
+				Expect(err).NotTo(HaveOccurred())
+				result = append(result, user)
+			}
+			return result
+		}
+
+		It("Adds the missing users and removes the users that aren't listed", func() {
+			plan := NewPlan("dedicated-admins", users("carol", "alice", "dave"), []string{"bob", "alice", "erin"},
+				nil)
+			Expect(plan.Add).To(Equal([]string{"bob", "erin"}))
+			Expect(plan.Remove).To(Equal([]string{"carol", "dave"}))
+			Expect(plan.Unchanged).To(Equal([]string{"alice"}))
+			Expect(plan.HasChanges()).To(BeTrue())
+			Expect(plan.Report()).To(Equal("Changes to group 'dedicated-admins':\n" +
+				"  + bob\n  + erin\n  - carol\n  - dave\n" +
+				"Users added: 2, removed: 2, unchanged: 1\n"))
+		})
+
+		It("Never adds or removes reserved users", func() {
+			plan := NewPlan("cluster-admins", users("cluster-admin", "alice"), []string{"alice"},
+				[]string{"cluster-admin"})
+			Expect(plan.HasChanges()).To(BeFalse())
+			Expect(plan.Report()).To(Equal("Changes to group 'cluster-admins':\n  No changes\n" +
+				"Users added: 0, removed: 0, unchanged: 1\n"))
+		})
+	})
+})
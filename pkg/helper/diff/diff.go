@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diff compares the attributes of a resource before and after an edit
+package diff
+
+import "fmt"
+
+// FieldChange is an attribute of a resource changed by an edit
+type FieldChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// Changes are the attributes of a resource changed by an edit, in the order they were compared
+type Changes []FieldChange
+
+// Add records the change of an attribute if its value before and after the edit differ
+func (c *Changes) Add(field string, before string, after string) {
+	if before != after {
+		*c = append(*c, FieldChange{Field: field, Before: before, After: after})
+	}
+}
+
+// Format returns a line per change with the value before and after the edit, the fields padded to the
+// width. Empty values are shown as '-'.
+func (c Changes) Format(width int) string {
+	if len(c) == 0 {
+		return "  No changes\n"
+	}
+	result := ""
+	for _, change := range c {
+		result += fmt.Sprintf("  %-*s%s -> %s\n", width, change.Field+":", printEmpty(change.Before),
+			printEmpty(change.After))
+	}
+	return result
+}
+
+func printEmpty(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
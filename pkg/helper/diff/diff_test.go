@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDiff(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Diff Suite")
+}
+
+var _ = Describe("Changes", func() {
+	It("Records only the fields whose value changes", func() {
+		changes := Changes{}
+		changes.Add("Replicas", "3", "6")
+		changes.Add("Labels", "app=db", "app=db")
+		changes.Add("Taints", "", "dedicated=db:NoSchedule")
+		Expect(changes).To(Equal(Changes{
+			{Field: "Replicas", Before: "3", After: "6"},
+			{Field: "Taints", Before: "", After: "dedicated=db:NoSchedule"},
+		}))
+	})
+
+	It("Formats the changes with the fields padded to the width", func() {
+		changes := Changes{}
+		changes.Add("Replicas", "3", "6")
+		changes.Add("Taints", "dedicated=db:NoSchedule", "")
+		Expect(changes.Format(12)).To(Equal("  Replicas:   3 -> 6\n" +
+			"  Taints:     dedicated=db:NoSchedule -> -\n"))
+	})
+
+	It("Formats no changes", func() {
+		var changes Changes
+		Expect(changes.Format(12)).To(Equal("  No changes\n"))
+	})
+})
@@ -0,0 +1,622 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idp
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
+	passwordValidator "github.com/openshift-online/ocm-common/pkg/idp/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/helper"
+	urlHelper "github.com/openshift/rosa/pkg/helper/url"
+)
+
+const (
+	GithubType   = "github"
+	GitlabType   = "gitlab"
+	GoogleType   = "google"
+	HTPasswdType = "htpasswd"
+	LDAPType     = "ldap"
+	OpenIDType   = "openid"
+
+	// ClusterAdminName is the name of the HTPasswd identity provider of the cluster admin, it's created
+	// with 'rosa create admin' and isn't part of an export
+	ClusterAdminName = "cluster-admin"
+
+	defaultMappingMethod = "claim"
+
+	documentHeader = "# Secrets are exported as placeholders: set the environment variables, or replace a\n" +
+		"# placeholder with ${file:<path>} to read the secret from a file, before running\n" +
+		"# 'rosa create idps --from-file'.\n"
+)
+
+var Types = []string{GithubType, GitlabType, GoogleType, HTPasswdType, LDAPType, OpenIDType}
+var MappingMethods = []string{"add", "claim", "generate", "lookup"}
+
+var cmv1Types = map[string]cmv1.IdentityProviderType{
+	GithubType:   cmv1.IdentityProviderTypeGithub,
+	GitlabType:   cmv1.IdentityProviderTypeGitlab,
+	GoogleType:   cmv1.IdentityProviderTypeGoogle,
+	HTPasswdType: cmv1.IdentityProviderTypeHtpasswd,
+	LDAPType:     cmv1.IdentityProviderTypeLDAP,
+	OpenIDType:   cmv1.IdentityProviderTypeOpenID,
+}
+
+// secretRE matches the placeholders of secrets, '${env:NAME}' reads the secret from an environment
+// variable and '${file:PATH}' from a file
+var secretRE = regexp.MustCompile(`^\$\{(env|file):([^}]+)\}$`)
+
+var nonAlphanumericRE = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// Document is a set of identity providers that can be exported from a cluster and created on others
+type Document struct {
+	IdentityProviders []*IdentityProvider `json:"identity_providers"`
+}
+
+// IdentityProvider is an identity provider of a document, only the section of its type is set
+type IdentityProvider struct {
+	Name          string    `json:"name"`
+	Type          string    `json:"type"`
+	MappingMethod string    `json:"mapping_method,omitempty"`
+	Github        *Github   `json:"github,omitempty"`
+	Gitlab        *Gitlab   `json:"gitlab,omitempty"`
+	Google        *Google   `json:"google,omitempty"`
+	HTPasswd      *HTPasswd `json:"htpasswd,omitempty"`
+	LDAP          *LDAP     `json:"ldap,omitempty"`
+	OpenID        *OpenID   `json:"open_id,omitempty"`
+}
+
+type Github struct {
+	ClientID      string   `json:"client_id"`
+	ClientSecret  string   `json:"client_secret"`
+	Hostname      string   `json:"hostname,omitempty"`
+	Organizations []string `json:"organizations,omitempty"`
+	Teams         []string `json:"teams,omitempty"`
+	CA            string   `json:"ca,omitempty"`
+}
+
+type Gitlab struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	URL          string `json:"url"`
+	CA           string `json:"ca,omitempty"`
+}
+
+type Google struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	HostedDomain string `json:"hosted_domain,omitempty"`
+}
+
+type HTPasswd struct {
+	Users []HTPasswdUser `json:"users"`
+}
+
+type HTPasswdUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type LDAP struct {
+	URL          string         `json:"url"`
+	Insecure     bool           `json:"insecure,omitempty"`
+	BindDN       string         `json:"bind_dn,omitempty"`
+	BindPassword string         `json:"bind_password,omitempty"`
+	CA           string         `json:"ca,omitempty"`
+	Attributes   LDAPAttributes `json:"attributes"`
+}
+
+type LDAPAttributes struct {
+	ID                []string `json:"id"`
+	Email             []string `json:"email,omitempty"`
+	Name              []string `json:"name,omitempty"`
+	PreferredUsername []string `json:"preferred_username,omitempty"`
+}
+
+type OpenID struct {
+	ClientID     string       `json:"client_id"`
+	ClientSecret string       `json:"client_secret"`
+	Issuer       string       `json:"issuer"`
+	CA           string       `json:"ca,omitempty"`
+	ExtraScopes  []string     `json:"extra_scopes,omitempty"`
+	Claims       OpenIDClaims `json:"claims"`
+}
+
+type OpenIDClaims struct {
+	Email             []string `json:"email,omitempty"`
+	Groups            []string `json:"groups,omitempty"`
+	Name              []string `json:"name,omitempty"`
+	PreferredUsername []string `json:"preferred_username,omitempty"`
+}
+
+// NewIdentityProvider converts an identity provider of a cluster, with the users of HTPasswd identity
+// providers. Secrets aren't returned by the API, they are replaced with environment variable placeholders
+// named after the identity provider.
+func NewIdentityProvider(idp *cmv1.IdentityProvider, users []*cmv1.HTPasswdUser) *IdentityProvider {
+	result := &IdentityProvider{
+		Name:          idp.Name(),
+		MappingMethod: string(idp.MappingMethod()),
+	}
+	for name, idpType := range cmv1Types {
+		if idp.Type() == idpType {
+			result.Type = name
+		}
+	}
+	switch idp.Type() {
+	case cmv1.IdentityProviderTypeGithub:
+		github := idp.Github()
+		result.Github = &Github{
+			ClientID:      github.ClientID(),
+			ClientSecret:  placeholder(idp.Name(), "client_secret"),
+			Hostname:      github.Hostname(),
+			Organizations: github.Organizations(),
+			Teams:         github.Teams(),
+			CA:            github.CA(),
+		}
+	case cmv1.IdentityProviderTypeGitlab:
+		gitlab := idp.Gitlab()
+		result.Gitlab = &Gitlab{
+			ClientID:     gitlab.ClientID(),
+			ClientSecret: placeholder(idp.Name(), "client_secret"),
+			URL:          gitlab.URL(),
+			CA:           gitlab.CA(),
+		}
+	case cmv1.IdentityProviderTypeGoogle:
+		google := idp.Google()
+		result.Google = &Google{
+			ClientID:     google.ClientID(),
+			ClientSecret: placeholder(idp.Name(), "client_secret"),
+			HostedDomain: google.HostedDomain(),
+		}
+	case cmv1.IdentityProviderTypeHtpasswd:
+		result.HTPasswd = &HTPasswd{Users: []HTPasswdUser{}}
+		for _, user := range users {
+			result.HTPasswd.Users = append(result.HTPasswd.Users, HTPasswdUser{
+				Username: user.Username(),
+				Password: placeholder(idp.Name(), user.Username()+"_password"),
+			})
+		}
+	case cmv1.IdentityProviderTypeLDAP:
+		ldap := idp.LDAP()
+		result.LDAP = &LDAP{
+			URL:      ldap.URL(),
+			Insecure: ldap.Insecure(),
+			BindDN:   ldap.BindDN(),
+			CA:       ldap.CA(),
+			Attributes: LDAPAttributes{
+				ID:                ldap.Attributes().ID(),
+				Email:             ldap.Attributes().Email(),
+				Name:              ldap.Attributes().Name(),
+				PreferredUsername: ldap.Attributes().PreferredUsername(),
+			},
+		}
+		if ldap.BindDN() != "" {
+			result.LDAP.BindPassword = placeholder(idp.Name(), "bind_password")
+		}
+	case cmv1.IdentityProviderTypeOpenID:
+		openID := idp.OpenID()
+		result.OpenID = &OpenID{
+			ClientID:     openID.ClientID(),
+			ClientSecret: placeholder(idp.Name(), "client_secret"),
+			Issuer:       openID.Issuer(),
+			CA:           openID.CA(),
+			ExtraScopes:  openID.ExtraScopes(),
+			Claims: OpenIDClaims{
+				Email:             openID.Claims().Email(),
+				Groups:            openID.Claims().Groups(),
+				Name:              openID.Claims().Name(),
+				PreferredUsername: openID.Claims().PreferredUsername(),
+			},
+		}
+	}
+	return result
+}
+
+// placeholder is the environment variable placeholder of a secret, for example
+// '${env:GITHUB_1_CLIENT_SECRET}' for the client secret of identity provider 'github-1'
+func placeholder(idpName string, secret string) string {
+	name := nonAlphanumericRE.ReplaceAllString(strings.ToUpper(idpName+"_"+secret), "_")
+	return fmt.Sprintf("${env:%s}", strings.Trim(name, "_"))
+}
+
+// Export returns the YAML document of the identity providers, preceded by a comment about the secret
+// placeholders
+func Export(idps []*IdentityProvider) ([]byte, error) {
+	data, err := yaml.Marshal(&Document{IdentityProviders: idps})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(documentHeader), data...), nil
+}
+
+// LoadDocument reads and validates the identity providers of a file, see ParseDocument
+func LoadDocument(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDocument(data)
+}
+
+// ParseDocument parses and validates a YAML document of identity providers. Secrets aren't resolved,
+// that happens when the identity providers are built.
+func ParseDocument(data []byte) (*Document, error) {
+	document := &Document{}
+	err := yaml.UnmarshalStrict(data, document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse identity providers: %v", err)
+	}
+	if len(document.IdentityProviders) == 0 {
+		return nil, fmt.Errorf("expected at least one identity provider")
+	}
+	names := map[string]bool{}
+	for i, idp := range document.IdentityProviders {
+		if idp == nil {
+			return nil, fmt.Errorf("identity provider %d is empty", i+1)
+		}
+		if names[idp.Name] {
+			return nil, fmt.Errorf("identity provider '%s' is declared more than once", idp.Name)
+		}
+		names[idp.Name] = true
+		err = idp.validate()
+		if err != nil {
+			return nil, fmt.Errorf("identity provider '%s': %v", idp.Name, err)
+		}
+	}
+	return document, nil
+}
+
+func (p *IdentityProvider) validate() error {
+	err := ValidateName(p.Name)
+	if err != nil {
+		return err
+	}
+	if p.MappingMethod != "" && !slices.Contains(MappingMethods, p.MappingMethod) {
+		return fmt.Errorf("invalid mapping method '%s', options are %s", p.MappingMethod,
+			strings.Join(MappingMethods, ","))
+	}
+	sections := map[string]bool{
+		GithubType:   p.Github != nil,
+		GitlabType:   p.Gitlab != nil,
+		GoogleType:   p.Google != nil,
+		HTPasswdType: p.HTPasswd != nil,
+		LDAPType:     p.LDAP != nil,
+		OpenIDType:   p.OpenID != nil,
+	}
+	if _, ok := sections[p.Type]; !ok {
+		return fmt.Errorf("invalid type '%s', options are %s", p.Type, strings.Join(Types, ","))
+	}
+	for idpType, set := range sections {
+		if set && idpType != p.Type {
+			return fmt.Errorf("unexpected '%s' settings for an identity provider of type '%s'", idpType, p.Type)
+		}
+	}
+	if !sections[p.Type] {
+		return fmt.Errorf("expected '%s' settings", p.Type)
+	}
+
+	switch p.Type {
+	case GithubType:
+		return p.Github.validate()
+	case GitlabType:
+		return p.Gitlab.validate()
+	case GoogleType:
+		return validateClient(p.Google.ClientID, p.Google.ClientSecret)
+	case HTPasswdType:
+		return p.HTPasswd.validate()
+	case LDAPType:
+		return p.LDAP.validate()
+	case OpenIDType:
+		return p.OpenID.validate()
+	}
+	return nil
+}
+
+func (g *Github) validate() error {
+	err := validateClient(g.ClientID, g.ClientSecret)
+	if err != nil {
+		return err
+	}
+	if len(g.Organizations) > 0 && len(g.Teams) > 0 {
+		return fmt.Errorf("GitHub IDP only allows either organizations or teams, but not both")
+	}
+	if len(g.Organizations) == 0 && len(g.Teams) == 0 {
+		return fmt.Errorf("GitHub IDP requires either organizations or teams")
+	}
+	if g.Hostname == "" && g.CA != "" {
+		return fmt.Errorf("CA is not expected when not using a hosted instance of Github Enterprise")
+	}
+	return ValidateGithubHostname(g.Hostname)
+}
+
+func (g *Gitlab) validate() error {
+	err := validateClient(g.ClientID, g.ClientSecret)
+	if err != nil {
+		return err
+	}
+	return validateHTTPSURL("GitLab provider URL", g.URL)
+}
+
+func (h *HTPasswd) validate() error {
+	if len(h.Users) == 0 {
+		return fmt.Errorf("expected at least one user")
+	}
+	usernames := map[string]bool{}
+	for _, user := range h.Users {
+		if user.Username == "" || strings.ContainsAny(user.Username, "/:%") {
+			return fmt.Errorf("invalid username '%s': it must not be empty or contain /, :, or %%",
+				user.Username)
+		}
+		if strings.EqualFold(user.Username, ClusterAdminName) {
+			return fmt.Errorf("username '%s' is reserved for the cluster admin", user.Username)
+		}
+		if usernames[user.Username] {
+			return fmt.Errorf("user '%s' is declared more than once", user.Username)
+		}
+		usernames[user.Username] = true
+		if user.Password == "" {
+			return fmt.Errorf("expected a password for user '%s'", user.Username)
+		}
+	}
+	return nil
+}
+
+func (l *LDAP) validate() error {
+	if l.URL == "" {
+		return fmt.Errorf("expected an LDAP URL")
+	}
+	if len(l.Attributes.ID) == 0 {
+		return fmt.Errorf("LDAP ID attributes are required")
+	}
+	if l.BindDN == "" && l.BindPassword != "" {
+		return fmt.Errorf("a bind password is only expected with a bind DN")
+	}
+	return nil
+}
+
+func (o *OpenID) validate() error {
+	err := validateClient(o.ClientID, o.ClientSecret)
+	if err != nil {
+		return err
+	}
+	err = validateHTTPSURL("OpenID issuer URL", o.Issuer)
+	if err != nil {
+		return err
+	}
+	claims := o.Claims
+	if len(claims.Email) == 0 && len(claims.Groups) == 0 && len(claims.Name) == 0 &&
+		len(claims.PreferredUsername) == 0 {
+		return fmt.Errorf("at least one claim is required: [email groups name preferred_username]")
+	}
+	return nil
+}
+
+func validateClient(clientID string, clientSecret string) error {
+	if clientID == "" {
+		return fmt.Errorf("expected a client ID")
+	}
+	if clientSecret == "" {
+		return fmt.Errorf("expected a client secret")
+	}
+	return nil
+}
+
+func validateHTTPSURL(name string, value string) error {
+	parsedURL, err := urlHelper.ParseRequestURI(value)
+	if err != nil {
+		return fmt.Errorf("expected a valid %s: %v", name, err)
+	}
+	if parsedURL.Scheme != helper.ProtocolHttps {
+		return fmt.Errorf("expected %s to use an https:// scheme", name)
+	}
+	if parsedURL.RawQuery != "" || parsedURL.Fragment != "" {
+		return fmt.Errorf("%s must not have query parameters or a fragment", name)
+	}
+	return nil
+}
+
+var nameRE = regexp.MustCompile(`(?i)^[0-9a-z]+([-_][0-9a-z]+)*$`)
+
+// ValidateName checks the name of an identity provider, 'cluster-admin' is reserved for the cluster admin
+func ValidateName(name string) error {
+	if !nameRE.MatchString(name) {
+		return fmt.Errorf("invalid identifier '%s' for 'name'", name)
+	}
+	if strings.EqualFold(name, ClusterAdminName) {
+		return fmt.Errorf("the name \"%s\" is reserved for admin user IDP", ClusterAdminName)
+	}
+	return nil
+}
+
+// ResolveSecret returns the value of a secret placeholder, '${env:NAME}' is replaced with the value of
+// the environment variable and '${file:PATH}' with the content of the file without its trailing newline.
+// Other values are returned unchanged.
+func ResolveSecret(value string) (string, error) {
+	match := secretRE.FindStringSubmatch(value)
+	if match == nil {
+		return value, nil
+	}
+	switch match[1] {
+	case "env":
+		secret, ok := os.LookupEnv(match[2])
+		if !ok || secret == "" {
+			return "", fmt.Errorf("environment variable '%s' isn't set", match[2])
+		}
+		return secret, nil
+	default:
+		data, err := os.ReadFile(match[2])
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret file: %v", err)
+		}
+		secret := strings.TrimRight(string(data), "\r\n")
+		if secret == "" {
+			return "", fmt.Errorf("secret file '%s' is empty", match[2])
+		}
+		return secret, nil
+	}
+}
+
+// Build resolves the secrets of the identity provider and returns the identity provider to create. The
+// passwords of HTPasswd users are validated and hashed.
+func (p *IdentityProvider) Build() (*cmv1.IdentityProvider, error) {
+	mappingMethod := p.MappingMethod
+	if mappingMethod == "" {
+		mappingMethod = defaultMappingMethod
+	}
+	builder := cmv1.NewIdentityProvider().
+		Name(p.Name).
+		Type(cmv1Types[p.Type])
+	if p.Type != HTPasswdType {
+		builder.MappingMethod(cmv1.IdentityProviderMappingMethod(mappingMethod))
+	}
+
+	switch p.Type {
+	case GithubType:
+		clientSecret, err := ResolveSecret(p.Github.ClientSecret)
+		if err != nil {
+			return nil, p.secretError("client secret", err)
+		}
+		github := cmv1.NewGithubIdentityProvider().
+			ClientID(p.Github.ClientID).
+			ClientSecret(clientSecret)
+		if len(p.Github.Organizations) > 0 {
+			github.Organizations(p.Github.Organizations...)
+		}
+		if len(p.Github.Teams) > 0 {
+			github.Teams(p.Github.Teams...)
+		}
+		if p.Github.Hostname != "" {
+			github.Hostname(p.Github.Hostname)
+		}
+		if p.Github.CA != "" {
+			github.CA(p.Github.CA)
+		}
+		builder.Github(github)
+	case GitlabType:
+		clientSecret, err := ResolveSecret(p.Gitlab.ClientSecret)
+		if err != nil {
+			return nil, p.secretError("client secret", err)
+		}
+		gitlab := cmv1.NewGitlabIdentityProvider().
+			ClientID(p.Gitlab.ClientID).
+			ClientSecret(clientSecret).
+			URL(p.Gitlab.URL)
+		if p.Gitlab.CA != "" {
+			gitlab.CA(p.Gitlab.CA)
+		}
+		builder.Gitlab(gitlab)
+	case GoogleType:
+		clientSecret, err := ResolveSecret(p.Google.ClientSecret)
+		if err != nil {
+			return nil, p.secretError("client secret", err)
+		}
+		google := cmv1.NewGoogleIdentityProvider().
+			ClientID(p.Google.ClientID).
+			ClientSecret(clientSecret)
+		if p.Google.HostedDomain != "" {
+			google.HostedDomain(p.Google.HostedDomain)
+		}
+		builder.Google(google)
+	case HTPasswdType:
+		users := []*cmv1.HTPasswdUserBuilder{}
+		for _, user := range p.HTPasswd.Users {
+			password, err := ResolveSecret(user.Password)
+			if err != nil {
+				return nil, p.secretError(fmt.Sprintf("password of user '%s'", user.Username), err)
+			}
+			err = passwordValidator.PasswordValidator(password)
+			if err != nil {
+				return nil, fmt.Errorf("invalid password of user '%s' of identity provider '%s': %v",
+					user.Username, p.Name, err)
+			}
+			hashedPassword, err := idputils.GenerateHTPasswdCompatibleHash(password)
+			if err != nil {
+				return nil, fmt.Errorf("failed to hash the password of user '%s': %v", user.Username, err)
+			}
+			users = append(users, cmv1.NewHTPasswdUser().Username(user.Username).HashedPassword(hashedPassword))
+		}
+		builder.Htpasswd(cmv1.NewHTPasswdIdentityProvider().Users(cmv1.NewHTPasswdUserList().Items(users...)))
+	case LDAPType:
+		attributes := cmv1.NewLDAPAttributes().ID(p.LDAP.Attributes.ID...)
+		if len(p.LDAP.Attributes.Email) > 0 {
+			attributes.Email(p.LDAP.Attributes.Email...)
+		}
+		if len(p.LDAP.Attributes.Name) > 0 {
+			attributes.Name(p.LDAP.Attributes.Name...)
+		}
+		if len(p.LDAP.Attributes.PreferredUsername) > 0 {
+			attributes.PreferredUsername(p.LDAP.Attributes.PreferredUsername...)
+		}
+		ldap := cmv1.NewLDAPIdentityProvider().
+			URL(p.LDAP.URL).
+			Insecure(p.LDAP.Insecure).
+			Attributes(attributes)
+		if p.LDAP.BindDN != "" {
+			bindPassword, err := ResolveSecret(p.LDAP.BindPassword)
+			if err != nil {
+				return nil, p.secretError("bind password", err)
+			}
+			ldap.BindDN(p.LDAP.BindDN).BindPassword(bindPassword)
+		}
+		if p.LDAP.CA != "" {
+			ldap.CA(p.LDAP.CA)
+		}
+		builder.LDAP(ldap)
+	case OpenIDType:
+		clientSecret, err := ResolveSecret(p.OpenID.ClientSecret)
+		if err != nil {
+			return nil, p.secretError("client secret", err)
+		}
+		claims := cmv1.NewOpenIDClaims()
+		if len(p.OpenID.Claims.Email) > 0 {
+			claims.Email(p.OpenID.Claims.Email...)
+		}
+		if len(p.OpenID.Claims.Groups) > 0 {
+			claims.Groups(p.OpenID.Claims.Groups...)
+		}
+		if len(p.OpenID.Claims.Name) > 0 {
+			claims.Name(p.OpenID.Claims.Name...)
+		}
+		if len(p.OpenID.Claims.PreferredUsername) > 0 {
+			claims.PreferredUsername(p.OpenID.Claims.PreferredUsername...)
+		}
+		openID := cmv1.NewOpenIDIdentityProvider().
+			ClientID(p.OpenID.ClientID).
+			ClientSecret(clientSecret).
+			Issuer(p.OpenID.Issuer).
+			Claims(claims)
+		if len(p.OpenID.ExtraScopes) > 0 {
+			openID.ExtraScopes(p.OpenID.ExtraScopes...)
+		}
+		if p.OpenID.CA != "" {
+			openID.CA(p.OpenID.CA)
+		}
+		builder.OpenID(openID)
+	}
+	return builder.Build()
+}
+
+func (p *IdentityProvider) secretError(secret string, err error) error {
+	return fmt.Errorf("failed to resolve the %s of identity provider '%s': %v", secret, p.Name, err)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idp
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const exportedDocument = `# Secrets are exported as placeholders: set the environment variables, or replace a
+# placeholder with ${file:<path>} to read the secret from a file, before running
+# 'rosa create idps --from-file'.
+identity_providers:
+- github:
+    client_id: github-client
+    client_secret: ${env:GITHUB_1_CLIENT_SECRET}
+    organizations:
+    - my-org
+  mapping_method: claim
+  name: github-1
+  type: github
+- mapping_method: lookup
+  name: corp-sso
+  open_id:
+    claims:
+      email:
+      - email
+      groups:
+      - groups
+      - roles
+      preferred_username:
+      - preferred_username
+    client_id: sso-client
+    client_secret: ${env:CORP_SSO_CLIENT_SECRET}
+    extra_scopes:
+    - profile
+    issuer: https://sso.example.com
+  type: openid
+- htpasswd:
+    users:
+    - password: ${env:HTPASSWD_1_JOHN_DOE_PASSWORD}
+      username: john.doe
+  mapping_method: claim
+  name: htpasswd-1
+  type: htpasswd
+`
+
+var _ = Describe("Identity provider documents", func() {
+	Context("Export", func() {
+		It("Exports the identity providers with secret placeholders and group claims", func() {
+			github, err := cmv1.NewIdentityProvider().Name("github-1").Type(cmv1.IdentityProviderTypeGithub).
+				MappingMethod(cmv1.IdentityProviderMappingMethodClaim).
+				Github(cmv1.NewGithubIdentityProvider().ClientID("github-client").Organizations("my-org")).Build()
+			Expect(err).NotTo(HaveOccurred())
+			openID, err := cmv1.NewIdentityProvider().Name("corp-sso").Type(cmv1.IdentityProviderTypeOpenID).
+				MappingMethod(cmv1.IdentityProviderMappingMethodLookup).
+				OpenID(cmv1.NewOpenIDIdentityProvider().ClientID("sso-client").Issuer("https://sso.example.com").
+					ExtraScopes("profile").
+					Claims(cmv1.NewOpenIDClaims().Email("email").Groups("groups", "roles").
+						PreferredUsername("preferred_username"))).Build()
+			Expect(err).NotTo(HaveOccurred())
+			htpasswd, err := cmv1.NewIdentityProvider().Name("htpasswd-1").Type(cmv1.IdentityProviderTypeHtpasswd).
+				MappingMethod(cmv1.IdentityProviderMappingMethodClaim).Build()
+			Expect(err).NotTo(HaveOccurred())
+			user, err := cmv1.NewHTPasswdUser().Username("john.doe").Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			data, err := Export([]*IdentityProvider{
+				NewIdentityProvider(github, nil),
+				NewIdentityProvider(openID, nil),
+				NewIdentityProvider(htpasswd, []*cmv1.HTPasswdUser{user}),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(exportedDocument))
+		})
+
+		It("Exports a bind password placeholder only for LDAP providers with a bind DN", func() {
+			ldap, err := cmv1.NewIdentityProvider().Name("ldap-1").Type(cmv1.IdentityProviderTypeLDAP).
+				LDAP(cmv1.NewLDAPIdentityProvider().URL("ldap://ldap.example.com/ou=users?uid").
+					Attributes(cmv1.NewLDAPAttributes().ID("dn"))).Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(NewIdentityProvider(ldap, nil).LDAP.BindPassword).To(BeEmpty())
+
+			ldap, err = cmv1.NewIdentityProvider().Name("ldap-1").Type(cmv1.IdentityProviderTypeLDAP).
+				LDAP(cmv1.NewLDAPIdentityProvider().URL("ldap://ldap.example.com/ou=users?uid").
+					BindDN("cn=admin").Attributes(cmv1.NewLDAPAttributes().ID("dn"))).Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(NewIdentityProvider(ldap, nil).LDAP.BindPassword).To(Equal("${env:LDAP_1_BIND_PASSWORD}"))
+		})
+	})
+
+	Context("Parse", func() {
+		It("Parses an exported document", func() {
+			document, err := ParseDocument([]byte(exportedDocument))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(document.IdentityProviders).To(HaveLen(3))
+			Expect(document.IdentityProviders[1].OpenID.Claims.Groups).To(Equal([]string{"groups", "roles"}))
+		})
+
+		DescribeTable("Rejects invalid documents",
+			func(document string, expected string) {
+				_, err := ParseDocument([]byte(document))
+				Expect(err).To(MatchError(ContainSubstring(expected)))
+			},
+			Entry("no identity providers", "identity_providers: []", "expected at least one identity provider"),
+			Entry("unknown field", "identity_providers:\n- name: a\n  typ: github", `unknown field "typ"`),
+			Entry("duplicate name", "identity_providers:\n- name: a\n  type: google\n  google: "+
+				"{client_id: a, client_secret: b}\n- name: a\n  type: google\n  google: {client_id: a, "+
+				"client_secret: b}", "identity provider 'a' is declared more than once"),
+			Entry("reserved name", "identity_providers:\n- name: cluster-admin\n  type: htpasswd",
+				"reserved for admin user IDP"),
+			Entry("invalid type", "identity_providers:\n- name: a\n  type: saml",
+				"invalid type 'saml', options are github,gitlab,google,htpasswd,ldap,openid"),
+			Entry("missing settings", "identity_providers:\n- name: a\n  type: google",
+				"identity provider 'a': expected 'google' settings"),
+			Entry("settings of another type", "identity_providers:\n- name: a\n  type: google\n  google: "+
+				"{client_id: a, client_secret: b}\n  gitlab: {url: https://gitlab.com}",
+				"unexpected 'gitlab' settings for an identity provider of type 'google'"),
+			Entry("invalid mapping method", "identity_providers:\n- name: a\n  type: google\n  "+
+				"mapping_method: map\n  google: {client_id: a, client_secret: b}", "invalid mapping method 'map'"),
+			Entry("missing client secret", "identity_providers:\n- name: a\n  type: google\n  google: "+
+				"{client_id: a}", "expected a client secret"),
+			Entry("GitHub organizations and teams", "identity_providers:\n- name: a\n  type: github\n  github: "+
+				"{client_id: a, client_secret: b, organizations: [o], teams: [o/t]}",
+				"either organizations or teams, but not both"),
+			Entry("GitHub hostname of github.com", "identity_providers:\n- name: a\n  type: github\n  github: "+
+				"{client_id: a, client_secret: b, organizations: [o], hostname: api.github.com}",
+				"'api.github.com' hostname cannot be equal to [*.]github.com"),
+			Entry("OpenID issuer without https", "identity_providers:\n- name: a\n  type: openid\n  open_id: "+
+				"{client_id: a, client_secret: b, issuer: 'http://sso', claims: {groups: [groups]}}",
+				"expected OpenID issuer URL to use an https:// scheme"),
+			Entry("OpenID without claims", "identity_providers:\n- name: a\n  type: openid\n  open_id: "+
+				"{client_id: a, client_secret: b, issuer: 'https://sso'}", "at least one claim is required"),
+			Entry("HTPasswd without users", "identity_providers:\n- name: a\n  type: htpasswd\n  htpasswd: "+
+				"{users: []}", "expected at least one user"),
+			Entry("LDAP without ID attributes", "identity_providers:\n- name: a\n  type: ldap\n  ldap: "+
+				"{url: 'ldap://ldap'}", "LDAP ID attributes are required"),
+		)
+	})
+
+	Context("Secrets", func() {
+		It("Resolves secrets from environment variables and files", func() {
+			GinkgoT().Setenv("IDP_TEST_SECRET", "from-env")
+			path := filepath.Join(GinkgoT().TempDir(), "secret")
+			Expect(os.WriteFile(path, []byte("from-file\n"), 0600)).To(Succeed())
+
+			Expect(ResolveSecret("${env:IDP_TEST_SECRET}")).To(Equal("from-env"))
+			Expect(ResolveSecret("${file:" + path + "}")).To(Equal("from-file"))
+			Expect(ResolveSecret("literal")).To(Equal("literal"))
+		})
+
+		It("Fails on missing secrets", func() {
+			_, err := ResolveSecret("${env:IDP_TEST_MISSING_SECRET}")
+			Expect(err).To(MatchError("environment variable 'IDP_TEST_MISSING_SECRET' isn't set"))
+			_, err = ResolveSecret("${file:/nonexistent/secret}")
+			Expect(err).To(MatchError(ContainSubstring("failed to read secret file")))
+		})
+
+		It("Builds identity providers with resolved secrets", func() {
+			GinkgoT().Setenv("GITHUB_1_CLIENT_SECRET", "github-secret")
+			GinkgoT().Setenv("CORP_SSO_CLIENT_SECRET", "sso-secret")
+			GinkgoT().Setenv("HTPASSWD_1_JOHN_DOE_PASSWORD", "Password-1234567")
+			document, err := ParseDocument([]byte(exportedDocument))
+			Expect(err).NotTo(HaveOccurred())
+
+			github, err := document.IdentityProviders[0].Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(github.Type()).To(Equal(cmv1.IdentityProviderTypeGithub))
+			Expect(github.Github().ClientSecret()).To(Equal("github-secret"))
+			Expect(github.Github().Organizations()).To(Equal([]string{"my-org"}))
+
+			openID, err := document.IdentityProviders[1].Build()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(openID.MappingMethod()).To(Equal(cmv1.IdentityProviderMappingMethodLookup))
+			Expect(openID.OpenID().ClientSecret()).To(Equal("sso-secret"))
+			Expect(openID.OpenID().Claims().Groups()).To(Equal([]string{"groups", "roles"}))
+
+			htpasswd, err := document.IdentityProviders[2].Build()
+			Expect(err).NotTo(HaveOccurred())
+			users := htpasswd.Htpasswd().Users().Slice()
+			Expect(users).To(HaveLen(1))
+			Expect(users[0].Username()).To(Equal("john.doe"))
+			Expect(users[0].HashedPassword()).NotTo(Equal("Password-1234567"))
+		})
+
+		It("Fails to build identity providers with unresolved secrets", func() {
+			document, err := ParseDocument([]byte(exportedDocument))
+			Expect(err).NotTo(HaveOccurred())
+			os.Unsetenv("GITHUB_1_CLIENT_SECRET")
+
+			_, err = document.IdentityProviders[0].Build()
+			Expect(err).To(MatchError("failed to resolve the client secret of identity provider 'github-1': " +
+				"environment variable 'GITHUB_1_CLIENT_SECRET' isn't set"))
+		})
+	})
+})
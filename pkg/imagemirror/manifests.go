@@ -0,0 +1,217 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	DigestType = "digest"
+	TagType    = "tag"
+
+	ImageDigestMirrorSetKind     = "ImageDigestMirrorSet"
+	ImageTagMirrorSetKind        = "ImageTagMirrorSet"
+	ImageContentSourcePolicyKind = "ImageContentSourcePolicy"
+	configAPIVersion             = "config.openshift.io/v1"
+	documentSeparator            = "---\n"
+)
+
+var documentSeparatorRE = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// Mirror is an image mirror of a cluster as declared in a manifest
+type Mirror struct {
+	Type    string
+	Source  string
+	Mirrors []string
+}
+
+type mirrorEntry struct {
+	Source  string   `json:"source"`
+	Mirrors []string `json:"mirrors,omitempty"`
+}
+
+type manifest struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		ImageDigestMirrors      []mirrorEntry `json:"imageDigestMirrors,omitempty"`
+		ImageTagMirrors         []mirrorEntry `json:"imageTagMirrors,omitempty"`
+		RepositoryDigestMirrors []mirrorEntry `json:"repositoryDigestMirrors,omitempty"`
+	} `json:"spec"`
+}
+
+// LoadManifests reads the image mirrors of a file with ImageDigestMirrorSet, ImageTagMirrorSet or
+// ImageContentSourcePolicy manifests, see ParseManifests
+func LoadManifests(path string) ([]*Mirror, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseManifests(data)
+}
+
+// ParseManifests parses the image mirrors of a stream of YAML documents with ImageDigestMirrorSet,
+// ImageTagMirrorSet or ImageContentSourcePolicy manifests as generated by oc-mirror. The mirrors of
+// ImageDigestMirrorSets and ImageContentSourcePolicies are digest mirrors and the mirrors of
+// ImageTagMirrorSets are tag mirrors. Entries of the same type and source are merged, keeping the order of
+// the mirrors.
+func ParseManifests(data []byte) ([]*Mirror, error) {
+	result := []*Mirror{}
+	for i, document := range documentSeparatorRE.Split(string(data), -1) {
+		if strings.TrimSpace(document) == "" {
+			continue
+		}
+		object := &manifest{}
+		err := yaml.Unmarshal([]byte(document), object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse document %d: %v", i+1, err)
+		}
+		if object.Kind == "" {
+			// Documents that only have comments
+			if isEmptyDocument(document) {
+				continue
+			}
+			return nil, fmt.Errorf("document %d has no kind", i+1)
+		}
+		var entries []mirrorEntry
+		var mirrorType string
+		switch object.Kind {
+		case ImageDigestMirrorSetKind:
+			entries, mirrorType = object.Spec.ImageDigestMirrors, DigestType
+		case ImageTagMirrorSetKind:
+			entries, mirrorType = object.Spec.ImageTagMirrors, TagType
+		case ImageContentSourcePolicyKind:
+			entries, mirrorType = object.Spec.RepositoryDigestMirrors, DigestType
+		default:
+			return nil, fmt.Errorf("document %d has kind '%s', expected one of '%s', '%s' or '%s'", i+1,
+				object.Kind, ImageDigestMirrorSetKind, ImageTagMirrorSetKind, ImageContentSourcePolicyKind)
+		}
+		for _, entry := range entries {
+			source := strings.TrimSpace(entry.Source)
+			if source == "" {
+				return nil, fmt.Errorf("%s '%s' has a mirror without source", object.Kind, object.Metadata.Name)
+			}
+			if len(entry.Mirrors) == 0 {
+				return nil, fmt.Errorf("%s '%s' has no mirrors for source '%s'", object.Kind,
+					object.Metadata.Name, source)
+			}
+			mirror := findMirror(result, mirrorType, source)
+			if mirror == nil {
+				mirror = &Mirror{Type: mirrorType, Source: source}
+				result = append(result, mirror)
+			}
+			for _, m := range entry.Mirrors {
+				m = strings.TrimSpace(m)
+				if m != "" && !slices.Contains(mirror.Mirrors, m) {
+					mirror.Mirrors = append(mirror.Mirrors, m)
+				}
+			}
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("expected at least one image mirror")
+	}
+	return result, nil
+}
+
+// Validate checks that all the image mirrors can be created on a cluster, where digest is the only
+// supported type, so that a file is rejected before any change is made to the cluster
+func Validate(mirrors []*Mirror) error {
+	sources := []string{}
+	for _, mirror := range mirrors {
+		if mirror.Type != DigestType {
+			sources = append(sources, mirror.Source)
+		}
+	}
+	if len(sources) > 0 {
+		return fmt.Errorf("only digest image mirrors are supported, the %s mirrors of sources '%s' "+
+			"can't be created", TagType, strings.Join(sources, "', '"))
+	}
+	return nil
+}
+
+func isEmptyDocument(document string) bool {
+	for _, line := range strings.Split(document, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+func findMirror(mirrors []*Mirror, mirrorType string, source string) *Mirror {
+	for _, mirror := range mirrors {
+		if mirror.Type == mirrorType && mirror.Source == source {
+			return mirror
+		}
+	}
+	return nil
+}
+
+// Manifests exports the image mirrors of a cluster as an ImageDigestMirrorSet with the digest mirrors,
+// followed by an ImageTagMirrorSet if there are tag mirrors
+func Manifests(name string, imageMirrors []*cmv1.ImageMirror) ([]byte, error) {
+	digestSet := &manifest{APIVersion: configAPIVersion, Kind: ImageDigestMirrorSetKind}
+	digestSet.Metadata.Name = name
+	tagSet := &manifest{APIVersion: configAPIVersion, Kind: ImageTagMirrorSetKind}
+	tagSet.Metadata.Name = name
+	for _, imageMirror := range imageMirrors {
+		entry := mirrorEntry{Source: imageMirror.Source(), Mirrors: imageMirror.Mirrors()}
+		if mirrorType(imageMirror) == TagType {
+			tagSet.Spec.ImageTagMirrors = append(tagSet.Spec.ImageTagMirrors, entry)
+		} else {
+			digestSet.Spec.ImageDigestMirrors = append(digestSet.Spec.ImageDigestMirrors, entry)
+		}
+	}
+
+	var b bytes.Buffer
+	for _, object := range []*manifest{digestSet, tagSet} {
+		if object.Kind == ImageTagMirrorSetKind && len(object.Spec.ImageTagMirrors) == 0 {
+			continue
+		}
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		if b.Len() > 0 {
+			b.WriteString(documentSeparator)
+		}
+		b.Write(data)
+	}
+	return b.Bytes(), nil
+}
+
+// mirrorType is the type of an image mirror of a cluster, digest when the type isn't set
+func mirrorType(imageMirror *cmv1.ImageMirror) string {
+	if imageMirror.Type() == "" {
+		return DigestType
+	}
+	return imageMirror.Type()
+}
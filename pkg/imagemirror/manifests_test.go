@@ -0,0 +1,153 @@
+package imagemirror
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("ParseManifests", func() {
+	It("Parses the mirrors of digest, tag and content source manifests", func() {
+		mirrors, err := ParseManifests([]byte(`# Generated by oc-mirror
+---
+apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+metadata:
+  name: idms-release-0
+spec:
+  imageDigestMirrors:
+  - source: quay.io/openshift-release-dev/ocp-release
+    mirrors:
+    - mirror.corp.com/openshift-release-dev/ocp-release
+  - source: quay.io/openshift-release-dev/ocp-v4.0-art-dev
+    mirrors:
+    - mirror.corp.com/openshift-release-dev/ocp-v4.0-art-dev
+---
+apiVersion: config.openshift.io/v1
+kind: ImageTagMirrorSet
+metadata:
+  name: itms-generic-0
+spec:
+  imageTagMirrors:
+  - source: docker.io/library
+    mirrors:
+    - mirror.corp.com/library
+---
+apiVersion: operator.openshift.io/v1alpha1
+kind: ImageContentSourcePolicy
+metadata:
+  name: operator-0
+spec:
+  repositoryDigestMirrors:
+  - source: registry.redhat.io/rhel9
+    mirrors:
+    - mirror.corp.com/rhel9
+  - source: quay.io/openshift-release-dev/ocp-release
+    mirrors:
+    - backup.corp.com/openshift-release-dev/ocp-release
+    - mirror.corp.com/openshift-release-dev/ocp-release
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mirrors).To(Equal([]*Mirror{
+			{
+				Type:   DigestType,
+				Source: "quay.io/openshift-release-dev/ocp-release",
+				Mirrors: []string{
+					"mirror.corp.com/openshift-release-dev/ocp-release",
+					"backup.corp.com/openshift-release-dev/ocp-release",
+				},
+			},
+			{
+				Type:    DigestType,
+				Source:  "quay.io/openshift-release-dev/ocp-v4.0-art-dev",
+				Mirrors: []string{"mirror.corp.com/openshift-release-dev/ocp-v4.0-art-dev"},
+			},
+			{Type: TagType, Source: "docker.io/library", Mirrors: []string{"mirror.corp.com/library"}},
+			{Type: DigestType, Source: "registry.redhat.io/rhel9", Mirrors: []string{"mirror.corp.com/rhel9"}},
+		}))
+	})
+
+	It("Fails for other kinds", func() {
+		_, err := ParseManifests([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: test\n"))
+		Expect(err).To(MatchError("document 1 has kind 'ConfigMap', expected one of 'ImageDigestMirrorSet', " +
+			"'ImageTagMirrorSet' or 'ImageContentSourcePolicy'"))
+	})
+
+	It("Fails for a source without mirrors", func() {
+		_, err := ParseManifests([]byte(`kind: ImageDigestMirrorSet
+metadata:
+  name: test
+spec:
+  imageDigestMirrors:
+  - source: quay.io/openshift
+`))
+		Expect(err).To(MatchError("ImageDigestMirrorSet 'test' has no mirrors for source 'quay.io/openshift'"))
+	})
+
+	It("Fails for manifests without mirrors", func() {
+		_, err := ParseManifests([]byte("kind: ImageDigestMirrorSet\nmetadata:\n  name: test\n"))
+		Expect(err).To(MatchError("expected at least one image mirror"))
+	})
+})
+
+var _ = Describe("Validate", func() {
+	It("Accepts digest mirrors", func() {
+		Expect(Validate([]*Mirror{
+			{Type: DigestType, Source: "quay.io/openshift", Mirrors: []string{"mirror.corp.com/openshift"}},
+		})).To(Succeed())
+	})
+
+	It("Rejects tag mirrors", func() {
+		err := Validate([]*Mirror{
+			{Type: DigestType, Source: "quay.io/openshift", Mirrors: []string{"mirror.corp.com/openshift"}},
+			{Type: TagType, Source: "docker.io/library", Mirrors: []string{"mirror.corp.com/library"}},
+		})
+		Expect(err).To(MatchError("only digest image mirrors are supported, the tag mirrors of sources " +
+			"'docker.io/library' can't be created"))
+	})
+})
+
+var _ = Describe("Manifests", func() {
+	It("Exports digest and tag mirrors that parse back to the same mirrors", func() {
+		digest, err := cmv1.NewImageMirror().ID("a").Type(DigestType).Source("quay.io/openshift").
+			Mirrors("mirror.corp.com/openshift").Build()
+		Expect(err).NotTo(HaveOccurred())
+		tag, err := cmv1.NewImageMirror().ID("b").Type(TagType).Source("docker.io/library").
+			Mirrors("mirror.corp.com/library", "backup.corp.com/library").Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := Manifests("mycluster", []*cmv1.ImageMirror{digest, tag})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+metadata:
+  name: mycluster
+spec:
+  imageDigestMirrors:
+  - mirrors:
+    - mirror.corp.com/openshift
+    source: quay.io/openshift
+---
+apiVersion: config.openshift.io/v1
+kind: ImageTagMirrorSet
+metadata:
+  name: mycluster
+spec:
+  imageTagMirrors:
+  - mirrors:
+    - mirror.corp.com/library
+    - backup.corp.com/library
+    source: docker.io/library
+`))
+		mirrors, err := ParseManifests(data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mirrors).To(Equal([]*Mirror{
+			{Type: DigestType, Source: "quay.io/openshift", Mirrors: []string{"mirror.corp.com/openshift"}},
+			{
+				Type:    TagType,
+				Source:  "docker.io/library",
+				Mirrors: []string{"mirror.corp.com/library", "backup.corp.com/library"},
+			},
+		}))
+	})
+})
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imagemirror
+
+import (
+	"slices"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// Update is an image mirror of a cluster whose mirrors differ from the manifests
+type Update struct {
+	ID      string
+	Mirror  *Mirror
+	Current []string
+}
+
+// Plan is the changes that make the image mirrors of a cluster match the manifests
+type Plan struct {
+	Create    []*Mirror
+	Update    []*Update
+	Delete    []*cmv1.ImageMirror
+	Unchanged int
+}
+
+// Empty returns true when the image mirrors of the cluster already match the manifests
+func (p *Plan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// Diff compares the image mirrors of a cluster with the desired ones. Image mirrors are matched by type and
+// source, the order of the mirrors is significant since they are tried in order.
+func Diff(existing []*cmv1.ImageMirror, desired []*Mirror) *Plan {
+	plan := &Plan{}
+	matched := map[string]bool{}
+	for _, mirror := range desired {
+		var current *cmv1.ImageMirror
+		for _, imageMirror := range existing {
+			if !matched[imageMirror.ID()] && mirrorType(imageMirror) == mirror.Type &&
+				imageMirror.Source() == mirror.Source {
+				current = imageMirror
+				break
+			}
+		}
+		if current == nil {
+			plan.Create = append(plan.Create, mirror)
+			continue
+		}
+		matched[current.ID()] = true
+		if slices.Equal(current.Mirrors(), mirror.Mirrors) {
+			plan.Unchanged++
+			continue
+		}
+		plan.Update = append(plan.Update, &Update{
+			ID:      current.ID(),
+			Mirror:  mirror,
+			Current: current.Mirrors(),
+		})
+	}
+	for _, imageMirror := range existing {
+		if !matched[imageMirror.ID()] {
+			plan.Delete = append(plan.Delete, imageMirror)
+		}
+	}
+	return plan
+}
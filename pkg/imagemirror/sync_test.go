@@ -0,0 +1,47 @@
+package imagemirror
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func imageMirror(id string, mirrorType string, source string, mirrors ...string) *cmv1.ImageMirror {
+	result, err := cmv1.NewImageMirror().ID(id).Type(mirrorType).Source(source).Mirrors(mirrors...).Build()
+	Expect(err).NotTo(HaveOccurred())
+	return result
+}
+
+var _ = Describe("Diff", func() {
+	It("Creates, updates and deletes image mirrors by type and source", func() {
+		existing := []*cmv1.ImageMirror{
+			imageMirror("unchanged", DigestType, "quay.io/openshift", "mirror.corp.com/openshift"),
+			imageMirror("reordered", "", "registry.redhat.io", "a.corp.com", "b.corp.com"),
+			imageMirror("tag", TagType, "docker.io/library", "mirror.corp.com/library"),
+		}
+		desired := []*Mirror{
+			{Type: DigestType, Source: "quay.io/openshift", Mirrors: []string{"mirror.corp.com/openshift"}},
+			{Type: DigestType, Source: "registry.redhat.io", Mirrors: []string{"b.corp.com", "a.corp.com"}},
+			{Type: DigestType, Source: "docker.io/library", Mirrors: []string{"mirror.corp.com/library"}},
+		}
+
+		plan := Diff(existing, desired)
+		Expect(plan.Empty()).To(BeFalse())
+		Expect(plan.Unchanged).To(Equal(1))
+		Expect(plan.Create).To(Equal([]*Mirror{desired[2]}))
+		Expect(plan.Update).To(Equal([]*Update{
+			{ID: "reordered", Mirror: desired[1], Current: []string{"a.corp.com", "b.corp.com"}},
+		}))
+		Expect(plan.Delete).To(Equal([]*cmv1.ImageMirror{existing[2]}))
+	})
+
+	It("Is empty when the image mirrors match", func() {
+		existing := []*cmv1.ImageMirror{
+			imageMirror("a", DigestType, "quay.io/openshift", "mirror.corp.com/openshift"),
+		}
+		plan := Diff(existing, []*Mirror{
+			{Type: DigestType, Source: "quay.io/openshift", Mirrors: []string{"mirror.corp.com/openshift"}},
+		})
+		Expect(plan.Empty()).To(BeTrue())
+	})
+})
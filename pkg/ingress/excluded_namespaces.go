@@ -1,7 +1,10 @@
 package ingress
 
 import (
+	"fmt"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 func GetExcludedNamespaces(excludedNamespaces string) []string {
@@ -14,3 +17,20 @@ func GetExcludedNamespaces(excludedNamespaces string) []string {
 	}
 	return sliceExcludedNamespaces
 }
+
+// ValidateExcludedNamespaces checks that a comma-separated list of excluded namespaces only holds valid
+// namespace names
+func ValidateExcludedNamespaces(excludedNamespaces string) error {
+	if strings.TrimSpace(excludedNamespaces) == "" {
+		return nil
+	}
+	for _, namespace := range GetExcludedNamespaces(excludedNamespaces) {
+		if namespace == "" {
+			return fmt.Errorf("Excluded namespace list '%s' has an empty entry", excludedNamespaces)
+		}
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("Invalid excluded namespace '%s': %s", namespace, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
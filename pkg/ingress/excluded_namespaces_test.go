@@ -25,3 +25,19 @@ var _ = Describe("GetExcludedNamespaces", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidateExcludedNamespaces", func() {
+	It("accepts valid namespaces", func() {
+		Expect(ValidateExcludedNamespaces("")).To(Succeed())
+		Expect(ValidateExcludedNamespaces("stage, dev-1")).To(Succeed())
+	})
+	It("rejects an empty entry", func() {
+		err := ValidateExcludedNamespaces("stage,,dev")
+		Expect(err).To(MatchError("Excluded namespace list 'stage,,dev' has an empty entry"))
+	})
+	It("rejects an invalid namespace", func() {
+		err := ValidateExcludedNamespaces("stage,Dev_1")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Invalid excluded namespace 'Dev_1'"))
+	})
+})
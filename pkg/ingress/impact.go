@@ -0,0 +1,123 @@
+package ingress
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/helper/diff"
+)
+
+// EditImpact is the change an edit makes to an ingress and the warnings about the routes it exposes
+type EditImpact struct {
+	Ingress  string       `json:"ingress"`
+	Default  bool         `json:"default"`
+	Changes  diff.Changes `json:"changes"`
+	Warnings []string     `json:"warnings,omitempty"`
+}
+
+// NewEditImpact compares an ingress with the sparse update sent by an edit, attributes that aren't set
+// in the update, and component routes that it doesn't list, are left unchanged
+func NewEditImpact(current *cmv1.Ingress, update *cmv1.Ingress) *EditImpact {
+	impact := &EditImpact{
+		Ingress: current.ID(),
+		Default: current.Default(),
+	}
+
+	currentPrivate := current.Listening() == cmv1.ListeningMethodInternal
+	if listening, ok := update.GetListening(); ok {
+		private := listening == cmv1.ListeningMethodInternal
+		impact.Changes.Add("Private", strconv.FormatBool(currentPrivate), strconv.FormatBool(private))
+		if impact.Default && private != currentPrivate {
+			if private {
+				impact.Warnings = append(impact.Warnings, "The default router will only be reachable from "+
+					"within the VPC, application routes will no longer be exposed to the internet")
+			} else {
+				impact.Warnings = append(impact.Warnings, "The default router will be exposed to the "+
+					"internet, application routes will be reachable from outside the VPC")
+			}
+		}
+	}
+	if lbType, ok := update.GetLoadBalancerType(); ok {
+		impact.Changes.Add("LB-Type", string(current.LoadBalancerType()), string(lbType))
+		if impact.Default && lbType != current.LoadBalancerType() {
+			impact.Warnings = append(impact.Warnings, "The load balancer of the default router will be "+
+				"replaced, application routes are unreachable until DNS points to the new load balancer")
+		}
+	}
+	if policy, ok := update.GetRouteWildcardPolicy(); ok {
+		impact.Changes.Add("Wildcard Policy", string(current.RouteWildcardPolicy()), string(policy))
+	}
+	if policy, ok := update.GetRouteNamespaceOwnershipPolicy(); ok {
+		impact.Changes.Add("Namespace Ownership Policy", string(current.RouteNamespaceOwnershipPolicy()),
+			string(policy))
+	}
+	if routeSelectors, ok := update.GetRouteSelectors(); ok {
+		impact.Changes.Add("Route Selectors", formatRouteSelectors(current.RouteSelectors()),
+			formatRouteSelectors(routeSelectors))
+		if impact.Default && !sameRouteSelectors(current.RouteSelectors(), routeSelectors) {
+			impact.Warnings = append(impact.Warnings, "The route selectors of the default router change, "+
+				"routes are exposed or hidden by it according to their labels")
+		}
+	}
+	if excludedNamespaces, ok := update.GetExcludedNamespaces(); ok {
+		impact.Changes.Add("Excluded Namespaces", helper.SliceToSortedString(current.ExcludedNamespaces()),
+			helper.SliceToSortedString(excludedNamespaces))
+		if impact.Default {
+			added := []string{}
+			for _, namespace := range excludedNamespaces {
+				if !helper.Contains(current.ExcludedNamespaces(), namespace) {
+					added = append(added, namespace)
+				}
+			}
+			if len(added) > 0 {
+				impact.Warnings = append(impact.Warnings, fmt.Sprintf("Routes in namespaces %s will no "+
+					"longer be exposed by the default router", helper.SliceToSortedString(added)))
+			}
+		}
+	}
+
+	componentRoutes := update.ComponentRoutes()
+	components := helper.MapKeys(componentRoutes)
+	sort.Strings(components)
+	for _, component := range components {
+		before := current.ComponentRoutes()[component]
+		after := componentRoutes[component]
+		impact.Changes.Add(fmt.Sprintf("Component Route '%s' Hostname", component), before.Hostname(),
+			after.Hostname())
+		impact.Changes.Add(fmt.Sprintf("Component Route '%s' TLS Secret Ref", component), before.TlsSecretRef(),
+			after.TlsSecretRef())
+	}
+	return impact
+}
+
+// ImpactOutput returns the before/after diff of the ingress and the warnings about the routes it exposes
+func ImpactOutput(impact *EditImpact) string {
+	result := fmt.Sprintf("Changes to ingress '%s':\n", impact.Ingress)
+	result += impact.Changes.Format(45)
+	if len(impact.Warnings) > 0 {
+		result += "\nWarnings:\n"
+		for _, warning := range impact.Warnings {
+			result += fmt.Sprintf("  - %s\n", warning)
+		}
+	}
+	return result
+}
+
+func formatRouteSelectors(routeSelectors map[string]string) string {
+	selectors := []string{}
+	for key, value := range routeSelectors {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(selectors)
+	return strings.Join(selectors, ",")
+}
+
+func sameRouteSelectors(current map[string]string, update map[string]string) bool {
+	return len(current) == 0 && len(update) == 0 || reflect.DeepEqual(current, update)
+}
@@ -0,0 +1,93 @@
+package ingress
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper/diff"
+)
+
+var _ = Describe("Ingress edit impact", func() {
+	var current *cmv1.Ingress
+
+	BeforeEach(func() {
+		var err error
+		current, err = cmv1.NewIngress().
+			ID("a1b2").
+			Default(true).
+			Listening(cmv1.ListeningMethodExternal).
+			LoadBalancerType(cmv1.LoadBalancerFlavorNlb).
+			RouteWildcardPolicy(cmv1.WildcardPolicyWildcardsDisallowed).
+			RouteNamespaceOwnershipPolicy(cmv1.NamespaceOwnershipPolicyStrict).
+			RouteSelectors(map[string]string{"route": "external"}).
+			ExcludedNamespaces("stage").
+			ComponentRoutes(map[string]*cmv1.ComponentRouteBuilder{
+				string(cmv1.ComponentRouteTypeConsole): cmv1.NewComponentRoute().
+					Hostname("console.example.com").TlsSecretRef("console-secret"),
+			}).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("compares only the attributes set in the update", func() {
+		update, err := cmv1.NewIngress().ID("a1b2").
+			RouteWildcardPolicy(cmv1.WildcardPolicyWildcardsAllowed).
+			ComponentRoutes(map[string]*cmv1.ComponentRouteBuilder{
+				string(cmv1.ComponentRouteTypeConsole): cmv1.NewComponentRoute().
+					Hostname("console.apps.example.com").TlsSecretRef("console-secret"),
+				string(cmv1.ComponentRouteTypeDownloads): cmv1.NewComponentRoute().
+					Hostname("downloads.example.com").TlsSecretRef("downloads-secret"),
+			}).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		impact := NewEditImpact(current, update)
+		Expect(impact.Changes).To(Equal(diff.Changes{
+			{Field: "Wildcard Policy", Before: "WildcardsDisallowed", After: "WildcardsAllowed"},
+			{Field: "Component Route 'console' Hostname", Before: "console.example.com",
+				After: "console.apps.example.com"},
+			{Field: "Component Route 'downloads' Hostname", Before: "", After: "downloads.example.com"},
+			{Field: "Component Route 'downloads' TLS Secret Ref", Before: "", After: "downloads-secret"},
+		}))
+		Expect(impact.Warnings).To(BeEmpty())
+	})
+
+	It("warns when the exposure of the default router changes", func() {
+		update, err := cmv1.NewIngress().ID("a1b2").
+			Listening(cmv1.ListeningMethodInternal).
+			LoadBalancerType(cmv1.LoadBalancerFlavorClassic).
+			RouteSelectors(map[string]string{"route": "internal"}).
+			ExcludedNamespaces("stage", "dev").
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		impact := NewEditImpact(current, update)
+		//nolint:lll
+		Expect(ImpactOutput(impact)).To(Equal(`Changes to ingress 'a1b2':
+  Private:                                     false -> true
+  LB-Type:                                     nlb -> classic
+  Route Selectors:                             route=external -> route=internal
+  Excluded Namespaces:                         [stage] -> [dev, stage]
+
+Warnings:
+  - The default router will only be reachable from within the VPC, application routes will no longer be exposed to the internet
+  - The load balancer of the default router will be replaced, application routes are unreachable until DNS points to the new load balancer
+  - The route selectors of the default router change, routes are exposed or hidden by it according to their labels
+  - Routes in namespaces [dev] will no longer be exposed by the default router
+`))
+	})
+
+	It("doesn't warn about ingresses other than the default", func() {
+		current, err := cmv1.NewIngress().ID("c3d4").Listening(cmv1.ListeningMethodExternal).Build()
+		Expect(err).NotTo(HaveOccurred())
+		update, err := cmv1.NewIngress().ID("c3d4").Listening(cmv1.ListeningMethodInternal).
+			RouteSelectors(map[string]string{}).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		impact := NewEditImpact(current, update)
+		Expect(impact.Warnings).To(BeEmpty())
+		Expect(ImpactOutput(impact)).To(Equal("Changes to ingress 'c3d4':\n" +
+			"  Private:                                     false -> true\n"))
+	})
+})
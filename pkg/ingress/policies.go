@@ -1,7 +1,12 @@
 package ingress
 
 import (
+	"fmt"
+	"strings"
+
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper"
 )
 
 var ValidWildcardPolicies = []string{string(cmv1.WildcardPolicyWildcardsDisallowed),
@@ -10,3 +15,26 @@ var DefaultWildcardPolicy = cmv1.WildcardPolicyWildcardsDisallowed
 var ValidNamespaceOwnershipPolicies = []string{string(cmv1.NamespaceOwnershipPolicyStrict),
 	string(cmv1.NamespaceOwnershipPolicyInterNamespaceAllowed)}
 var DefaultNamespaceOwnershipPolicy = cmv1.NamespaceOwnershipPolicyStrict
+var ValidLbTypes = []string{string(cmv1.LoadBalancerFlavorClassic), string(cmv1.LoadBalancerFlavorNlb)}
+
+// ValidateLbType checks that a load balancer type is one of the valid options
+func ValidateLbType(lbType string) error {
+	return validateOption("Load Balancer type", lbType, ValidLbTypes)
+}
+
+// ValidateWildcardPolicy checks that a wildcard policy is one of the valid options
+func ValidateWildcardPolicy(policy string) error {
+	return validateOption("Wildcard Policy", policy, ValidWildcardPolicies)
+}
+
+// ValidateNamespaceOwnershipPolicy checks that a namespace ownership policy is one of the valid options
+func ValidateNamespaceOwnershipPolicy(policy string) error {
+	return validateOption("Namespace Ownership Policy", policy, ValidNamespaceOwnershipPolicies)
+}
+
+func validateOption(name string, value string, options []string) error {
+	if !helper.Contains(options, value) {
+		return fmt.Errorf("Invalid %s '%s'. Options are %s", name, value, strings.Join(options, ","))
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package ingress
 import (
 	"fmt"
 	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 func GetRouteSelector(labelMatches string) (map[string]string, error) {
@@ -20,3 +22,36 @@ func GetRouteSelector(labelMatches string) (map[string]string, error) {
 
 	return routeSelectors, nil
 }
+
+// ValidateRouteSelector checks the syntax of a comma-separated list of 'key=value' route selectors and
+// that the keys and values follow the rules of Kubernetes labels
+func ValidateRouteSelector(labelMatches string) error {
+	if strings.TrimSpace(labelMatches) == "" {
+		return nil
+	}
+	keys := map[string]bool{}
+	for _, labelMatch := range strings.Split(labelMatches, ",") {
+		labelMatch = strings.TrimSpace(labelMatch)
+		if labelMatch == "" {
+			return fmt.Errorf("Route selector list '%s' has an empty entry", labelMatches)
+		}
+		tokens := strings.SplitN(labelMatch, "=", 2)
+		if len(tokens) != 2 {
+			return fmt.Errorf("Expected key=value format for route selector '%s'", labelMatch)
+		}
+		key := strings.TrimSpace(tokens[0])
+		value := strings.TrimSpace(tokens[1])
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("Invalid route selector key '%s': %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("Invalid value '%s' for route selector key '%s': %s", value, key,
+				strings.Join(errs, "; "))
+		}
+		if keys[key] {
+			return fmt.Errorf("Route selector key '%s' is supplied more than once", key)
+		}
+		keys[key] = true
+	}
+	return nil
+}
@@ -37,3 +37,28 @@ var _ = Describe("GetRouteSelector", func() {
 		})
 	})
 })
+
+var _ = Describe("ValidateRouteSelector", func() {
+	DescribeTable("accepts valid route selectors",
+		func(input string) {
+			Expect(ValidateRouteSelector(input)).To(Succeed())
+		},
+		Entry("empty", ""),
+		Entry("single", "route=external"),
+		Entry("prefixed key and spaces", "example.com/route=external, tier=front_end-1"),
+		Entry("empty value", "route="),
+	)
+	DescribeTable("rejects invalid route selectors",
+		func(input string, message string) {
+			err := ValidateRouteSelector(input)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		},
+		Entry("wrong delimiter", "route:external", "Expected key=value format for route selector 'route:external'"),
+		Entry("empty entry", "route=external,,tier=front", "has an empty entry"),
+		Entry("invalid key", "-route=external", "Invalid route selector key '-route'"),
+		Entry("invalid prefix", "Example_com/route=external", "Invalid route selector key"),
+		Entry("invalid value", "route=ext=ernal", "Invalid value 'ext=ernal' for route selector key 'route'"),
+		Entry("duplicate key", "route=a, route=b", "Route selector key 'route' is supplied more than once"),
+	)
+})
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const gibibyte = 1 << 30
+
+// Categories are the instance type categories accepted by the filters
+var Categories = []string{
+	string(cmv1.MachineTypeCategoryAcceleratedComputing),
+	string(cmv1.MachineTypeCategoryComputeOptimized),
+	string(cmv1.MachineTypeCategoryGeneralPurpose),
+	string(cmv1.MachineTypeCategoryMemoryOptimized),
+}
+
+// Architectures are the processor architectures accepted by the filters
+var Architectures = []string{
+	string(cmv1.ProcessorTypeAMD64),
+	string(cmv1.ProcessorTypeARM64),
+}
+
+// Filter selects instance types by their size and kind. Zero values don't filter.
+type Filter struct {
+	MinCPU       int
+	MinMemory    int64
+	Categories   []string
+	Architecture string
+	GPU          bool
+}
+
+// Validate checks the categories and the architecture of the filter
+func (f *Filter) Validate() error {
+	for _, category := range f.Categories {
+		if !contains(Categories, category) {
+			return fmt.Errorf("invalid category '%s', allowed values are %s", category,
+				strings.Join(Categories, ", "))
+		}
+	}
+	if f.Architecture != "" && !contains(Architectures, f.Architecture) {
+		return fmt.Errorf("invalid architecture '%s', allowed values are %s", f.Architecture,
+			strings.Join(Architectures, ", "))
+	}
+	if f.MinCPU < 0 {
+		return fmt.Errorf("the minimum number of CPU cores must not be negative")
+	}
+	if f.MinMemory < 0 {
+		return fmt.Errorf("the minimum memory must not be negative")
+	}
+	return nil
+}
+
+// Matches returns true if the instance type passes all the criteria of the filter
+func (f *Filter) Matches(machineType *cmv1.MachineType) bool {
+	if CPUCores(machineType) < f.MinCPU {
+		return false
+	}
+	if MemoryBytes(machineType) < f.MinMemory {
+		return false
+	}
+	if len(f.Categories) > 0 && !contains(f.Categories, string(machineType.Category())) {
+		return false
+	}
+	if f.Architecture != "" && !strings.EqualFold(string(machineType.Architecture()), f.Architecture) {
+		return false
+	}
+	if f.GPU && !HasGPU(machineType) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the instance types of the list that match the filter
+func (f *Filter) Apply(machineTypes ocm.MachineTypeList) ocm.MachineTypeList {
+	filtered := machineTypes.Filter(func(machineType *ocm.MachineType) bool {
+		return f.Matches(machineType.MachineType)
+	})
+	filtered.Region = machineTypes.Region
+	filtered.AvailabilityZones = machineTypes.AvailabilityZones
+	return filtered
+}
+
+// HasGPU returns true for accelerated computing instance types with GPUs, other accelerators such as
+// Gaudi or Inferentia don't count
+func HasGPU(machineType *cmv1.MachineType) bool {
+	return machineType.Category() == cmv1.MachineTypeCategoryAcceleratedComputing &&
+		(strings.Contains(strings.ToLower(machineType.GenericName()), "gpu") ||
+			strings.Contains(strings.ToLower(machineType.Name()), "gpu"))
+}
+
+// CPUCores returns the number of virtual CPUs of the instance type
+func CPUCores(machineType *cmv1.MachineType) int {
+	return int(machineType.CPU().Value())
+}
+
+// MemoryBytes returns the memory of the instance type in bytes
+func MemoryBytes(machineType *cmv1.MachineType) int64 {
+	memory := machineType.Memory()
+	switch strings.ToLower(memory.Unit()) {
+	case "kib":
+		return int64(memory.Value() * (1 << 10))
+	case "mib":
+		return int64(memory.Value() * (1 << 20))
+	case "gib":
+		return int64(memory.Value() * gibibyte)
+	}
+	return int64(memory.Value())
+}
+
+// ParseMemory parses a memory size such as '16Gi', '16GiB' or '512Mi'. Numbers without a unit are
+// gibibytes.
+func ParseMemory(value string) (int64, error) {
+	value = strings.ReplaceAll(value, " ", "")
+	if value == "" {
+		return 0, nil
+	}
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		if number < 0 {
+			return 0, fmt.Errorf("invalid memory size '%s', positive size required", value)
+		}
+		return int64(number * gibibyte), nil
+	}
+	// The quantity parser doesn't accept the 'B' of 'GiB'
+	if strings.HasSuffix(value, "iB") {
+		value = strings.TrimSuffix(value, "B")
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory size '%s', expected a size such as '16Gi' or '512Mi'", value)
+	}
+	if quantity.Sign() < 0 {
+		return 0, fmt.Errorf("invalid memory size '%s', positive size required", value)
+	}
+	return quantity.Value(), nil
+}
+
+// FormatMemory prints a number of bytes in gibibytes, for example '192.0 GiB'
+func FormatMemory(bytes int64) string {
+	return fmt.Sprintf("%.1f GiB", float64(bytes)/gibibyte)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package instancetype
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+func machineType(id string, category cmv1.MachineTypeCategory, cpu int, memoryGiB int,
+	genericName string) *cmv1.MachineType {
+	machineType, err := cmv1.NewMachineType().ID(id).Category(category).GenericName(genericName).
+		Architecture(cmv1.ProcessorTypeAMD64).
+		CPU(cmv1.NewValue().Value(float64(cpu)).Unit("vCPU")).
+		Memory(cmv1.NewValue().Value(float64(memoryGiB) * gibibyte).Unit("B")).Build()
+	Expect(err).ToNot(HaveOccurred())
+	return machineType
+}
+
+func machineTypeList(gpuQuota int, machineTypes ...*cmv1.MachineType) ocm.MachineTypeList {
+	list := ocm.MachineTypeList{}
+	for _, machineType := range machineTypes {
+		list.Items = append(list.Items, &ocm.MachineType{MachineType: machineType})
+	}
+	quotaCosts, err := amsv1.NewQuotaCostList().Items(amsv1.NewQuotaCost().Allowed(gpuQuota).RelatedResources(
+		amsv1.NewRelatedResource().ResourceName("t4-gpu-48").Cost(1).Product("any").BYOC("any").
+			CloudProvider("any"),
+	)).Build()
+	Expect(err).ToNot(HaveOccurred())
+	list.UpdateAvailableQuota(quotaCosts)
+	return list
+}
+
+var _ = Describe("Filter", func() {
+	m5 := machineType("m5.xlarge", cmv1.MachineTypeCategoryGeneralPurpose, 4, 16, "standard-4")
+	r5 := machineType("r5.2xlarge", cmv1.MachineTypeCategoryMemoryOptimized, 8, 64, "highmem-8")
+	g4dn := machineType("g4dn.12xlarge", cmv1.MachineTypeCategoryAcceleratedComputing, 48, 192, "t4-gpu-48")
+	dl1 := machineType("dl1.24xlarge", cmv1.MachineTypeCategoryAcceleratedComputing, 96, 768, "d1-gaudi-24x")
+
+	It("Filters by CPU and memory", func() {
+		filter := &Filter{MinCPU: 8, MinMemory: 100 * gibibyte}
+		Expect(filter.Matches(m5)).To(BeFalse())
+		Expect(filter.Matches(r5)).To(BeFalse())
+		Expect(filter.Matches(g4dn)).To(BeTrue())
+	})
+
+	It("Filters by category and architecture", func() {
+		filter := &Filter{Categories: []string{"memory_optimized"}, Architecture: "amd64"}
+		Expect(filter.Matches(r5)).To(BeTrue())
+		Expect(filter.Matches(m5)).To(BeFalse())
+		filter = &Filter{Architecture: "arm64"}
+		Expect(filter.Matches(r5)).To(BeFalse())
+	})
+
+	It("Keeps only instance types with GPUs", func() {
+		filter := &Filter{GPU: true}
+		list := filter.Apply(machineTypeList(10, m5, g4dn, dl1))
+		Expect(list.IDs()).To(Equal([]string{"g4dn.12xlarge"}))
+	})
+
+	It("Rejects unknown categories and architectures", func() {
+		Expect((&Filter{Categories: []string{"storage"}}).Validate()).To(MatchError(
+			"invalid category 'storage', allowed values are accelerated_computing, compute_optimized, " +
+				"general_purpose, memory_optimized"))
+		Expect((&Filter{Architecture: "s390x"}).Validate()).To(MatchError(
+			"invalid architecture 's390x', allowed values are amd64, arm64"))
+	})
+})
+
+var _ = Describe("ParseMemory", func() {
+	DescribeTable("Parses memory sizes",
+		func(value string, expected int64) {
+			Expect(ParseMemory(value)).To(Equal(expected))
+		},
+		Entry("gibibytes", "256Gi", int64(256*gibibyte)),
+		Entry("gibibytes with the byte unit", "256GiB", int64(256*gibibyte)),
+		Entry("mebibytes", "512Mi", int64(512<<20)),
+		Entry("no unit", "16", int64(16*gibibyte)),
+		Entry("empty", "", int64(0)),
+	)
+
+	It("Fails on invalid sizes", func() {
+		_, err := ParseMemory("lots")
+		Expect(err).To(MatchError("invalid memory size 'lots', expected a size such as '16Gi' or '512Mi'"))
+		_, err = ParseMemory("-4")
+		Expect(err).To(MatchError("invalid memory size '-4', positive size required"))
+	})
+})
+
+var _ = Describe("Recommend", func() {
+	m5 := machineType("m5.xlarge", cmv1.MachineTypeCategoryGeneralPurpose, 4, 16, "standard-4")
+	m5x4 := machineType("m5.4xlarge", cmv1.MachineTypeCategoryGeneralPurpose, 16, 64, "standard-16")
+	r5 := machineType("r5.2xlarge", cmv1.MachineTypeCategoryMemoryOptimized, 8, 64, "highmem-8")
+	g4dn := machineType("g4dn.12xlarge", cmv1.MachineTypeCategoryAcceleratedComputing, 48, 192, "t4-gpu-48")
+
+	It("Ranks instance types by fit", func() {
+		recommendations := Recommend(machineTypeList(10, m5, r5, m5x4), Requirements{
+			CPU:            64,
+			Memory:         256 * gibibyte,
+			MaxPodsPerNode: DefaultMaxPodsPerNode,
+		})
+		Expect(recommendations).To(HaveLen(3))
+		Expect(recommendations[0].MachineType.MachineType.ID()).To(Equal("m5.4xlarge"))
+		Expect(recommendations[0].Nodes).To(Equal(4))
+		Expect(recommendations[1].MachineType.MachineType.ID()).To(Equal("m5.xlarge"))
+		Expect(recommendations[1].Nodes).To(Equal(16))
+		Expect(recommendations[2].MachineType.MachineType.ID()).To(Equal("r5.2xlarge"))
+		Expect(recommendations[2].Nodes).To(Equal(8))
+		Expect(recommendations[2].TotalCPU).To(Equal(64))
+		Expect(recommendations[2].TotalMemory).To(Equal(int64(512 * gibibyte)))
+		Expect(recommendations[2].Overhead).To(Equal(0.5))
+	})
+
+	It("Adds nodes for the number of pods", func() {
+		recommendations := Recommend(machineTypeList(10, m5x4), Requirements{
+			CPU:            16,
+			Pods:           600,
+			MaxPodsPerNode: DefaultMaxPodsPerNode,
+		})
+		Expect(recommendations[0].Nodes).To(Equal(3))
+	})
+
+	It("Ranks instance types without enough quota last", func() {
+		recommendations := Recommend(machineTypeList(3, g4dn, m5x4), Requirements{
+			CPU:            192,
+			MaxPodsPerNode: DefaultMaxPodsPerNode,
+		})
+		Expect(recommendations[0].MachineType.MachineType.ID()).To(Equal("m5.4xlarge"))
+		Expect(recommendations[1].MachineType.MachineType.ID()).To(Equal("g4dn.12xlarge"))
+		Expect(recommendations[1].Nodes).To(Equal(4))
+		Expect(recommendations[1].QuotaLimited).To(BeTrue())
+		Expect(recommendations[1].Quota).To(Equal(3))
+		Expect(recommendations[1].HasQuota()).To(BeFalse())
+	})
+
+	It("Requires a CPU or memory amount", func() {
+		requirements := Requirements{Pods: 100, MaxPodsPerNode: DefaultMaxPodsPerNode}
+		Expect(requirements.Validate()).To(MatchError("the required number of CPU cores or memory must be set"))
+	})
+})
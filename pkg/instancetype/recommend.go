@@ -0,0 +1,131 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancetype
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// DefaultMaxPodsPerNode is the default maximum number of pods that the kubelet runs on a node
+const DefaultMaxPodsPerNode = 250
+
+// Requirements is the capacity that the nodes of a machine pool must provide together
+type Requirements struct {
+	CPU            int
+	Memory         int64
+	Pods           int
+	MaxPodsPerNode int
+}
+
+// Validate checks that the requirements ask for some capacity
+func (r *Requirements) Validate() error {
+	if r.CPU < 0 || r.Memory < 0 || r.Pods < 0 {
+		return fmt.Errorf("the required capacity must not be negative")
+	}
+	if r.CPU == 0 && r.Memory == 0 {
+		return fmt.Errorf("the required number of CPU cores or memory must be set")
+	}
+	if r.MaxPodsPerNode <= 0 {
+		return fmt.Errorf("the maximum number of pods per node must be positive")
+	}
+	return nil
+}
+
+// Recommendation is an instance type together with the number of nodes that meet the requirements
+type Recommendation struct {
+	MachineType *ocm.MachineType
+	Nodes       int
+	TotalCPU    int
+	TotalMemory int64
+	// Overhead is the fraction of CPU and memory provisioned above the requirements, averaged
+	Overhead float64
+	// Quota is the number of nodes that can still be created, only set for instance types with quota
+	Quota        int
+	QuotaLimited bool
+}
+
+// HasQuota returns true if the organization has quota for all the nodes of the recommendation
+func (r *Recommendation) HasQuota() bool {
+	return !r.QuotaLimited || r.Quota >= r.Nodes
+}
+
+// Recommend ranks the available instance types of the list by how closely the nodes needed to meet the
+// requirements fit them. Instance types with quota for the nodes come first, then the ones with the
+// least overhead and the ones that need fewer nodes.
+func Recommend(machineTypes ocm.MachineTypeList, requirements Requirements) []*Recommendation {
+	recommendations := []*Recommendation{}
+	for _, machineType := range machineTypes.Items {
+		if !machineType.Available {
+			continue
+		}
+		cpu := CPUCores(machineType.MachineType)
+		memory := MemoryBytes(machineType.MachineType)
+		if cpu <= 0 || memory <= 0 {
+			continue
+		}
+		nodes := max(
+			divideRoundingUp(int64(requirements.CPU), int64(cpu)),
+			divideRoundingUp(requirements.Memory, memory),
+			divideRoundingUp(int64(requirements.Pods), int64(requirements.MaxPodsPerNode)),
+			1,
+		)
+		recommendation := &Recommendation{
+			MachineType: machineType,
+			Nodes:       nodes,
+			TotalCPU:    nodes * cpu,
+			TotalMemory: int64(nodes) * memory,
+		}
+		recommendation.Overhead = overhead(recommendation, requirements)
+		recommendation.Quota, recommendation.QuotaLimited = machineType.AvailableQuota()
+		recommendations = append(recommendations, recommendation)
+	}
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.HasQuota() != b.HasQuota() {
+			return a.HasQuota()
+		}
+		if a.Overhead != b.Overhead {
+			return a.Overhead < b.Overhead
+		}
+		if a.Nodes != b.Nodes {
+			return a.Nodes < b.Nodes
+		}
+		return a.MachineType.MachineType.ID() < b.MachineType.MachineType.ID()
+	})
+	return recommendations
+}
+
+func overhead(recommendation *Recommendation, requirements Requirements) float64 {
+	total := 0.0
+	count := 0
+	if requirements.CPU > 0 {
+		total += float64(recommendation.TotalCPU)/float64(requirements.CPU) - 1
+		count++
+	}
+	if requirements.Memory > 0 {
+		total += float64(recommendation.TotalMemory)/float64(requirements.Memory) - 1
+		count++
+	}
+	return total / float64(count)
+}
+
+func divideRoundingUp(value int64, divisor int64) int {
+	return int((value + divisor - 1) / divisor)
+}
@@ -25,10 +25,6 @@ import (
 	"os"
 	"regexp"
 	"strconv"
-	"strings"
-
-	"k8s.io/apimachinery/pkg/util/validation"
-	netutils "k8s.io/utils/net"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/core"
@@ -38,6 +34,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/helper"
 	urlHelper "github.com/openshift/rosa/pkg/helper/url"
+	"github.com/openshift/rosa/pkg/idp"
 	"github.com/openshift/rosa/pkg/ocm"
 )
 
@@ -65,24 +62,9 @@ func IsURL(val interface{}) error {
 	return err
 }
 
-// IsValidHostname is same validation as in the Open Shift GitHub IDP CRD
-// Validates the hostname DNS1123 format
-// https://github.com/openshift/kubernetes/blob/91607f5d750ba4002f87d34a12ae1cfd45b45b81/openshift-kube-apiserver/admission/customresourcevalidation/oauth/helpers.go#L13
-// and denies any [*.]github.com hostnames
-// https://github.com/openshift/kubernetes/blob/258f1d5fb6491ba65fd8201c827e179432430627/openshift-kube-apiserver/admission/customresourcevalidation/oauth/validate_github.go#L49
+// IsValidHostname validates the hostname of a GitHub Enterprise instance, see idp.ValidateGithubHostname
 func IsValidHostname(val interface{}) error {
-	hostname := val.(string)
-	if hostname == "" {
-		return nil
-	}
-	if hostname == "github.com" || strings.HasSuffix(hostname, ".github.com") {
-		return fmt.Errorf("%s", fmt.Sprintf("'%s' hostname cannot be equal to [*.]github.com", hostname))
-	}
-	//nolint:staticcheck
-	if !(len(validation.IsDNS1123Subdomain(hostname)) == 0 || netutils.ParseIPSloppy(hostname) != nil) {
-		return fmt.Errorf("%s", fmt.Sprintf("'%s' hostname must be a valid DNS subdomain or IP address", hostname))
-	}
-	return nil
+	return idp.ValidateGithubHostname(val.(string))
 }
 
 func IsURLHttps(val interface{}) error {
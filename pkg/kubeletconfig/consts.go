@@ -15,4 +15,11 @@ const (
 	InteractiveNameHelpPrompt      = "Name?"
 	InteractiveNameHelp            = "Name of the KubeletConfig"
 	ByPassPidsLimitCapability      = "capability.organization.bypass_pids_limits"
+	PresetOption                   = "preset"
+	PresetOptionUsage              = "Name of a preset of the presets file to take the settings of the KubeletConfig from."
+	PresetsFileOption              = "presets-file"
+	PresetsFileOptionUsage         = "Path to the YAML file with the KubeletConfig presets. " +
+		"Defaults to 'rosa/kubeletconfig-presets.yaml' in the user configuration directory."
+	DryRunOption      = "dry-run"
+	DryRunOptionUsage = "Show the machine pools whose nodes the change would roll out to without applying it."
 )
@@ -0,0 +1,72 @@
+package kubeletconfig
+
+import (
+	"fmt"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const inPlaceUpgradeType = "InPlace"
+
+// Impact is the roll out of a change of a KubeletConfig to the nodes of a machine pool
+type Impact struct {
+	MachinePool string
+	Nodes       string
+	Recreate    bool
+}
+
+// NodePoolsImpact returns the impact of a change of a KubeletConfig on the node pools that use it. The
+// nodes are recreated unless the node pool is upgraded in place, in which case they are rebooted.
+func NodePoolsImpact(nodePools []*cmv1.NodePool) []*Impact {
+	impacts := []*Impact{}
+	for _, nodePool := range nodePools {
+		nodes := fmt.Sprintf("%d", nodePool.Replicas())
+		if nodePool.Status() != nil {
+			nodes = fmt.Sprintf("%d", nodePool.Status().CurrentReplicas())
+		} else if nodePool.Autoscaling() != nil {
+			nodes = fmt.Sprintf("%d-%d", nodePool.Autoscaling().MinReplica(), nodePool.Autoscaling().MaxReplica())
+		}
+		impacts = append(impacts, &Impact{
+			MachinePool: nodePool.ID(),
+			Nodes:       nodes,
+			Recreate:    nodePool.ManagementUpgrade().Type() != inPlaceUpgradeType,
+		})
+	}
+	return impacts
+}
+
+// MachinePoolsImpact returns the impact of a change of the KubeletConfig of a classic cluster, which
+// reboots the nodes of every machine pool
+func MachinePoolsImpact(machinePools []*cmv1.MachinePool) []*Impact {
+	impacts := []*Impact{}
+	for _, machinePool := range machinePools {
+		nodes := fmt.Sprintf("%d", machinePool.Replicas())
+		if machinePool.Autoscaling() != nil {
+			nodes = fmt.Sprintf("%d-%d", machinePool.Autoscaling().MinReplicas(),
+				machinePool.Autoscaling().MaxReplicas())
+		}
+		impacts = append(impacts, &Impact{
+			MachinePool: machinePool.ID(),
+			Nodes:       nodes,
+		})
+	}
+	return impacts
+}
+
+func PrintImpact(impacts []*Impact) string {
+	var output strings.Builder
+	if len(impacts) == 0 {
+		return ""
+	}
+	output.WriteString("Editing This KubeletConfig Will:\n")
+	for _, impact := range impacts {
+		action := "Reboot"
+		if impact.Recreate {
+			action = "Recreate"
+		}
+		fmt.Fprintf(&output, " - %s the %s nodes of machine pool '%s'\n", //nolint:forbidigo
+			action, impact.Nodes, impact.MachinePool)
+	}
+	return output.String()
+}
@@ -9,6 +9,9 @@ import (
 type KubeletConfigOptions struct {
 	Name         string
 	PodPidsLimit int
+	Preset       string
+	PresetsFile  string
+	DryRun       bool
 }
 
 func NewKubeletConfigOptions() *KubeletConfigOptions {
@@ -33,9 +36,27 @@ func (k *KubeletConfigOptions) AddAllFlags(cmd *cobra.Command) {
 		PodPidsLimitOption,
 		PodPidsLimitOptionDefaultValue,
 		PodPidsLimitOptionUsage)
+	flags.StringVar(
+		&k.Preset,
+		PresetOption,
+		"",
+		PresetOptionUsage)
+	flags.StringVar(
+		&k.PresetsFile,
+		PresetsFileOption,
+		"",
+		PresetsFileOptionUsage)
 	k.AddNameFlag(cmd)
 }
 
+func (k *KubeletConfigOptions) AddDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(
+		&k.DryRun,
+		DryRunOption,
+		false,
+		DryRunOptionUsage)
+}
+
 // BindFromArgs allows the user to use positional args for the name. The --name flag
 // will take precedence
 func (k *KubeletConfigOptions) BindFromArgs(args []string) {
@@ -46,6 +67,34 @@ func (k *KubeletConfigOptions) BindFromArgs(args []string) {
 	}
 }
 
+// ApplyPreset takes the settings of the KubeletConfig from the requested preset, if any
+func (k *KubeletConfigOptions) ApplyPreset() error {
+	if k.Preset == "" {
+		if k.PresetsFile != "" {
+			return fmt.Errorf("The --%s flag requires the --%s flag.", PresetsFileOption, PresetOption)
+		}
+		return nil
+	}
+	if k.PodPidsLimit != PodPidsLimitOptionDefaultValue {
+		return fmt.Errorf("The --%s and --%s flags can't be used together.", PodPidsLimitOption, PresetOption)
+	}
+
+	path := k.PresetsFile
+	if path == "" {
+		var err error
+		path, err = DefaultPresetsFile()
+		if err != nil {
+			return err
+		}
+	}
+	preset, err := FindPreset(path, k.Preset)
+	if err != nil {
+		return err
+	}
+	k.PodPidsLimit = preset.PodPidsLimit
+	return nil
+}
+
 func (k *KubeletConfigOptions) ValidateForHypershift() error {
 	if k.Name == "" {
 		return fmt.Errorf("The --name flag is required for Hosted Control Plane clusters.")
@@ -1,6 +1,10 @@
 package kubeletconfig
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
@@ -24,6 +28,12 @@ var _ = Describe("KubeletConfigOptions", func() {
 
 		flag = flags.Lookup(NameOption)
 		assertFlag(flag, NameOption, NameOptionUsage)
+
+		flag = flags.Lookup(PresetOption)
+		assertFlag(flag, PresetOption, PresetOptionUsage)
+
+		flag = flags.Lookup(PresetsFileOption)
+		assertFlag(flag, PresetsFileOption, PresetsFileOptionUsage)
 	})
 
 	It("Adds name flag to command", func() {
@@ -68,6 +78,60 @@ var _ = Describe("KubeletConfigOptions", func() {
 		options.BindFromArgs([]string{"bob"})
 		Expect(options.Name).To(Equal("foo"))
 	})
+
+	Context("ApplyPreset", func() {
+		var presetsFile string
+
+		BeforeEach(func() {
+			presetsFile = filepath.Join(GinkgoT().TempDir(), "presets.yaml")
+			err := os.WriteFile(presetsFile, []byte("presets:\n"+
+				"  high-density:\n    podPidsLimit: 16384\n"+
+				"  batch:\n    podPidsLimit: 8192\n"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Takes the pod pids limit from the preset", func() {
+			options := NewKubeletConfigOptions()
+			options.Preset = "batch"
+			options.PresetsFile = presetsFile
+			Expect(options.ApplyPreset()).To(Succeed())
+			Expect(options.PodPidsLimit).To(Equal(8192))
+		})
+
+		It("Does nothing without a preset", func() {
+			options := NewKubeletConfigOptions()
+			options.PodPidsLimit = 5000
+			Expect(options.ApplyPreset()).To(Succeed())
+			Expect(options.PodPidsLimit).To(Equal(5000))
+		})
+
+		It("Fails for a preset that doesn't exist", func() {
+			options := NewKubeletConfigOptions()
+			options.Preset = "web"
+			options.PresetsFile = presetsFile
+			Expect(options.ApplyPreset()).To(MatchError(fmt.Sprintf("KubeletConfig preset 'web' doesn't exist "+
+				"in file '%s'. Available presets are: 'batch', 'high-density'", presetsFile)))
+		})
+
+		It("Fails when the pod pids limit is also set", func() {
+			options := NewKubeletConfigOptions()
+			options.Preset = "batch"
+			options.PodPidsLimit = 5000
+			Expect(options.ApplyPreset()).To(MatchError(
+				"The --pod-pids-limit and --preset flags can't be used together."))
+		})
+
+		It("Fails for unknown settings", func() {
+			err := os.WriteFile(presetsFile, []byte("presets:\n  batch:\n    maxPods: 500\n"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+			options := NewKubeletConfigOptions()
+			options.Preset = "batch"
+			options.PresetsFile = presetsFile
+			err = options.ApplyPreset()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown field \"maxPods\""))
+		})
+	})
 })
 
 func assertFlag(flag *flag.Flag, name string, usage string) {
@@ -29,13 +29,25 @@ func getName(config *cmv1.KubeletConfig) string {
 func PrintKubeletConfigForHcp(config *cmv1.KubeletConfig, nodePools []*cmv1.NodePool) string {
 	var output strings.Builder
 	output.WriteString(PrintKubeletConfigForClassic(config))
-	if len(nodePools) != 0 {
+	output.WriteString(printImpactedMachinePools(NodePoolsImpact(nodePools)))
+	return output.String()
+}
+
+// PrintMachinePoolsForClassic prints the machine pools of a classic cluster, which all use the KubeletConfig
+// of the cluster
+func PrintMachinePoolsForClassic(machinePools []*cmv1.MachinePool) string {
+	return printImpactedMachinePools(MachinePoolsImpact(machinePools))
+}
+
+func printImpactedMachinePools(impacts []*Impact) string {
+	var output strings.Builder
+	if len(impacts) != 0 {
 		output.WriteString("MachinePools Using This KubeletConfig:\n")
-		for _, n := range nodePools {
-			fmt.Fprintf(&output, " - %s\n", n.ID()) //nolint:forbidigo
+		for _, impact := range impacts {
+			fmt.Fprintf(&output, " - %s\n", impact.MachinePool) //nolint:forbidigo
 		}
+		output.WriteString(PrintImpact(impacts))
 	}
-
 	return output.String()
 }
 
@@ -26,6 +26,8 @@ Name:                                 foo
 Pod Pids Limit:                       10000
 MachinePools Using This KubeletConfig:
  - testing
+Editing This KubeletConfig Will:
+ - Recreate the 3 nodes of machine pool 'testing'
 `
 
 var hcpOutputNoName = `
@@ -34,6 +36,16 @@ Name:                                 -
 Pod Pids Limit:                       10000
 MachinePools Using This KubeletConfig:
  - testing
+Editing This KubeletConfig Will:
+ - Reboot the 2-4 nodes of machine pool 'testing'
+`
+
+var classicMachinePoolsOutput = `MachinePools Using This KubeletConfig:
+ - worker
+ - infra
+Editing This KubeletConfig Will:
+ - Reboot the 2 nodes of machine pool 'worker'
+ - Reboot the 3-6 nodes of machine pool 'infra'
 `
 
 var _ = Describe("KubeletConfig Output", func() {
@@ -75,7 +87,7 @@ var _ = Describe("KubeletConfig Output", func() {
 		})
 
 		nodePool := MockNodePool(func(n *cmv1.NodePoolBuilder) {
-			n.ID("testing")
+			n.ID("testing").Replicas(3)
 		})
 
 		output := PrintKubeletConfigForHcp(kubeletConfig, []*cmv1.NodePool{nodePool})
@@ -88,10 +100,35 @@ var _ = Describe("KubeletConfig Output", func() {
 		})
 
 		nodePool := MockNodePool(func(n *cmv1.NodePoolBuilder) {
-			n.ID("testing")
+			n.ID("testing").
+				Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)).
+				ManagementUpgrade(cmv1.NewNodePoolManagementUpgrade().Type("InPlace"))
 		})
 
 		output := PrintKubeletConfigForHcp(kubeletConfig, []*cmv1.NodePool{nodePool})
 		Expect(output).To(Equal(hcpOutputNoName))
 	})
+
+	It("Prints the machine pools of a Classic cluster", func() {
+		worker, err := cmv1.NewMachinePool().ID("worker").Replicas(2).Build()
+		Expect(err).NotTo(HaveOccurred())
+		infra, err := cmv1.NewMachinePool().ID("infra").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(3).MaxReplicas(6)).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		output := PrintMachinePoolsForClassic([]*cmv1.MachinePool{worker, infra})
+		Expect(output).To(Equal(classicMachinePoolsOutput))
+	})
+
+	It("Prints the current replicas of node pools", func() {
+		nodePool := MockNodePool(func(n *cmv1.NodePoolBuilder) {
+			n.ID("testing").
+				Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)).
+				Status(cmv1.NewNodePoolStatus().CurrentReplicas(3))
+		})
+
+		output := PrintImpact(NodePoolsImpact([]*cmv1.NodePool{nodePool}))
+		Expect(output).To(Equal("Editing This KubeletConfig Will:\n" +
+			" - Recreate the 3 nodes of machine pool 'testing'\n"))
+	})
 })
@@ -0,0 +1,76 @@
+package kubeletconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const presetsFileName = "kubeletconfig-presets.yaml"
+
+// Preset is a named set of KubeletConfig settings, for example:
+//
+//	presets:
+//	  high-density:
+//	    podPidsLimit: 16384
+//	  batch:
+//	    podPidsLimit: 8192
+type Preset struct {
+	PodPidsLimit int `json:"podPidsLimit"`
+}
+
+type presetsFile struct {
+	Presets map[string]*Preset `json:"presets"`
+}
+
+// DefaultPresetsFile returns the location of the presets file in the user configuration directory
+func DefaultPresetsFile() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("Failed to find the user configuration directory: %v", err)
+	}
+	return filepath.Join(configDir, "rosa", presetsFileName), nil
+}
+
+// LoadPresets reads the KubeletConfig presets of a file
+func LoadPresets(path string) (map[string]*Preset, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read KubeletConfig presets file '%s': %v", path, err)
+	}
+	file := &presetsFile{}
+	err = yaml.UnmarshalStrict(data, file)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse KubeletConfig presets file '%s': %v", path, err)
+	}
+	for name, preset := range file.Presets {
+		if preset == nil || preset.PodPidsLimit == PodPidsLimitOptionDefaultValue {
+			return nil, fmt.Errorf("KubeletConfig preset '%s' of file '%s' doesn't set 'podPidsLimit'",
+				name, path)
+		}
+	}
+	return file.Presets, nil
+}
+
+// FindPreset returns the KubeletConfig preset with the given name
+func FindPreset(path string, name string) (*Preset, error) {
+	presets, err := LoadPresets(path)
+	if err != nil {
+		return nil, err
+	}
+	preset, ok := presets[name]
+	if !ok {
+		names := []string{}
+		for presetName := range presets {
+			names = append(names, presetName)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("KubeletConfig preset '%s' doesn't exist in file '%s'. Available presets are: '%s'",
+			name, path, strings.Join(names, "', '"))
+	}
+	return preset, nil
+}
@@ -0,0 +1,223 @@
+package logforwarding
+
+import (
+	"fmt"
+	"strings"
+
+	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+// Results of the checks of a log forwarder
+const (
+	CheckPassed  = "passed"
+	CheckWarning = "warning"
+	CheckFailed  = "failed"
+	CheckSkipped = "skipped"
+)
+
+// Names of the checks of a log forwarder
+const (
+	DestinationCheck = "Destination"
+	RegionCheck      = "Region"
+	EncryptionCheck  = "Encryption"
+	PermissionsCheck = "Permissions"
+)
+
+// Check is the result of one of the checks of a log forwarder
+type Check struct {
+	Name    string `json:"name"`
+	Result  string `json:"result"`
+	Message string `json:"message"`
+}
+
+// Verification is the result of the checks of a log forwarder
+type Verification struct {
+	ID          string   `json:"id"`
+	Type        string   `json:"type"`
+	Destination string   `json:"destination"`
+	Checks      []*Check `json:"checks"`
+}
+
+// Failed returns true when one of the checks failed
+func (v *Verification) Failed() bool {
+	for _, check := range v.Checks {
+		if check.Result == CheckFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// Skipped returns true when the check with the given name was skipped
+func (v *Verification) Skipped(name string) bool {
+	for _, check := range v.Checks {
+		if check.Name == name && check.Result == CheckSkipped {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *Verification) add(name string, result string, format string, args ...interface{}) {
+	v.Checks = append(v.Checks, &Check{Name: name, Result: result, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *Verification) skip(message string, names ...string) {
+	for _, name := range names {
+		v.add(name, CheckSkipped, "%s", message)
+	}
+}
+
+// Verifier checks that the destinations of the log forwarders of a cluster exist and that logs can be
+// written to them
+type Verifier struct {
+	// AWSClient is a client for the region of the cluster
+	AWSClient aws.Client
+	// Region is the region of the cluster
+	Region string
+	// S3RoleARN is the role that the writes to the S3 buckets are simulated for, S3 log forwarders don't
+	// have a role of their own
+	S3RoleARN string
+}
+
+// Verify checks the destination of a log forwarder: that it exists, its region, its encryption, and
+// simulates the writes of the role of the log forwarder
+func (v *Verifier) Verify(logForwarder *cmv1.LogForwarder) *Verification {
+	switch {
+	case logForwarder.S3() != nil:
+		return v.verifyS3(logForwarder.ID(), logForwarder.S3())
+	case logForwarder.Cloudwatch() != nil:
+		return v.verifyCloudWatch(logForwarder.ID(), logForwarder.Cloudwatch())
+	}
+	verification := &Verification{ID: logForwarder.ID(), Type: "Unknown"}
+	verification.add(DestinationCheck, CheckFailed, "The log forwarder has no S3 or CloudWatch destination")
+	return verification
+}
+
+func (v *Verifier) verifyS3(id string, config *cmv1.LogForwarderS3Config) *Verification {
+	bucket := config.BucketName()
+	verification := &Verification{ID: id, Type: "S3", Destination: bucket}
+
+	region, err := v.AWSClient.GetBucketRegion(bucket)
+	if err != nil {
+		if errors.GetType(err) == errors.NotFound {
+			verification.add(DestinationCheck, CheckFailed, "Bucket '%s' doesn't exist", bucket)
+		} else {
+			verification.add(DestinationCheck, CheckFailed, "Failed to get bucket '%s': %v", bucket, err)
+		}
+		verification.skip("The bucket couldn't be found", RegionCheck, EncryptionCheck, PermissionsCheck)
+		return verification
+	}
+	verification.add(DestinationCheck, CheckPassed, "Bucket '%s' exists", bucket)
+
+	if region == v.Region {
+		verification.add(RegionCheck, CheckPassed, "Bucket is in the region of the cluster '%s'", region)
+	} else {
+		verification.add(RegionCheck, CheckWarning, "Bucket is in region '%s' and the cluster in region '%s', "+
+			"the logs are transferred across regions", region, v.Region)
+	}
+
+	kmsKeyARN := ""
+	encryption, err := v.AWSClient.GetBucketEncryption(bucket, region)
+	switch {
+	case err != nil:
+		verification.add(EncryptionCheck, CheckWarning, "Failed to get the encryption of the bucket: %v", err)
+	case encryption == nil:
+		verification.add(EncryptionCheck, CheckWarning, "Bucket has no default encryption")
+	case strings.HasPrefix(encryption.Algorithm, "aws:kms"):
+		if encryption.KMSKeyID == "" {
+			verification.add(EncryptionCheck, CheckPassed, "Objects are encrypted with the AWS managed key 'aws/s3'")
+		} else {
+			verification.add(EncryptionCheck, CheckPassed, "Objects are encrypted with KMS key '%s'",
+				encryption.KMSKeyID)
+		}
+		if arn.IsARN(encryption.KMSKeyID) {
+			kmsKeyARN = encryption.KMSKeyID
+		}
+	default:
+		verification.add(EncryptionCheck, CheckPassed, "Objects are encrypted with S3 managed keys (%s)",
+			encryption.Algorithm)
+	}
+
+	if v.S3RoleARN == "" {
+		verification.skip("There is no role to simulate the writes to the bucket for", PermissionsCheck)
+		return verification
+	}
+	roleARN, err := arn.Parse(v.S3RoleARN)
+	if err != nil {
+		verification.add(PermissionsCheck, CheckFailed, "Invalid role ARN '%s': %v", v.S3RoleARN, err)
+		return verification
+	}
+	objectsARN := fmt.Sprintf("arn:%s:s3:::%s/%s*", roleARN.Partition, bucket, config.BucketPrefix())
+	denied, err := v.AWSClient.FindDeniedActions(v.S3RoleARN, []string{"s3:PutObject"}, []string{objectsARN})
+	if err == nil && kmsKeyARN != "" {
+		var deniedKMS []string
+		deniedKMS, err = v.AWSClient.FindDeniedActions(v.S3RoleARN, []string{"kms:GenerateDataKey"},
+			[]string{kmsKeyARN})
+		denied = append(denied, deniedKMS...)
+	}
+	v.addPermissions(verification, v.S3RoleARN, denied, err)
+	return verification
+}
+
+func (v *Verifier) verifyCloudWatch(id string, config *cmv1.LogForwarderCloudWatchConfig) *Verification {
+	logGroupName := config.LogGroupName()
+	verification := &Verification{ID: id, Type: "CloudWatch", Destination: logGroupName}
+
+	logGroup, err := v.AWSClient.GetLogGroup(logGroupName)
+	if err != nil {
+		if errors.GetType(err) == errors.NotFound {
+			verification.add(DestinationCheck, CheckFailed, "Log group '%s' doesn't exist in region '%s'",
+				logGroupName, v.Region)
+		} else {
+			verification.add(DestinationCheck, CheckFailed, "Failed to get log group '%s': %v", logGroupName, err)
+		}
+		verification.skip("The log group couldn't be found", RegionCheck, EncryptionCheck, PermissionsCheck)
+		return verification
+	}
+	verification.add(DestinationCheck, CheckPassed, "Log group '%s' exists", logGroupName)
+	// Log groups are looked up in the region of the cluster, which is the only one logs are forwarded to
+	verification.add(RegionCheck, CheckPassed, "Log group is in the region of the cluster '%s'", v.Region)
+
+	if kmsKeyID := awsSdk.ToString(logGroup.KmsKeyId); kmsKeyID != "" {
+		verification.add(EncryptionCheck, CheckPassed, "Log events are encrypted with KMS key '%s'", kmsKeyID)
+	} else {
+		verification.add(EncryptionCheck, CheckPassed, "Log events are encrypted with CloudWatch Logs managed keys")
+	}
+
+	roleARN := config.LogDistributionRoleArn()
+	if roleARN == "" {
+		verification.add(PermissionsCheck, CheckFailed, "The log forwarder has no log distribution role")
+		return verification
+	}
+	denied, err := v.AWSClient.FindDeniedActions(roleARN, []string{"logs:CreateLogStream", "logs:PutLogEvents"},
+		[]string{awsSdk.ToString(logGroup.Arn)})
+	v.addPermissions(verification, roleARN, denied, err)
+	return verification
+}
+
+func (v *Verifier) addPermissions(verification *Verification, roleARN string, denied []string, err error) {
+	switch {
+	case err != nil:
+		verification.add(PermissionsCheck, CheckFailed, "Failed to simulate the policies of role '%s': %v",
+			roleARN, err)
+	case len(denied) > 0:
+		verification.add(PermissionsCheck, CheckFailed, "Role '%s' isn't allowed to %s", roleARN,
+			strings.Join(denied, ", "))
+	default:
+		verification.add(PermissionsCheck, CheckPassed, "Role '%s' can write to the %s", roleARN,
+			destinationKind(verification.Type))
+	}
+}
+
+func destinationKind(logType string) string {
+	if logType == "S3" {
+		return "bucket"
+	}
+	return "log group"
+}
@@ -0,0 +1,134 @@
+package logforwarding
+
+import (
+	"fmt"
+
+	awsSdk "github.com/aws/aws-sdk-go-v2/aws"
+	cwltypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+const (
+	testRoleARN     = "arn:aws:iam::123456789012:role/log-writer"
+	testLogGroupARN = "arn:aws:logs:us-east-1:123456789012:log-group:cluster-logs:*"
+)
+
+func checkResults(verification *Verification) []string {
+	results := []string{}
+	for _, check := range verification.Checks {
+		results = append(results, fmt.Sprintf("%s %s: %s", check.Name, check.Result, check.Message))
+	}
+	return results
+}
+
+var _ = Describe("Verifier", func() {
+	var awsClient *aws.MockClient
+	var verifier *Verifier
+
+	BeforeEach(func() {
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		verifier = &Verifier{AWSClient: awsClient, Region: "us-east-1"}
+	})
+
+	Context("S3", func() {
+		var logForwarder *cmv1.LogForwarder
+
+		BeforeEach(func() {
+			var err error
+			logForwarder, err = cmv1.NewLogForwarder().ID("s3").
+				S3(cmv1.NewLogForwarderS3Config().BucketName("cluster-logs").BucketPrefix("audit/")).Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Fails when the bucket doesn't exist", func() {
+			awsClient.EXPECT().GetBucketRegion("cluster-logs").
+				Return("", errors.NotFound.Errorf("Bucket 'cluster-logs' not found"))
+			verification := verifier.Verify(logForwarder)
+			Expect(verification.Failed()).To(BeTrue())
+			Expect(checkResults(verification)).To(Equal([]string{
+				"Destination failed: Bucket 'cluster-logs' doesn't exist",
+				"Region skipped: The bucket couldn't be found",
+				"Encryption skipped: The bucket couldn't be found",
+				"Permissions skipped: The bucket couldn't be found",
+			}))
+		})
+
+		It("Warns about the region and skips the permissions without a role", func() {
+			awsClient.EXPECT().GetBucketRegion("cluster-logs").Return("eu-west-1", nil)
+			awsClient.EXPECT().GetBucketEncryption("cluster-logs", "eu-west-1").Return(nil, nil)
+			verification := verifier.Verify(logForwarder)
+			Expect(verification.Failed()).To(BeFalse())
+			Expect(checkResults(verification)).To(Equal([]string{
+				"Destination passed: Bucket 'cluster-logs' exists",
+				"Region warning: Bucket is in region 'eu-west-1' and the cluster in region 'us-east-1', " +
+					"the logs are transferred across regions",
+				"Encryption warning: Bucket has no default encryption",
+				"Permissions skipped: There is no role to simulate the writes to the bucket for",
+			}))
+			Expect(verification.Skipped(PermissionsCheck)).To(BeTrue())
+			Expect(verification.Skipped(DestinationCheck)).To(BeFalse())
+		})
+
+		It("Simulates the writes to the objects and the KMS key of the bucket", func() {
+			keyARN := "arn:aws:kms:us-east-1:123456789012:key/1234abcd"
+			verifier.S3RoleARN = testRoleARN
+			awsClient.EXPECT().GetBucketRegion("cluster-logs").Return("us-east-1", nil)
+			awsClient.EXPECT().GetBucketEncryption("cluster-logs", "us-east-1").
+				Return(&aws.BucketEncryption{Algorithm: "aws:kms", KMSKeyID: keyARN}, nil)
+			awsClient.EXPECT().FindDeniedActions(testRoleARN, []string{"s3:PutObject"},
+				[]string{"arn:aws:s3:::cluster-logs/audit/*"}).Return([]string{}, nil)
+			awsClient.EXPECT().FindDeniedActions(testRoleARN, []string{"kms:GenerateDataKey"},
+				[]string{keyARN}).Return([]string{"kms:GenerateDataKey"}, nil)
+			verification := verifier.Verify(logForwarder)
+			Expect(verification.Failed()).To(BeTrue())
+			Expect(checkResults(verification)).To(Equal([]string{
+				"Destination passed: Bucket 'cluster-logs' exists",
+				"Region passed: Bucket is in the region of the cluster 'us-east-1'",
+				"Encryption passed: Objects are encrypted with KMS key '" + keyARN + "'",
+				"Permissions failed: Role '" + testRoleARN + "' isn't allowed to kms:GenerateDataKey",
+			}))
+		})
+	})
+
+	Context("CloudWatch", func() {
+		var logForwarder *cmv1.LogForwarder
+
+		BeforeEach(func() {
+			var err error
+			logForwarder, err = cmv1.NewLogForwarder().ID("cw").Cloudwatch(cmv1.NewLogForwarderCloudWatchConfig().
+				LogGroupName("cluster-logs").LogDistributionRoleArn(testRoleARN)).Build()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Fails when the log group doesn't exist", func() {
+			awsClient.EXPECT().GetLogGroup("cluster-logs").
+				Return(nil, errors.NotFound.Errorf("Log group 'cluster-logs' not found"))
+			verification := verifier.Verify(logForwarder)
+			Expect(verification.Failed()).To(BeTrue())
+			Expect(verification.Checks[0].Message).To(Equal("Log group 'cluster-logs' doesn't exist in region 'us-east-1'"))
+		})
+
+		It("Simulates the writes of the log distribution role", func() {
+			awsClient.EXPECT().GetLogGroup("cluster-logs").Return(&cwltypes.LogGroup{
+				LogGroupName: awsSdk.String("cluster-logs"),
+				Arn:          awsSdk.String(testLogGroupARN),
+			}, nil)
+			awsClient.EXPECT().FindDeniedActions(testRoleARN, []string{"logs:CreateLogStream", "logs:PutLogEvents"},
+				[]string{testLogGroupARN}).Return([]string{}, nil)
+			verification := verifier.Verify(logForwarder)
+			Expect(verification.Failed()).To(BeFalse())
+			Expect(checkResults(verification)).To(Equal([]string{
+				"Destination passed: Log group 'cluster-logs' exists",
+				"Region passed: Log group is in the region of the cluster 'us-east-1'",
+				"Encryption passed: Log events are encrypted with CloudWatch Logs managed keys",
+				"Permissions passed: Role '" + testRoleARN + "' can write to the log group",
+			}))
+		})
+	})
+})
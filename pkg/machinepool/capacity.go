@@ -0,0 +1,248 @@
+package machinepool
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// ZoneCapacity is the number of nodes of a machine pool in one availability zone
+type ZoneCapacity struct {
+	AvailabilityZone string `json:"availability_zone"`
+	Subnet           string `json:"subnet,omitempty"`
+	Current          int    `json:"current_replicas"`
+	Desired          string `json:"desired_replicas,omitempty"`
+}
+
+// Capacity is the desired and actual size of a machine pool and its status
+type Capacity struct {
+	ID                string         `json:"id"`
+	ClusterID         string         `json:"cluster_id"`
+	Autoscaling       bool           `json:"autoscaling"`
+	Replicas          int            `json:"replicas,omitempty"`
+	MinReplicas       int            `json:"min_replicas,omitempty"`
+	MaxReplicas       int            `json:"max_replicas,omitempty"`
+	CurrentReplicas   int            `json:"current_replicas"`
+	Zones             []ZoneCapacity `json:"zones"`
+	Spot              bool           `json:"spot"`
+	SpotInterruptions *int           `json:"spot_interruptions,omitempty"`
+	State             string         `json:"state,omitempty"`
+	Message           string         `json:"message,omitempty"`
+	ScheduledUpgrade  string         `json:"scheduled_upgrade,omitempty"`
+}
+
+// DesiredReplicas returns the desired replicas of the machine pool, the range of an autoscaling one
+func (c *Capacity) DesiredReplicas() string {
+	if c.Autoscaling {
+		return fmt.Sprintf("%d-%d", c.MinReplicas, c.MaxReplicas)
+	}
+	return fmt.Sprintf("%d", c.Replicas)
+}
+
+// ZoneDistribution returns the current nodes per availability zone, for example 'us-east-1a: 2, us-east-1b: 1'
+func (c *Capacity) ZoneDistribution() string {
+	zones := []string{}
+	for _, zone := range c.Zones {
+		zones = append(zones, fmt.Sprintf("%s: %d", zone.AvailabilityZone, zone.Current))
+	}
+	return strings.Join(zones, ", ")
+}
+
+// NodePoolCapacity returns the capacity of a node pool, or of the node pools of a group spread across
+// subnets, from the status reported by the node pools
+func NodePoolCapacity(clusterID string, group *NodePoolGroup,
+	scheduledUpgrade *cmv1.NodePoolUpgradePolicy) *Capacity {
+	first := group.NodePools[0]
+	capacity := &Capacity{
+		ID:        group.Name,
+		ClusterID: clusterID,
+		Zones:     []ZoneCapacity{},
+		State:     first.Status().State().NodePoolStateValue(),
+		Message:   first.Status().Message(),
+	}
+	_, capacity.Spot = first.AWSNodePool().GetSpotMarketOptions()
+	_, capacity.Autoscaling = first.GetAutoscaling()
+	messages := []string{}
+	for _, nodePool := range group.NodePools {
+		current := nodePool.Status().CurrentReplicas()
+		capacity.CurrentReplicas += current
+		zone := ZoneCapacity{
+			AvailabilityZone: nodePool.AvailabilityZone(),
+			Subnet:           nodePool.Subnet(),
+			Current:          current,
+		}
+		if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+			capacity.MinReplicas += autoscaling.MinReplica()
+			capacity.MaxReplicas += autoscaling.MaxReplica()
+			zone.Desired = fmt.Sprintf("%d-%d", autoscaling.MinReplica(), autoscaling.MaxReplica())
+		} else {
+			capacity.Replicas += nodePool.Replicas()
+			zone.Desired = fmt.Sprintf("%d", nodePool.Replicas())
+		}
+		capacity.Zones = append(capacity.Zones, zone)
+		if group.IsSpread() && nodePool.Status().Message() != "" {
+			messages = append(messages, fmt.Sprintf("%s: %s", nodePool.ID(), nodePool.Status().Message()))
+		}
+	}
+	if group.IsSpread() {
+		capacity.Message = strings.Join(messages, "; ")
+	}
+	if scheduledUpgrade != nil && scheduledUpgrade.State() != nil && scheduledUpgrade.Version() != "" {
+		capacity.ScheduledUpgrade = fmt.Sprintf("%s %s on %s", scheduledUpgrade.State().Value(),
+			scheduledUpgrade.Version(), scheduledUpgrade.NextRun().Format("2006-01-02 15:04 MST"))
+	}
+	return capacity
+}
+
+// MachinePoolCapacity returns the capacity of a classic machine pool from its running EC2 instances,
+// classic machine pools don't report their status
+func MachinePoolCapacity(clusterID string, machinePool *cmv1.MachinePool, instances []ec2types.Instance,
+	spotInterruptions *int) *Capacity {
+	capacity := &Capacity{
+		ID:                machinePool.ID(),
+		ClusterID:         clusterID,
+		CurrentReplicas:   len(instances),
+		Zones:             []ZoneCapacity{},
+		SpotInterruptions: spotInterruptions,
+	}
+	_, capacity.Spot = machinePool.AWS().GetSpotMarketOptions()
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		capacity.Autoscaling = true
+		capacity.MinReplicas = autoscaling.MinReplicas()
+		capacity.MaxReplicas = autoscaling.MaxReplicas()
+	} else {
+		capacity.Replicas = machinePool.Replicas()
+	}
+
+	current := map[string]int{}
+	for _, instance := range instances {
+		if instance.Placement != nil {
+			current[awssdk.ToString(instance.Placement.AvailabilityZone)]++
+		}
+	}
+	zones := append([]string{}, machinePool.AvailabilityZones()...)
+	for zone := range current {
+		if !helper.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+	sort.Strings(zones)
+	// Replicas of multi-AZ machine pools are split evenly between the zones
+	shares := map[string]string{}
+	if !capacity.Autoscaling && len(machinePool.AvailabilityZones()) > 0 {
+		desired := DistributeReplicas(capacity.Replicas, len(machinePool.AvailabilityZones()))
+		sortedZones := append([]string{}, machinePool.AvailabilityZones()...)
+		sort.Strings(sortedZones)
+		for i, zone := range sortedZones {
+			shares[zone] = fmt.Sprintf("%d", desired[i])
+		}
+	}
+	for _, zone := range zones {
+		capacity.Zones = append(capacity.Zones, ZoneCapacity{
+			AvailabilityZone: zone,
+			Current:          current[zone],
+			Desired:          shares[zone],
+		})
+	}
+	return capacity
+}
+
+// GetNodePoolCapacity returns the capacity of a node pool, or of the node pools of the spread group with
+// the given name
+func GetNodePoolCapacity(ocmClient *ocm.Client, cluster *cmv1.Cluster, clusterKey string,
+	nodePoolId string) (*Capacity, error) {
+	nodePools, err := ocmClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	group := FindNodePoolGroup(nodePools, nodePoolId)
+	if group == nil {
+		for _, nodePool := range nodePools {
+			if nodePool.ID() == nodePoolId {
+				group = &NodePoolGroup{Name: nodePool.ID(), NodePools: []*cmv1.NodePool{nodePool}}
+			}
+		}
+	}
+	if group == nil {
+		return nil, fmt.Errorf(notFoundMessage, nodePoolId)
+	}
+	_, scheduledUpgrade, err := ocmClient.GetHypershiftNodePoolUpgrade(cluster.ID(), clusterKey,
+		group.NodePools[0].ID())
+	if err != nil {
+		return nil, err
+	}
+	return NodePoolCapacity(cluster.ID(), group, scheduledUpgrade), nil
+}
+
+// GetMachinePoolCapacity returns the capacity of a classic machine pool, counting its EC2 instances
+func GetMachinePoolCapacity(ocmClient *ocm.Client, awsClient aws.Client, cluster *cmv1.Cluster,
+	machinePoolId string) (*Capacity, error) {
+	machinePool, exists, err := ocmClient.GetMachinePool(cluster.ID(), machinePoolId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf(notFoundMessage, machinePoolId)
+	}
+	instances, spotInterruptions, err := getMachinePoolInstances(awsClient, cluster, machinePool)
+	if err != nil {
+		return nil, err
+	}
+	return MachinePoolCapacity(cluster.ID(), machinePool, instances, spotInterruptions), nil
+}
+
+// getMachinePoolInstances returns the running instances of the machine pool and, for spot machine pools,
+// the recent spot interruptions. The interruptions are left unknown when they can't be counted.
+func getMachinePoolInstances(awsClient aws.Client, cluster *cmv1.Cluster,
+	machinePool *cmv1.MachinePool) ([]ec2types.Instance, *int, error) {
+	infraID := cluster.InfraID()
+	instances, err := awsClient.ListMachinePoolInstances(infraID, machinePool.ID())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the instances of machine pool '%s': %v", machinePool.ID(), err)
+	}
+	if _, ok := machinePool.AWS().GetSpotMarketOptions(); !ok {
+		return instances, nil, nil
+	}
+	interruptions, err := awsClient.CountMachinePoolSpotInterruptions(infraID, machinePool.ID())
+	if err != nil {
+		return instances, nil, nil
+	}
+	return instances, &interruptions, nil
+}
+
+// GetMachinePoolCapacities returns the capacity of each classic machine pool of the list, by ID
+func GetMachinePoolCapacities(awsClient aws.Client, cluster *cmv1.Cluster,
+	machinePools []*cmv1.MachinePool) (map[string]*Capacity, error) {
+	capacities := map[string]*Capacity{}
+	for _, machinePool := range machinePools {
+		instances, spotInterruptions, err := getMachinePoolInstances(awsClient, cluster, machinePool)
+		if err != nil {
+			return nil, err
+		}
+		capacities[machinePool.ID()] = MachinePoolCapacity(cluster.ID(), machinePool, instances, spotInterruptions)
+	}
+	return capacities, nil
+}
+
+// GetNodePoolCapacities returns the capacity of each node pool group of the list, by group name
+func GetNodePoolCapacities(ocmClient *ocm.Client, cluster *cmv1.Cluster, clusterKey string,
+	nodePools []*cmv1.NodePool) (map[string]*Capacity, error) {
+	capacities := map[string]*Capacity{}
+	for _, group := range GroupNodePools(nodePools) {
+		_, scheduledUpgrade, err := ocmClient.GetHypershiftNodePoolUpgrade(cluster.ID(), clusterKey,
+			group.NodePools[0].ID())
+		if err != nil {
+			return nil, err
+		}
+		capacities[group.Name] = NodePoolCapacity(cluster.ID(), group, scheduledUpgrade)
+	}
+	return capacities, nil
+}
@@ -0,0 +1,70 @@
+package machinepool
+
+import (
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Machine pool capacity", func() {
+	instance := func(zone string) ec2types.Instance {
+		return ec2types.Instance{Placement: &ec2types.Placement{AvailabilityZone: awssdk.String(zone)}}
+	}
+
+	It("Aggregates the node pools of a spread group", func() {
+		nodePool := func(id string, zone string, replicas int, current int, message string) *cmv1.NodePool {
+			nodePool, err := cmv1.NewNodePool().ID(id).AvailabilityZone(zone).Subnet("subnet-" + id).
+				Replicas(replicas).Labels(map[string]string{SpreadGroupLabel: "workers"}).
+				Status(cmv1.NewNodePoolStatus().CurrentReplicas(current).Message(message).
+					State(cmv1.NewNodePoolState().NodePoolStateValue("ready"))).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			return nodePool
+		}
+		group := &NodePoolGroup{
+			Name: "workers",
+			NodePools: []*cmv1.NodePool{
+				nodePool("workers-a", "us-east-1a", 2, 2, ""),
+				nodePool("workers-b", "us-east-1b", 1, 0, "Waiting for capacity"),
+			},
+		}
+		capacity := NodePoolCapacity("cluster-1", group, nil)
+		Expect(capacity.ID).To(Equal("workers"))
+		Expect(capacity.DesiredReplicas()).To(Equal("3"))
+		Expect(capacity.CurrentReplicas).To(Equal(2))
+		Expect(capacity.State).To(Equal("ready"))
+		Expect(capacity.Message).To(Equal("workers-b: Waiting for capacity"))
+		Expect(capacity.ZoneDistribution()).To(Equal("us-east-1a: 2, us-east-1b: 0"))
+	})
+
+	It("Counts the instances of a classic machine pool per zone", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").Replicas(5).
+			AvailabilityZones("us-east-1b", "us-east-1a", "us-east-1c").
+			AWS(cmv1.NewAWSMachinePool().SpotMarketOptions(cmv1.NewAWSSpotMarketOptions())).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		interruptions := 2
+		capacity := MachinePoolCapacity("cluster-1", machinePool, []ec2types.Instance{
+			instance("us-east-1a"), instance("us-east-1a"), instance("us-east-1b"),
+		}, &interruptions)
+		Expect(capacity.CurrentReplicas).To(Equal(3))
+		Expect(capacity.Zones).To(Equal([]ZoneCapacity{
+			{AvailabilityZone: "us-east-1a", Current: 2, Desired: "2"},
+			{AvailabilityZone: "us-east-1b", Current: 1, Desired: "2"},
+			{AvailabilityZone: "us-east-1c", Current: 0, Desired: "1"},
+		}))
+	})
+
+	It("Doesn't split the replicas of autoscaling machine pools", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(1).MaxReplicas(4)).
+			AvailabilityZones("us-east-1a").
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		capacity := MachinePoolCapacity("cluster-1", machinePool, []ec2types.Instance{instance("us-east-1a")}, nil)
+		Expect(capacity.DesiredReplicas()).To(Equal("1-4"))
+		Expect(capacity.Zones).To(Equal([]ZoneCapacity{{AvailabilityZone: "us-east-1a", Current: 1}}))
+	})
+})
@@ -0,0 +1,27 @@
+package machinepool
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CloneSource identifies the machine pool that a new machine pool is copied from
+type CloneSource struct {
+	ClusterKey    string
+	MachinePoolID string
+}
+
+// ParseCloneSource parses a '<cluster>/<machine-pool>' reference. The cluster can be omitted to copy a
+// machine pool of the target cluster.
+func ParseCloneSource(from string, clusterKey string) (*CloneSource, error) {
+	source := &CloneSource{ClusterKey: clusterKey, MachinePoolID: from}
+	if index := strings.LastIndex(from, "/"); index >= 0 {
+		source.ClusterKey = from[:index]
+		source.MachinePoolID = from[index+1:]
+	}
+	if source.ClusterKey == "" || !MachinePoolKeyRE.MatchString(source.MachinePoolID) {
+		return nil, fmt.Errorf("expected a machine pool reference in the format '<cluster>/<machine-pool>', "+
+			"got '%s'", from)
+	}
+	return source, nil
+}
@@ -0,0 +1,30 @@
+package machinepool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clone machine pool", func() {
+	Context("ParseCloneSource", func() {
+		It("Parses a machine pool of another cluster", func() {
+			source, err := ParseCloneSource("other/workers", "mycluster")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.ClusterKey).To(Equal("other"))
+			Expect(source.MachinePoolID).To(Equal("workers"))
+		})
+
+		It("Defaults to the target cluster", func() {
+			source, err := ParseCloneSource("workers", "mycluster")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.ClusterKey).To(Equal("mycluster"))
+		})
+
+		It("Rejects invalid references", func() {
+			_, err := ParseCloneSource("/workers", "mycluster")
+			Expect(err).To(MatchError(ContainSubstring("expected a machine pool reference")))
+			_, err = ParseCloneSource("other/", "mycluster")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
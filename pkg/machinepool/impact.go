@@ -0,0 +1,365 @@
+package machinepool
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper/diff"
+)
+
+// EditImpactArgs limit an edit of a machine pool by its impact on the nodes
+type EditImpactArgs struct {
+	// DryRun reports the impact without updating the machine pool
+	DryRun bool
+	// MaxDisruption is the number or percentage of nodes that can be unavailable at the same time, empty
+	// when the edit isn't limited
+	MaxDisruption string
+}
+
+// EditImpact is the change an edit makes to a machine pool and its effect on the nodes
+type EditImpact struct {
+	MachinePool     string       `json:"machine_pool"`
+	Changes         diff.Changes `json:"changes"`
+	CurrentNodes    int          `json:"current_nodes"`
+	RemovedNodes    int          `json:"removed_nodes"`
+	NodesRecreated  bool         `json:"nodes_recreated"`
+	RecreateReasons []string     `json:"recreate_reasons,omitempty"`
+	RemovedLabels   []string     `json:"removed_labels,omitempty"`
+	RemovedTaints   []string     `json:"removed_taints,omitempty"`
+	DiskSizeChanged bool         `json:"disk_size_changed"`
+	// MaxUnavailable is the number of nodes that are unavailable at the same time while they are recreated
+	MaxUnavailable int `json:"max_unavailable"`
+}
+
+// Unavailable returns the number of nodes the edit makes unavailable at the same time, the nodes removed
+// by a scale down and the nodes being recreated
+func (i *EditImpact) Unavailable() int {
+	unavailable := i.RemovedNodes
+	if i.NodesRecreated {
+		unavailable += i.MaxUnavailable
+	}
+	return min(unavailable, i.CurrentNodes)
+}
+
+// poolState holds the attributes of a machine pool compared by the edit impact
+type poolState struct {
+	replicas             string
+	minNodes             int
+	maxNodes             int
+	labels               map[string]string
+	taints               []string
+	diskSize             string
+	autoRepair           string
+	tuningConfigs        []string
+	kubeletConfigs       []string
+	nodeDrainGracePeriod string
+	maxSurge             string
+	maxUnavailable       string
+}
+
+func machinePoolState(machinePool *cmv1.MachinePool) *poolState {
+	state := &poolState{
+		labels: machinePool.Labels(),
+		taints: formatTaints(machinePool.Taints()),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		state.minNodes = autoscaling.MinReplicas()
+		state.maxNodes = autoscaling.MaxReplicas()
+		state.replicas = fmt.Sprintf("%d-%d (autoscaling)", state.minNodes, state.maxNodes)
+	} else {
+		state.minNodes = machinePool.Replicas()
+		state.maxNodes = machinePool.Replicas()
+		state.replicas = fmt.Sprintf("%d", state.minNodes)
+	}
+	if size, ok := machinePool.RootVolume().AWS().GetSize(); ok {
+		state.diskSize = fmt.Sprintf("%d GiB", size)
+	}
+	return state
+}
+
+func nodePoolState(nodePool *cmv1.NodePool) *poolState {
+	state := &poolState{
+		labels:         nodePool.Labels(),
+		taints:         formatTaints(nodePool.Taints()),
+		autoRepair:     strconv.FormatBool(nodePool.AutoRepair()),
+		tuningConfigs:  nodePool.TuningConfigs(),
+		kubeletConfigs: nodePool.KubeletConfigs(),
+		maxSurge:       nodePool.ManagementUpgrade().MaxSurge(),
+		maxUnavailable: nodePool.ManagementUpgrade().MaxUnavailable(),
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		state.minNodes = autoscaling.MinReplica()
+		state.maxNodes = autoscaling.MaxReplica()
+		state.replicas = fmt.Sprintf("%d-%d (autoscaling)", state.minNodes, state.maxNodes)
+	} else {
+		state.minNodes = nodePool.Replicas()
+		state.maxNodes = nodePool.Replicas()
+		state.replicas = fmt.Sprintf("%d", state.minNodes)
+	}
+	if size, ok := nodePool.AWSNodePool().RootVolume().GetSize(); ok {
+		state.diskSize = fmt.Sprintf("%d GiB", size)
+	}
+	if period, ok := nodePool.GetNodeDrainGracePeriod(); ok {
+		state.nodeDrainGracePeriod = fmt.Sprintf("%d %s", int(period.Value()), period.Unit())
+	}
+	return state
+}
+
+// machinePoolEditImpact compares a classic machine pool with the update sent by an edit. Classic machine
+// pools don't report their nodes, the current nodes are the desired replicas or the autoscaling minimum.
+func machinePoolEditImpact(original *cmv1.MachinePool, update *cmv1.MachinePool) *EditImpact {
+	before := machinePoolState(original)
+	after := machinePoolState(original)
+	if autoscaling, ok := update.GetAutoscaling(); ok {
+		after.minNodes = autoscaling.MinReplicas()
+		after.maxNodes = autoscaling.MaxReplicas()
+		after.replicas = fmt.Sprintf("%d-%d (autoscaling)", after.minNodes, after.maxNodes)
+	} else if replicas, ok := update.GetReplicas(); ok {
+		after.minNodes = replicas
+		after.maxNodes = replicas
+		after.replicas = fmt.Sprintf("%d", replicas)
+	}
+	if labels, ok := update.GetLabels(); ok {
+		after.labels = labels
+	}
+	if taints, ok := update.GetTaints(); ok {
+		after.taints = formatTaints(taints)
+	}
+	if size, ok := update.RootVolume().AWS().GetSize(); ok {
+		after.diskSize = fmt.Sprintf("%d GiB", size)
+	}
+	return newEditImpact(original.ID(), before, after, before.minNodes)
+}
+
+// nodePoolEditImpact compares a node pool with the update sent by an edit
+func nodePoolEditImpact(original *cmv1.NodePool, update *cmv1.NodePool) *EditImpact {
+	before := nodePoolState(original)
+	after := nodePoolState(original)
+	if autoscaling, ok := update.GetAutoscaling(); ok {
+		after.minNodes = autoscaling.MinReplica()
+		after.maxNodes = autoscaling.MaxReplica()
+		after.replicas = fmt.Sprintf("%d-%d (autoscaling)", after.minNodes, after.maxNodes)
+	} else if replicas, ok := update.GetReplicas(); ok {
+		after.minNodes = replicas
+		after.maxNodes = replicas
+		after.replicas = fmt.Sprintf("%d", replicas)
+	}
+	if labels, ok := update.GetLabels(); ok {
+		after.labels = labels
+	}
+	if taints, ok := update.GetTaints(); ok {
+		after.taints = formatTaints(taints)
+	}
+	if autoRepair, ok := update.GetAutoRepair(); ok {
+		after.autoRepair = strconv.FormatBool(autoRepair)
+	}
+	if tuningConfigs, ok := update.GetTuningConfigs(); ok {
+		after.tuningConfigs = tuningConfigs
+	}
+	if kubeletConfigs, ok := update.GetKubeletConfigs(); ok {
+		after.kubeletConfigs = kubeletConfigs
+	}
+	if size, ok := update.AWSNodePool().RootVolume().GetSize(); ok {
+		after.diskSize = fmt.Sprintf("%d GiB", size)
+	}
+	if period, ok := update.GetNodeDrainGracePeriod(); ok {
+		after.nodeDrainGracePeriod = fmt.Sprintf("%d %s", int(period.Value()), period.Unit())
+	}
+	if managementUpgrade, ok := update.GetManagementUpgrade(); ok {
+		if maxSurge, ok := managementUpgrade.GetMaxSurge(); ok {
+			after.maxSurge = maxSurge
+		}
+		if maxUnavailable, ok := managementUpgrade.GetMaxUnavailable(); ok {
+			after.maxUnavailable = maxUnavailable
+		}
+	}
+
+	current := original.Status().CurrentReplicas()
+	if _, ok := original.GetStatus(); !ok {
+		current = before.minNodes
+	}
+	impact := newEditImpact(original.ID(), before, after, current)
+	if !equalConfigs(before.kubeletConfigs, after.kubeletConfigs) {
+		impact.RecreateReasons = append(impact.RecreateReasons, "the kubelet configs change")
+	}
+	if !equalConfigs(before.tuningConfigs, after.tuningConfigs) {
+		impact.RecreateReasons = append(impact.RecreateReasons, "the tuning configs change")
+	}
+	impact.NodesRecreated = len(impact.RecreateReasons) > 0
+	// Node pools replace their nodes with surge nodes, only 'max-unavailable' nodes are drained at once
+	impact.MaxUnavailable = resolveNodeCount(after.maxUnavailable, max(current-impact.RemovedNodes, 0))
+	return impact
+}
+
+func newEditImpact(id string, before *poolState, after *poolState, current int) *EditImpact {
+	impact := &EditImpact{
+		MachinePool:  id,
+		Changes:      diff.Changes{},
+		CurrentNodes: current,
+		RemovedNodes: max(current-after.maxNodes, 0),
+	}
+	impact.Changes.Add("Replicas", before.replicas, after.replicas)
+	impact.Changes.Add("Labels", formatLabels(before.labels), formatLabels(after.labels))
+	impact.Changes.Add("Taints", strings.Join(before.taints, ", "), strings.Join(after.taints, ", "))
+	impact.Changes.Add("Disk size", before.diskSize, after.diskSize)
+	impact.Changes.Add("Autorepair", before.autoRepair, after.autoRepair)
+	impact.Changes.Add("Tuning configs", strings.Join(before.tuningConfigs, ", "),
+		strings.Join(after.tuningConfigs, ", "))
+	impact.Changes.Add("Kubelet configs", strings.Join(before.kubeletConfigs, ", "),
+		strings.Join(after.kubeletConfigs, ", "))
+	impact.Changes.Add("Node drain grace period", before.nodeDrainGracePeriod, after.nodeDrainGracePeriod)
+	impact.Changes.Add("Max surge", before.maxSurge, after.maxSurge)
+	impact.Changes.Add("Max unavailable", before.maxUnavailable, after.maxUnavailable)
+
+	for key := range before.labels {
+		if _, ok := after.labels[key]; !ok {
+			impact.RemovedLabels = append(impact.RemovedLabels, key)
+		}
+	}
+	sort.Strings(impact.RemovedLabels)
+	for _, taint := range before.taints {
+		if !slices.Contains(after.taints, taint) {
+			impact.RemovedTaints = append(impact.RemovedTaints, taint)
+		}
+	}
+	if after.diskSize != before.diskSize {
+		impact.DiskSizeChanged = true
+		impact.RecreateReasons = append(impact.RecreateReasons, "the disk size changes")
+		impact.NodesRecreated = true
+	}
+	return impact
+}
+
+// ImpactOutput returns the before/after diff of the machine pool and the impact statements
+func ImpactOutput(impact *EditImpact) string {
+	result := fmt.Sprintf("Changes to machine pool '%s':\n", impact.MachinePool)
+	result += impact.Changes.Format(25)
+
+	statements := []string{}
+	if impact.RemovedNodes > 0 {
+		statements = append(statements, fmt.Sprintf("%d of the %d current nodes will be removed",
+			impact.RemovedNodes, impact.CurrentNodes))
+	}
+	if impact.NodesRecreated {
+		statement := fmt.Sprintf("The nodes will be recreated because %s",
+			strings.Join(impact.RecreateReasons, " and "))
+		if impact.MaxUnavailable > 0 {
+			statement += fmt.Sprintf(", %d at a time", impact.MaxUnavailable)
+		}
+		statements = append(statements, statement)
+	}
+	for _, label := range impact.RemovedLabels {
+		statements = append(statements, fmt.Sprintf("Label '%s' will be removed, workloads that select it "+
+			"can no longer be scheduled on this machine pool", label))
+	}
+	for _, taint := range impact.RemovedTaints {
+		statements = append(statements, fmt.Sprintf("Taint '%s' will be removed, workloads that don't "+
+			"tolerate it can be scheduled on this machine pool", taint))
+	}
+	result += "\nImpact:\n"
+	if len(statements) == 0 {
+		result += "  No nodes will be disrupted\n"
+	}
+	for _, statement := range statements {
+		result += fmt.Sprintf("  - %s\n", statement)
+	}
+	result += fmt.Sprintf("  Nodes unavailable at the same time: %d of %d\n", impact.Unavailable(),
+		impact.CurrentNodes)
+	return result
+}
+
+// checkEditImpact refuses edits that make more nodes unavailable at the same time than the maximum
+// disruption, if one is given. It returns false when the machine pool must not be updated, a dry run or
+// a refused edit.
+func checkEditImpact(impact *EditImpact, args EditImpactArgs) (bool, error) {
+	if args.MaxDisruption != "" {
+		allowed, err := ParseMaxDisruption(args.MaxDisruption, impact.CurrentNodes)
+		if err != nil {
+			return false, err
+		}
+		if impact.Unavailable() > allowed {
+			return false, fmt.Errorf("the edit would make %d of the %d nodes of machine pool '%s' unavailable "+
+				"at the same time, which exceeds the maximum disruption of %d", impact.Unavailable(),
+				impact.CurrentNodes, impact.MachinePool, allowed)
+		}
+	}
+	return !args.DryRun, nil
+}
+
+// ParseMaxDisruption returns the number of nodes allowed to be unavailable, the value is a number of nodes
+// or a percentage of the current nodes, rounded down
+func ParseMaxDisruption(value string, currentNodes int) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, fmt.Errorf("expected a number of nodes or a percentage for the maximum disruption")
+	}
+	if strings.HasSuffix(value, "%") {
+		percent, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+		if err != nil || percent < 0 || percent > 100 {
+			return 0, fmt.Errorf("the maximum disruption '%s' must be a percentage between 0%% and 100%%", value)
+		}
+		return currentNodes * percent / 100, nil
+	}
+	nodes, err := strconv.Atoi(value)
+	if err != nil || nodes < 0 {
+		return 0, fmt.Errorf("the maximum disruption '%s' must be a non-negative number of nodes or a "+
+			"percentage", value)
+	}
+	return nodes, nil
+}
+
+// resolveNodeCount resolves a number of nodes or a percentage of the total nodes, rounded down like the
+// 'max-unavailable' of node pool upgrades
+func resolveNodeCount(value string, total int) int {
+	value = strings.TrimSpace(value)
+	if strings.HasSuffix(value, "%") {
+		percent, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+		if err != nil {
+			return 0
+		}
+		return total * percent / 100
+	}
+	nodes, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return nodes
+}
+
+func formatTaints(taints []*cmv1.Taint) []string {
+	result := []string{}
+	for _, taint := range taints {
+		result = append(result, fmt.Sprintf("%s=%s:%s", taint.Key(), taint.Value(), taint.Effect()))
+	}
+	return result
+}
+
+func formatLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := []string{}
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, labels[key]))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func equalConfigs(before []string, after []string) bool {
+	if len(before) != len(after) {
+		return false
+	}
+	for _, config := range after {
+		if !slices.Contains(before, config) {
+			return false
+		}
+	}
+	return true
+}
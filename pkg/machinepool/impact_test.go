@@ -0,0 +1,112 @@
+package machinepool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper/diff"
+)
+
+var _ = Describe("Machine pool edit impact", func() {
+	It("Computes the nodes removed and the labels and taints removed from a classic machine pool", func() {
+		original, err := cmv1.NewMachinePool().ID("mp1").Replicas(6).
+			Labels(map[string]string{"app": "db", "tier": "data"}).
+			Taints(cmv1.NewTaint().Key("dedicated").Value("db").Effect("NoSchedule")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		update, err := cmv1.NewMachinePool().ID("mp1").Replicas(3).
+			Labels(map[string]string{"tier": "data"}).
+			Taints().
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		impact := machinePoolEditImpact(original, update)
+		Expect(impact.CurrentNodes).To(Equal(6))
+		Expect(impact.RemovedNodes).To(Equal(3))
+		Expect(impact.NodesRecreated).To(BeFalse())
+		Expect(impact.RemovedLabels).To(Equal([]string{"app"}))
+		Expect(impact.RemovedTaints).To(Equal([]string{"dedicated=db:NoSchedule"}))
+		Expect(impact.Unavailable()).To(Equal(3))
+		Expect(impact.Changes).To(Equal(diff.Changes{
+			{Field: "Replicas", Before: "6", After: "3"},
+			{Field: "Labels", Before: "app=db, tier=data", After: "tier=data"},
+			{Field: "Taints", Before: "dedicated=db:NoSchedule", After: ""},
+		}))
+
+		out := ImpactOutput(impact)
+		Expect(out).To(ContainSubstring("  Replicas:                6 -> 3\n"))
+		Expect(out).To(ContainSubstring("  Taints:                  dedicated=db:NoSchedule -> -\n"))
+		Expect(out).To(ContainSubstring("  - 3 of the 6 current nodes will be removed\n"))
+		Expect(out).To(ContainSubstring("Label 'app' will be removed"))
+		Expect(out).To(ContainSubstring("  Nodes unavailable at the same time: 3 of 6\n"))
+	})
+
+	It("Computes the nodes recreated by a kubelet config change of a node pool", func() {
+		original, err := cmv1.NewNodePool().ID("np1").Replicas(4).
+			Status(cmv1.NewNodePoolStatus().CurrentReplicas(4)).
+			ManagementUpgrade(cmv1.NewNodePoolManagementUpgrade().MaxUnavailable("50%")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		update, err := cmv1.NewNodePool().ID("np1").Replicas(4).KubeletConfigs("high-pods").Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		impact := nodePoolEditImpact(original, update)
+		Expect(impact.NodesRecreated).To(BeTrue())
+		Expect(impact.RecreateReasons).To(Equal([]string{"the kubelet configs change"}))
+		Expect(impact.MaxUnavailable).To(Equal(2))
+		Expect(impact.Unavailable()).To(Equal(2))
+		Expect(ImpactOutput(impact)).To(ContainSubstring(
+			"  - The nodes will be recreated because the kubelet configs change, 2 at a time\n"))
+	})
+
+	It("Doesn't report an impact for unchanged node pools", func() {
+		original, err := cmv1.NewNodePool().ID("np1").Replicas(2).AutoRepair(true).Build()
+		Expect(err).NotTo(HaveOccurred())
+		update, err := cmv1.NewNodePool().ID("np1").Replicas(2).AutoRepair(true).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		impact := nodePoolEditImpact(original, update)
+		Expect(impact.Changes).To(BeEmpty())
+		Expect(impact.Unavailable()).To(Equal(0))
+		Expect(ImpactOutput(impact)).To(ContainSubstring("  No nodes will be disrupted\n"))
+	})
+
+	It("Parses the maximum disruption", func() {
+		Expect(ParseMaxDisruption("2", 10)).To(Equal(2))
+		Expect(ParseMaxDisruption("25%", 10)).To(Equal(2))
+		_, err := ParseMaxDisruption("-1", 10)
+		Expect(err).To(MatchError(ContainSubstring("must be a non-negative number of nodes")))
+		_, err = ParseMaxDisruption("150%", 10)
+		Expect(err).To(MatchError(ContainSubstring("must be a percentage between 0% and 100%")))
+	})
+
+	Context("checkEditImpact", func() {
+		impact := &EditImpact{MachinePool: "mp1", CurrentNodes: 6, RemovedNodes: 3}
+
+		It("Updates the machine pool when no guard is set", func() {
+			proceed, err := checkEditImpact(impact, EditImpactArgs{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proceed).To(BeTrue())
+		})
+
+		It("Refuses edits that exceed the maximum disruption", func() {
+			proceed, err := checkEditImpact(impact, EditImpactArgs{MaxDisruption: "2"})
+			Expect(err).To(MatchError("the edit would make 3 of the 6 nodes of machine pool 'mp1' unavailable " +
+				"at the same time, which exceeds the maximum disruption of 2"))
+			Expect(proceed).To(BeFalse())
+		})
+
+		It("Accepts edits within the maximum disruption", func() {
+			proceed, err := checkEditImpact(impact, EditImpactArgs{MaxDisruption: "50%"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proceed).To(BeTrue())
+		})
+
+		It("Doesn't update the machine pool on a dry run", func() {
+			proceed, err := checkEditImpact(impact, EditImpactArgs{DryRun: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proceed).To(BeFalse())
+		})
+	})
+})
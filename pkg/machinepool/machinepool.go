@@ -51,6 +51,7 @@ type ListMachinePoolArgs struct {
 	ShowDedicated bool
 	ShowWindowsLI bool
 	ShowAll       bool
+	ShowWide      bool
 }
 
 type MachinePoolService interface {
@@ -58,10 +59,16 @@ type MachinePoolService interface {
 	ListMachinePools(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster, args ListMachinePoolArgs) error
 	DeleteMachinePool(r *rosa.Runtime, machinePoolId string, clusterKey string, cluster *cmv1.Cluster) error
 	EditMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string, cluster *cmv1.Cluster,
-		r *rosa.Runtime) error
+		r *rosa.Runtime, impactArgs EditImpactArgs) (*EditImpact, error)
 	CreateMachinePoolBasedOnClusterType(r *rosa.Runtime, cmd *cobra.Command,
 		clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
 		options *mpOpts.CreateMachinepoolUserOptions) error
+	BuildMachinePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster,
+		args *mpOpts.CreateMachinepoolUserOptions) (*cmv1.MachinePool, error)
+	BuildNodePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster,
+		clusterAutoscaler *cmv1.ClusterAutoscaler, args *mpOpts.CreateMachinepoolUserOptions) (*cmv1.NodePool, error)
+	BuildNodePoolEdit(r *rosa.Runtime, cmd *cobra.Command, nodePool *cmv1.NodePool,
+		cluster *cmv1.Cluster) (*cmv1.NodePool, error)
 }
 
 type machinePool struct {
@@ -102,38 +109,66 @@ func (m machinePool) CreateMachinePoolBasedOnClusterType(r *rosa.Runtime,
 
 func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster,
 	args *mpOpts.CreateMachinepoolUserOptions) error {
+	machinePool, err := m.BuildMachinePool(r, cmd, clusterKey, cluster, args)
+	if err != nil {
+		return err
+	}
+
+	createdMachinePool, err := r.OCMClient.CreateMachinePool(cluster.ID(), machinePool)
+	if err != nil {
+		return fmt.Errorf("failed to add machine pool to cluster '%s': %v", clusterKey, err)
+	}
+
+	if output.HasFlag() {
+		if err = output.Print(createdMachinePool); err != nil {
+			return fmt.Errorf("unable to print machine pool: %v", err)
+		}
+	} else {
+		r.Reporter.Infof("Machine pool '%s' created successfully on cluster '%s'", machinePool.ID(), clusterKey)
+		r.Reporter.Infof("To view the machine pool details, run 'rosa describe machinepool --cluster %s --machinepool %s'",
+			clusterKey, machinePool.ID())
+		r.Reporter.Infof("To view all machine pools, run 'rosa list machinepools --cluster %s'", clusterKey)
+	}
+
+	return nil
+}
+
+// BuildMachinePool validates the flags of a classic machine pool, asking for the missing values in
+// interactive mode, and returns the machine pool to create
+func (m *machinePool) BuildMachinePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string,
+	cluster *cmv1.Cluster, args *mpOpts.CreateMachinepoolUserOptions) (*cmv1.MachinePool, error) {
 
 	if cmd.Flags().Changed("capacity-reservation-id") || cmd.Flags().Changed("capacity-reservation-preference") {
-		return fmt.Errorf("setting the 'capacity-reservation-id' or 'capacity-reservation-preference' flags " +
+		return nil, fmt.Errorf("setting the 'capacity-reservation-id' or 'capacity-reservation-preference' flags " +
 			"is only allowed for Hosted Control Plane clusters")
 	}
 
 	// Validate flags that are only allowed for multi-AZ clusters
 	isMultiAvailabilityZoneSet := cmd.Flags().Changed("multi-availability-zone")
 	if isMultiAvailabilityZoneSet && !cluster.MultiAZ() {
-		return fmt.Errorf("setting the `multi-availability-zone` flag is only allowed for multi-AZ clusters")
+		return nil, fmt.Errorf("setting the `multi-availability-zone` flag is only allowed for multi-AZ clusters")
 	}
 	isAvailabilityZoneSet := cmd.Flags().Changed("availability-zone")
 	if isAvailabilityZoneSet && !cluster.MultiAZ() {
-		return fmt.Errorf("setting the `availability-zone` flag is only allowed for multi-AZ clusters")
+		return nil, fmt.Errorf("setting the `availability-zone` flag is only allowed for multi-AZ clusters")
 	}
 
 	// Validate flags that are only allowed for BYOVPC cluster
 	isSubnetSet := cmd.Flags().Changed("subnet")
 	isByoVpc := helper.IsBYOVPC(cluster)
 	if !isByoVpc && isSubnetSet {
-		return fmt.Errorf("setting the `subnet` flag is only allowed for BYO VPC clusters")
+		return nil, fmt.Errorf("setting the `subnet` flag is only allowed for BYO VPC clusters")
 	}
 
 	isSecurityGroupIdsSet := cmd.Flags().Changed(securitygroups.MachinePoolSecurityGroupFlag)
 	isVersionCompatibleComputeSgIds, err := versions.IsGreaterThanOrEqual(
 		cluster.Version().RawID(), ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay2)
 	if err != nil {
-		return fmt.Errorf("there was a problem checking version compatibility: %v", err)
+		return nil, fmt.Errorf("there was a problem checking version compatibility: %v", err)
 	}
 	if isSecurityGroupIdsSet {
 		if !isByoVpc {
-			return fmt.Errorf("setting the `%s` flag is only allowed for BYOVPC clusters",
+			return nil, fmt.Errorf("setting the `%s` flag is only allowed for BYOVPC clusters",
 				securitygroups.MachinePoolSecurityGroupFlag)
 		}
 		if !isVersionCompatibleComputeSgIds {
@@ -141,25 +176,25 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 				ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay2,
 			)
 			if err != nil {
-				return fmt.Errorf("%s", fmt.Sprintf(versions.MajorMinorPatchFormattedErrorOutput, err))
+				return nil, fmt.Errorf("%s", fmt.Sprintf(versions.MajorMinorPatchFormattedErrorOutput, err))
 			}
-			return fmt.Errorf("parameter '%s' is not supported prior to version '%s'",
+			return nil, fmt.Errorf("parameter '%s' is not supported prior to version '%s'",
 				securitygroups.MachinePoolSecurityGroupFlag, formattedVersion)
 		}
 	}
 
 	if isSubnetSet && isAvailabilityZoneSet {
-		return fmt.Errorf("setting both `subnet` and `availability-zone` flag is not supported." +
+		return nil, fmt.Errorf("setting both `subnet` and `availability-zone` flag is not supported." +
 			" Please select `subnet` or `availability-zone` to create a single availability zone machine pool")
 	}
 
 	// Validate `subnet` or `availability-zone` flags are set for a single AZ machine pool
 	if isAvailabilityZoneSet && isMultiAvailabilityZoneSet && args.MultiAvailabilityZone {
-		return fmt.Errorf("setting the `availability-zone` flag is only supported for creating a single AZ " +
+		return nil, fmt.Errorf("setting the `availability-zone` flag is only supported for creating a single AZ " +
 			"machine pool in a multi-AZ cluster")
 	}
 	if isSubnetSet && isMultiAvailabilityZoneSet && args.MultiAvailabilityZone {
-		return fmt.Errorf("setting the `subnet` flag is only supported for creating a single AZ machine pool")
+		return nil, fmt.Errorf("setting the `subnet` flag is only supported for creating a single AZ machine pool")
 	}
 
 	rosa.HostedClusterOnlyFlag(r, cmd, "version")
@@ -184,12 +219,12 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid name for the machine pool: %s", err)
+			return nil, fmt.Errorf("expected a valid name for the machine pool: %s", err)
 		}
 	}
 	name = strings.Trim(name, " \t")
 	if !machinePoolKeyRE.MatchString(name) {
-		return fmt.Errorf("expected a valid name for the machine pool")
+		return nil, fmt.Errorf("expected a valid name for the machine pool")
 	}
 
 	// Allow the user to select subnet for a single AZ BYOVPC cluster
@@ -197,7 +232,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 	if !cluster.MultiAZ() && isByoVpc {
 		subnet, err = getSubnetFromUser(cmd, r, isSubnetSet, cluster, args)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -219,7 +254,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 				Required: false,
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for create multi-AZ machine pool")
+				return nil, fmt.Errorf("expected a valid value for create multi-AZ machine pool")
 			}
 		} else {
 			multiAZMachinePool = args.MultiAvailabilityZone
@@ -230,7 +265,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 			if isByoVpc && args.AvailabilityZone == "" {
 				subnet, err = getSubnetFromUser(cmd, r, isSubnetSet, cluster, args)
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
 
@@ -246,14 +281,14 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 						Required: true,
 					})
 					if err != nil {
-						return fmt.Errorf("expected a valid AWS availability zone: %s", err)
+						return nil, fmt.Errorf("expected a valid AWS availability zone: %s", err)
 					}
 				} else if isAvailabilityZoneSet {
 					availabilityZone = args.AvailabilityZone
 				}
 
 				if !helper.Contains(cluster.Nodes().AvailabilityZones(), availabilityZone) {
-					return fmt.Errorf("availability zone '%s' doesn't belong to the cluster's availability zones",
+					return nil, fmt.Errorf("availability zone '%s' doesn't belong to the cluster's availability zones",
 						availabilityZone)
 				}
 			}
@@ -267,7 +302,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 	}
 	minReplicas, maxReplicas, replicas, autoscaling, err := manageReplicas(cmd, args, replicaSizeValidation)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	securityGroupIds := args.SecurityGroupIds
@@ -275,7 +310,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 		isByoVpc && !isSecurityGroupIdsSet {
 		securityGroupIds, err = getSecurityGroupsOption(r, cmd, cluster)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	for i, sg := range securityGroupIds {
@@ -285,7 +320,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 	// Machine pool instance type:
 	instanceType := args.InstanceType
 	if instanceType == "" && !interactive.Enabled() {
-		return fmt.Errorf("you must supply a valid instance type")
+		return nil, fmt.Errorf("you must supply a valid instance type")
 	}
 
 	var spin *spinner.Spinner
@@ -301,7 +336,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 	availabilityZonesFilter, err := getMachinePoolAvailabilityZones(r, cluster, multiAZMachinePool, availabilityZone,
 		subnet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	instanceTypeList, err := r.OCMClient.GetAvailableMachineTypesInRegion(
@@ -312,7 +347,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 		cluster.AWS().STS().ExternalID(),
 	)
 	if err != nil {
-		return fmt.Errorf("%s", err)
+		return nil, fmt.Errorf("%s", err)
 	}
 
 	if spin != nil {
@@ -331,13 +366,13 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 			Required: true,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid instance type: %s", err)
+			return nil, fmt.Errorf("expected a valid instance type: %s", err)
 		}
 	}
 
 	err = instanceTypeList.ValidateMachineType(instanceType, cluster.MultiAZ())
 	if err != nil {
-		return fmt.Errorf("expected a valid instance type: %s", err)
+		return nil, fmt.Errorf("expected a valid instance type: %s", err)
 	}
 
 	existingLabels := make(map[string]string, 0)
@@ -353,7 +388,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 	useSpotInstances := args.UseSpotInstances
 	spotMaxPrice := args.SpotMaxPrice
 	if isSpotMaxPriceSet && isSpotSet && !useSpotInstances {
-		return fmt.Errorf("can't set max price when not using spot instances")
+		return nil, fmt.Errorf("can't set max price when not using spot instances")
 	}
 
 	// Validate spot instance are supported
@@ -361,11 +396,11 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 	if subnet != "" {
 		isLocalZone, err = r.AWSClient.IsLocalAvailabilityZone(availabilityZonesFilter[0])
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if isLocalZone && useSpotInstances {
-		return fmt.Errorf("spot instances are not supported for local zones")
+		return nil, fmt.Errorf("spot instances are not supported for local zones")
 	}
 
 	if !isSpotSet && !isSpotMaxPriceSet && !isLocalZone && interactive.Enabled() {
@@ -376,7 +411,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 			Required: false,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for use spot instances: %s", err)
+			return nil, fmt.Errorf("expected a valid value for use spot instances: %s", err)
 		}
 	}
 
@@ -391,7 +426,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for spot max price: %s", err)
+			return nil, fmt.Errorf("expected a valid value for spot max price: %s", err)
 		}
 	}
 
@@ -399,7 +434,7 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 
 	err = spotMaxPriceValidator(spotMaxPrice)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if spotMaxPrice != spotPriceOnDemand {
 		price, _ := strconv.ParseFloat(spotMaxPrice, commonUtils.MaxByteSize)
@@ -476,19 +511,19 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid machine pool root disk size value: %v", err)
+				return nil, fmt.Errorf("expected a valid machine pool root disk size value: %v", err)
 			}
 		}
 
 		// Parse the value given by either CLI or interactive mode and return it in GigiBytes
 		rootDiskSize, err := ocm.ParseDiskSizeToGigibyte(rootDiskSizeStr)
 		if err != nil {
-			return fmt.Errorf("expected a valid machine pool root disk size value '%s': %v", rootDiskSizeStr, err)
+			return nil, fmt.Errorf("expected a valid machine pool root disk size value '%s': %v", rootDiskSizeStr, err)
 		}
 
 		err = diskValidator.ValidateMachinePoolRootDiskSize(cluster.Version().RawID(), rootDiskSize)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// If the size given by the user is different than the default, we just let the OCM server
@@ -500,41 +535,63 @@ func (m *machinePool) CreateMachinePool(r *rosa.Runtime, cmd *cobra.Command, clu
 
 	machinePool, err := mpBuilder.Build()
 	if err != nil {
-		return fmt.Errorf("failed to create machine pool for cluster '%s': %v", clusterKey, err)
+		return nil, fmt.Errorf("failed to create machine pool for cluster '%s': %v", clusterKey, err)
 	}
+	return machinePool, nil
+}
 
-	createdMachinePool, err := r.OCMClient.CreateMachinePool(cluster.ID(), machinePool)
+func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster,
+	clusterAutoscaler *cmv1.ClusterAutoscaler, args *mpOpts.CreateMachinepoolUserOptions) error {
+	nodePool, err := m.BuildNodePool(r, cmd, clusterKey, cluster, clusterAutoscaler, args)
 	if err != nil {
-		return fmt.Errorf("failed to add machine pool to cluster '%s': %v", clusterKey, err)
+		return err
+	}
+
+	createResult, err := r.OCMClient.CreateNodePoolWithWarnings(cluster.ID(), nodePool)
+	if err != nil {
+		return fmt.Errorf("failed to add machine pool to hosted cluster '%s': %v", clusterKey, err)
+	}
+	createdNodePool := createResult.NodePool
+	for _, warning := range createResult.Warnings {
+		r.Reporter.Warnf("%s", warning)
+	}
+	if len(createResult.Warnings) == 0 &&
+		nodePool.AWSNodePool().SpotMarketOptions() != nil &&
+		cluster.AWS() != nil &&
+		cluster.AWS().TerminationHandlerQueueUrl() == "" {
+		r.Reporter.Warnf("%s", spotNodePoolWithoutTerminationHandlerWarning)
 	}
 
 	if output.HasFlag() {
-		if err = output.Print(createdMachinePool); err != nil {
+		if err = output.Print(createdNodePool); err != nil {
 			return fmt.Errorf("unable to print machine pool: %v", err)
 		}
 	} else {
-		r.Reporter.Infof("Machine pool '%s' created successfully on cluster '%s'", name, clusterKey)
+		r.Reporter.Infof("Machine pool '%s' created successfully on hosted cluster '%s'", createdNodePool.ID(), clusterKey)
 		r.Reporter.Infof("To view the machine pool details, run 'rosa describe machinepool --cluster %s --machinepool %s'",
-			clusterKey, name)
+			clusterKey, nodePool.ID())
 		r.Reporter.Infof("To view all machine pools, run 'rosa list machinepools --cluster %s'", clusterKey)
 	}
 
 	return nil
 }
 
-func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster,
-	clusterAutoscaler *cmv1.ClusterAutoscaler, args *mpOpts.CreateMachinepoolUserOptions) error {
+// BuildNodePool validates the flags of a hosted control plane node pool, asking for the missing values in
+// interactive mode, and returns the node pool to create
+func (m *machinePool) BuildNodePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string,
+	cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
+	args *mpOpts.CreateMachinepoolUserOptions) (*cmv1.NodePool, error) {
 
 	var err error
 	isMultiAvailabilityZoneSet := cmd.Flags().Changed("multi-availability-zone")
 	if isMultiAvailabilityZoneSet {
-		return fmt.Errorf("setting `multi-availability-zone` flag is not supported for HCP clusters")
+		return nil, fmt.Errorf("setting `multi-availability-zone` flag is not supported for HCP clusters")
 	}
 
 	isAvailabilityZoneSet := cmd.Flags().Changed("availability-zone")
 	isSubnetSet := cmd.Flags().Changed("subnet")
 	if isSubnetSet && isAvailabilityZoneSet {
-		return fmt.Errorf("setting both `subnet` and `availability-zone` flag is not supported." +
+		return nil, fmt.Errorf("setting both `subnet` and `availability-zone` flag is not supported." +
 			" Please select `subnet` or `availability-zone` to create a single availability zone machine pool")
 	}
 
@@ -554,12 +611,12 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid name for the machine pool: %s", err)
+			return nil, fmt.Errorf("expected a valid name for the machine pool: %s", err)
 		}
 	}
 	name = strings.Trim(name, " \t")
 	if !machinePoolKeyRE.MatchString(name) {
-		return fmt.Errorf("expected a valid name for the machine pool")
+		return nil, fmt.Errorf("expected a valid name for the machine pool")
 	}
 
 	imageType := ""
@@ -571,28 +628,28 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 	if interactive.Enabled() && cluster.Hypershift().Enabled() && !fedramp.Enabled() {
 		imageType, err = interactive.GetOption(buildMachinePoolImageTypeInput(cmd))
 		if err != nil {
-			return fmt.Errorf("expected a valid image type: '%s'", err)
+			return nil, fmt.Errorf("expected a valid image type: '%s'", err)
 		}
 	}
 
 	if imageType != "" && !mpHelpers.IsValidImageType(imageType) && !fedramp.Enabled() {
-		return fmt.Errorf("expected a valid image type for the machine pool: '%s'", imageType)
+		return nil, fmt.Errorf("expected a valid image type for the machine pool: '%s'", imageType)
 	}
 
 	// Check if Windows LI image type is being used and if the tech preview is active
 	if imageType != "" && strings.EqualFold(imageType, string(cmv1.ImageTypeWindows)) {
 		isTechPreview, err := r.OCMClient.IsTechnologyPreview("windows-li", time.Now())
 		if err != nil {
-			return fmt.Errorf("failed to check Windows LI technology preview status: %v", err)
+			return nil, fmt.Errorf("failed to check Windows LI technology preview status: %v", err)
 		}
 		if !isTechPreview {
-			return fmt.Errorf("windows License Included AMI support for ROSA HCP node pools is not yet " +
+			return nil, fmt.Errorf("windows License Included AMI support for ROSA HCP node pools is not yet " +
 				"available")
 		}
 
 		techPreviewMsg, err := r.OCMClient.GetTechnologyPreviewMessage("windows-li", time.Now())
 		if err != nil {
-			return fmt.Errorf("failed to get Windows LI technology preview message: %v", err)
+			return nil, fmt.Errorf("failed to get Windows LI technology preview message: %v", err)
 		}
 		if techPreviewMsg != "" {
 			r.Reporter.Warnf("Technology Preview Message for Windows LI: %s", techPreviewMsg)
@@ -610,13 +667,13 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 		// so we pass the relative parameter as false
 		_, versionList, err := versions.GetVersionList(r, channelGroup, true, true, false, false)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Calculate the minimal version for a new hosted machine pool
 		minVersion, err := versions.GetMinimalHostedMachinePoolVersion(clusterVersion)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Filter the available list of versions for a hosted machine pool
@@ -634,28 +691,28 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 				Required: true,
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid OpenShift version: %s", err)
+				return nil, fmt.Errorf("expected a valid OpenShift version: %s", err)
 			}
 		}
 		// This is called in HyperShift, but we don't want to exclude version which are HCP disabled for node pools
 		// so we pass the relative parameter as false
 		version, err = r.OCMClient.ValidateVersion(version, filteredVersionList, channelGroup, true, false)
 		if err != nil {
-			return fmt.Errorf("expected a valid OpenShift version: %s", err)
+			return nil, fmt.Errorf("expected a valid OpenShift version: %s", err)
 		}
 	}
 
 	// Allow the user to select subnet for a single AZ BYOVPC cluster
 	subnet, err := getSubnetFromUser(cmd, r, isSubnetSet, cluster, args)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Select availability zone if the user didn't select subnet
 	if subnet == "" {
 		subnet, err = getSubnetFromAvailabilityZone(cmd, r, isAvailabilityZoneSet, cluster, args)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -668,7 +725,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 
 	minReplicas, maxReplicas, replicas, autoscaling, err := manageReplicas(cmd, args, replicaSizeValidation)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	existingLabels := make(map[string]string, 0)
@@ -682,12 +739,12 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 	isVersionCompatibleSecurityGroupIds, err := features.IsFeatureSupported(
 		features.AdditionalDay2SecurityGroupsHcpFeature, version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if interactive.Enabled() && !isSecurityGroupIdsSet && isVersionCompatibleSecurityGroupIds {
 		securityGroupIds, err = getSecurityGroupsOption(r, cmd, cluster)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	for i, sg := range securityGroupIds {
@@ -722,14 +779,14 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 	}
 
 	if isSpotMaxPriceSet && (!isSpotSet || !useSpotInstances) {
-		return fmt.Errorf("can't set max price when not using spot instances")
+		return nil, fmt.Errorf("can't set max price when not using spot instances")
 	}
 
 	// Machine pool instance type:
 	// NodePools don't support MultiAZ yet, so the availabilityZonesFilters is calculated from the cluster
 	instanceType := args.InstanceType
 	if instanceType == "" && !interactive.Enabled() {
-		return fmt.Errorf("you must supply a valid instance type")
+		return nil, fmt.Errorf("you must supply a valid instance type")
 	}
 
 	var spin *spinner.Spinner
@@ -748,7 +805,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 	if subnet != "" {
 		availabilityZone, err := r.AWSClient.GetSubnetAvailabilityZone(subnet)
 		if err != nil {
-			return fmt.Errorf("%s", err)
+			return nil, fmt.Errorf("%s", err)
 		}
 		availabilityZonesFilter = []string{availabilityZone}
 	}
@@ -758,17 +815,17 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 		(useSpotInstances || (!isSpotSet && !isSpotMaxPriceSet && interactive.Enabled())) {
 		isLocalZone, err = r.AWSClient.IsLocalAvailabilityZone(availabilityZonesFilter[0])
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if isLocalZone && useSpotInstances {
-		return fmt.Errorf("spot instances are not supported for local zones")
+		return nil, fmt.Errorf("spot instances are not supported for local zones")
 	}
 
 	instanceTypeList, err := r.OCMClient.GetAvailableMachineTypesInRegion(cluster.Region().ID(),
 		availabilityZonesFilter, cluster.AWS().STS().RoleARN(), r.AWSClient, cluster.AWS().STS().ExternalID())
 	if err != nil {
-		return fmt.Errorf("%s", err)
+		return nil, fmt.Errorf("%s", err)
 	}
 
 	if spin != nil {
@@ -788,13 +845,13 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			Required: true,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid instance type: %s", err)
+			return nil, fmt.Errorf("expected a valid instance type: %s", err)
 		}
 	}
 
 	err = instanceTypeList.ValidateMachineType(instanceType, cluster.MultiAZ())
 	if err != nil {
-		return fmt.Errorf("expected a valid instance type: %s", err)
+		return nil, fmt.Errorf("expected a valid instance type: %s", err)
 	}
 
 	autorepair := args.Autorepair
@@ -806,7 +863,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			Required: false,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for autorepair: %s", err)
+			return nil, fmt.Errorf("expected a valid value for autorepair: %s", err)
 		}
 	}
 
@@ -817,7 +874,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 	// Get the list of available tuning configs
 	availableTuningConfigs, err := r.OCMClient.GetTuningConfigsName(cluster.ID())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if tuningConfigs != "" {
 		if len(availableTuningConfigs) > 0 {
@@ -839,7 +896,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 				Required: false,
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for tuning configs: %s", err)
+				return nil, fmt.Errorf("expected a valid value for tuning configs: %s", err)
 			}
 		}
 	}
@@ -852,12 +909,12 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 	capacityReservationId := args.CapacityReservationId
 
 	if capacityReservationId != "" && fedramp.Enabled() {
-		return fmt.Errorf("capacity reservation is not supported in govcloud, please remove " +
+		return nil, fmt.Errorf("capacity reservation is not supported in govcloud, please remove " +
 			"'--capacity-reservation-id' and associated flags")
 	}
 
 	if args.CapacityReservationPreference != "" && fedramp.Enabled() {
-		return fmt.Errorf("capacity reservation is not supported in govcloud, please remove " +
+		return nil, fmt.Errorf("capacity reservation is not supported in govcloud, please remove " +
 			"'--capacity-reservation-preference' and associated flags")
 	}
 
@@ -869,7 +926,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			Required: false,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for Capacity Reservation ID: %s", err)
+			return nil, fmt.Errorf("expected a valid value for Capacity Reservation ID: %s", err)
 		}
 	}
 
@@ -888,14 +945,26 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			Required: false,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for Capacity Reservation Preference: %s", err)
+			return nil, fmt.Errorf("expected a valid value for Capacity Reservation Preference: %s", err)
 		}
 	}
 
 	if capacityReservationPreference != "" {
 		err = mpHelpers.ValidateCapacityReservationPreference(capacityReservationPreference, capacityReservationId)
 		if err != nil {
-			return fmt.Errorf("expected a valid value for Capacity Reservation Preference: %s", err)
+			return nil, fmt.Errorf("expected a valid value for Capacity Reservation Preference: %s", err)
+		}
+	}
+
+	if capacityReservationId != "" {
+		minNodes, maxNodes := replicas, replicas
+		if autoscaling {
+			minNodes, maxNodes = minReplicas, maxReplicas
+		}
+		err = validateCapacityReservation(r, cluster, capacityReservationId, instanceType,
+			availabilityZonesFilter, minNodes, maxNodes)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -906,7 +975,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 		// Get the list of available kubelet configs
 		availableKubeletConfigs, err := r.OCMClient.ListKubeletConfigNames(cluster.ID())
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if len(availableKubeletConfigs) > 0 {
@@ -931,14 +1000,14 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 					},
 				})
 				if err != nil {
-					return fmt.Errorf("expected a valid value for kubelet config: %s", err)
+					return nil, fmt.Errorf("expected a valid value for kubelet config: %s", err)
 				}
 			}
 		}
 
 		err = ValidateKubeletConfig(inputKubeletConfigs)
 		if err != nil {
-			return fmt.Errorf("%s", err.Error())
+			return nil, fmt.Errorf("%s", err.Error())
 		}
 
 		if len(inputKubeletConfigs) != 0 {
@@ -959,12 +1028,12 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			Default:  httpTokens,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid http tokens value : %v", err)
+			return nil, fmt.Errorf("expected a valid http tokens value : %v", err)
 		}
 	}
 
 	if err = ocm.ValidateHttpTokensValue(httpTokens); err != nil {
-		return fmt.Errorf("expected a valid http tokens value : %v", err)
+		return nil, fmt.Errorf("expected a valid http tokens value : %v", err)
 	}
 
 	if !isSpotSet && !isSpotMaxPriceSet && !isLocalZone && interactive.Enabled() {
@@ -975,12 +1044,12 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			Required: false,
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for use spot instances: %s", err)
+			return nil, fmt.Errorf("expected a valid value for use spot instances: %s", err)
 		}
 	}
 
 	if useSpotInstances && (capacityReservationId != "" || capacityReservationPreference != "") {
-		return fmt.Errorf("can't use spot instances with capacity reservation")
+		return nil, fmt.Errorf("can't use spot instances with capacity reservation")
 	}
 
 	if useSpotInstances {
@@ -995,12 +1064,12 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for spot max price: %s", err)
+				return nil, fmt.Errorf("expected a valid value for spot max price: %s", err)
 			}
 		}
 		err = spotMaxPriceValidator(spotMaxPrice)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -1032,19 +1101,19 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid node pool root disk size value: %v", err)
+				return nil, fmt.Errorf("expected a valid node pool root disk size value: %v", err)
 			}
 		}
 
 		// Parse the value given by either CLI or interactive mode and return it in GigiBytes
 		parsedRootDiskSize, err := ocm.ParseDiskSizeToGigibyte(rootDiskSizeStr)
 		if err != nil {
-			return fmt.Errorf("expected a valid node pool root disk size value '%s': %v", rootDiskSizeStr, err)
+			return nil, fmt.Errorf("expected a valid node pool root disk size value '%s': %v", rootDiskSizeStr, err)
 		}
 
 		err = diskValidator.ValidateNodePoolRootDiskSize(parsedRootDiskSize)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if parsedRootDiskSize != defaultRootDiskSize {
@@ -1099,13 +1168,13 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("expected a valid value for Node drain grace period: %s", err)
+			return nil, fmt.Errorf("expected a valid value for Node drain grace period: %s", err)
 		}
 	}
 	if nodeDrainGracePeriod != "" {
 		nodeDrainBuilder, err := mpHelpers.CreateNodeDrainGracePeriodBuilder(nodeDrainGracePeriod)
 		if err != nil {
-			return fmt.Errorf("%v", err.Error())
+			return nil, fmt.Errorf("%v", err.Error())
 		}
 		npBuilder.NodeDrainGracePeriod(nodeDrainBuilder)
 	}
@@ -1125,7 +1194,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for max surge: %s", err)
+				return nil, fmt.Errorf("expected a valid value for max surge: %s", err)
 			}
 		}
 
@@ -1141,7 +1210,7 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for max unavailable: %s", err)
+				return nil, fmt.Errorf("expected a valid value for max unavailable: %s", err)
 			}
 		}
 		if maxSurge != "" || maxUnavailable != "" {
@@ -1212,36 +1281,9 @@ func (m *machinePool) CreateNodePools(r *rosa.Runtime, cmd *cobra.Command, clust
 
 	nodePool, err := npBuilder.Build()
 	if err != nil {
-		return fmt.Errorf("failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
-	}
-
-	createResult, err := r.OCMClient.CreateNodePoolWithWarnings(cluster.ID(), nodePool)
-	if err != nil {
-		return fmt.Errorf("failed to add machine pool to hosted cluster '%s': %v", clusterKey, err)
+		return nil, fmt.Errorf("failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
 	}
-	createdNodePool := createResult.NodePool
-	for _, warning := range createResult.Warnings {
-		r.Reporter.Warnf("%s", warning)
-	}
-	if len(createResult.Warnings) == 0 &&
-		useSpotInstances &&
-		cluster.AWS() != nil &&
-		cluster.AWS().TerminationHandlerQueueUrl() == "" {
-		r.Reporter.Warnf("%s", spotNodePoolWithoutTerminationHandlerWarning)
-	}
-
-	if output.HasFlag() {
-		if err = output.Print(createdNodePool); err != nil {
-			return fmt.Errorf("unable to print machine pool: %v", err)
-		}
-	} else {
-		r.Reporter.Infof("Machine pool '%s' created successfully on hosted cluster '%s'", createdNodePool.ID(), clusterKey)
-		r.Reporter.Infof("To view the machine pool details, run 'rosa describe machinepool --cluster %s --machinepool %s'",
-			clusterKey, name)
-		r.Reporter.Infof("To view all machine pools, run 'rosa list machinepools --cluster %s'", clusterKey)
-	}
-
-	return nil
+	return nodePool, nil
 }
 
 // ListMachinePools lists all machinepools (or, nodepools if hypershift) in a cluster
@@ -1272,12 +1314,26 @@ func (m *machinePool) ListMachinePools(r *rosa.Runtime, clusterKey string, clust
 		return output.Print(machinePools)
 	}
 
+	// The wide output adds the actual capacity and status of the machine pools, the AWS client that
+	// counts the instances of classic machine pools is created by the caller
+	var capacities map[string]*Capacity
+	if args.ShowWide {
+		if isHypershift {
+			capacities, err = GetNodePoolCapacities(r.OCMClient, cluster, clusterKey, nodePools)
+		} else {
+			capacities, err = GetMachinePoolCapacities(r.AWSClient, cluster, machinePools)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create the writer that will be used to print the tabulated results:
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 
-	finalStringToOutput := getMachinePoolsString(r, machinePools, args)
+	finalStringToOutput := getMachinePoolsString(r, machinePools, args, capacities)
 	if isHypershift {
-		finalStringToOutput = getNodePoolsString(nodePools)
+		finalStringToOutput = getNodePoolsString(nodePools, capacities)
 	}
 	fmt.Fprint(writer, finalStringToOutput) //nolint:forbidigo
 	writer.Flush()
@@ -1304,7 +1360,17 @@ func (m *machinePool) DescribeMachinePool(r *rosa.Runtime, cluster *cmv1.Cluster
 		return output.Print(machinePool)
 	}
 
-	fmt.Print(machinePoolOutput(cluster.ID(), machinePool)) //nolint:forbidigo
+	result := machinePoolOutput(cluster.ID(), machinePool)
+	if mayBeInSpotGroup(machinePool) {
+		machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+		if err != nil {
+			return err
+		}
+		if group := FindSpotGroup(machinePools, machinePoolId); group != nil {
+			result += spotGroupOutput(group)
+		}
+	}
+	fmt.Print(result) //nolint:forbidigo
 
 	return nil
 }
@@ -1453,6 +1519,7 @@ func getMachinePoolsString(
 	runtime *rosa.Runtime,
 	machinePools []*cmv1.MachinePool,
 	args ListMachinePoolArgs,
+	capacities map[string]*Capacity,
 ) string {
 	type columnDefinition struct {
 		header      string
@@ -1499,6 +1566,18 @@ func getMachinePoolsString(
 			func(mp *cmv1.MachinePool) string { return isWinLIEnabled(mp.Labels()) }},
 		{"DEDICATED HOST", args.ShowDedicated || args.ShowAll,
 			func(mp *cmv1.MachinePool) string { return isDedicatedHost(mp, runtime) }},
+		{"CURRENT REPLICAS", args.ShowWide, func(mp *cmv1.MachinePool) string {
+			return fmt.Sprintf("%d", capacities[mp.ID()].CurrentReplicas)
+		}},
+		{"ZONE DISTRIBUTION", args.ShowWide, func(mp *cmv1.MachinePool) string {
+			return capacities[mp.ID()].ZoneDistribution()
+		}},
+		{"SPOT INTERRUPTIONS", args.ShowWide, func(mp *cmv1.MachinePool) string {
+			if interruptions := capacities[mp.ID()].SpotInterruptions; interruptions != nil {
+				return fmt.Sprintf("%d", *interruptions)
+			}
+			return ""
+		}},
 	}
 
 	var visibleColumnHeaders []string
@@ -1553,48 +1632,94 @@ func getMachinePoolsString(
 	return tableBuilder.String()
 }
 
-func getNodePoolsString(nodePools []*cmv1.NodePool) string {
+func getNodePoolsString(nodePools []*cmv1.NodePool, capacities map[string]*Capacity) string {
 	outputString := "ID\tAUTOSCALING\tREPLICAS\t" +
-		"INSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONE\tSUBNET\tSPOT INSTANCES\tDISK SIZE\tVERSION\tAUTOREPAIR\t\n"
-	for _, nodePool := range nodePools {
-		outputString += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t\t%s\t\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
-			nodePool.ID(),
+		"INSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONE\tSUBNET\tSPOT INSTANCES\tDISK SIZE\tVERSION\tAUTOREPAIR\t"
+	if capacities != nil {
+		outputString += "STATE\tMESSAGE\tSCHEDULED UPGRADE\t"
+	}
+	outputString += "\n"
+	for _, group := range GroupNodePools(nodePools) {
+		// Node pools spread across subnets share their settings and are shown as one machine pool
+		nodePool := group.NodePools[0]
+		replicas := ocmOutput.PrintNodePoolReplicasShort(
+			ocmOutput.PrintNodePoolCurrentReplicas(nodePool.Status()),
+			ocmOutput.PrintNodePoolReplicasInline(nodePool.Autoscaling(), nodePool.Replicas()),
+		)
+		availabilityZone := nodePool.AvailabilityZone()
+		subnet := nodePool.Subnet()
+		if group.IsSpread() {
+			replicas = getNodePoolGroupReplicas(group)
+			zones := []string{}
+			subnets := []string{}
+			for _, member := range group.NodePools {
+				zones = append(zones, member.AvailabilityZone())
+				subnets = append(subnets, member.Subnet())
+			}
+			availabilityZone = strings.Join(zones, ", ")
+			subnet = strings.Join(subnets, ", ")
+		}
+		outputString += fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t\t%s\t\t%s\t%s\t%s\t%s\t%s\t%s\t",
+			group.Name,
 			ocmOutput.PrintNodePoolAutoscaling(nodePool.Autoscaling()),
-			ocmOutput.PrintNodePoolReplicasShort(
-				ocmOutput.PrintNodePoolCurrentReplicas(nodePool.Status()),
-				ocmOutput.PrintNodePoolReplicasInline(nodePool.Autoscaling(), nodePool.Replicas()),
-			),
+			replicas,
 			ocmOutput.PrintNodePoolInstanceType(nodePool.AWSNodePool()),
 			ocmOutput.PrintLabels(nodePool.Labels()),
 			ocmOutput.PrintTaints(nodePool.Taints()),
-			nodePool.AvailabilityZone(),
-			nodePool.Subnet(),
+			availabilityZone,
+			subnet,
 			ocmOutput.PrintNodePoolSpot(nodePool.AWSNodePool()),
 			ocmOutput.PrintNodePoolDiskSize(nodePool.AWSNodePool()),
 			ocmOutput.PrintNodePoolVersion(nodePool.Version()),
 			ocmOutput.PrintNodePoolAutorepair(nodePool.AutoRepair()),
 		)
+		if capacity, ok := capacities[group.Name]; ok {
+			outputString += fmt.Sprintf("%s\t%s\t%s\t",
+				capacity.State,
+				capacity.Message,
+				capacity.ScheduledUpgrade,
+			)
+		}
+		outputString += "\n"
 	}
 	return outputString
 }
 
+func getNodePoolGroupReplicas(group *NodePoolGroup) string {
+	current, replicas, minReplicas, maxReplicas := 0, 0, 0, 0
+	for _, nodePool := range group.NodePools {
+		current += nodePool.Status().CurrentReplicas()
+		if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+			minReplicas += autoscaling.MinReplica()
+			maxReplicas += autoscaling.MaxReplica()
+		} else {
+			replicas += nodePool.Replicas()
+		}
+	}
+	desired := fmt.Sprintf("%d", replicas)
+	if group.NodePools[0].Autoscaling() != nil {
+		desired = fmt.Sprintf("%d-%d", minReplicas, maxReplicas)
+	}
+	return ocmOutput.PrintNodePoolReplicasShort(fmt.Sprintf("%d", current), desired)
+}
+
 func (m *machinePool) EditMachinePool(cmd *cobra.Command, machinePoolId string, clusterKey string,
-	cluster *cmv1.Cluster, r *rosa.Runtime) error {
+	cluster *cmv1.Cluster, r *rosa.Runtime, impactArgs EditImpactArgs) (*EditImpact, error) {
 	if cluster.State() != cmv1.ClusterStateReady {
-		return fmt.Errorf("cluster '%s' is not yet ready", clusterKey)
+		return nil, fmt.Errorf("cluster '%s' is not yet ready", clusterKey)
 	}
 
 	if !MachinePoolKeyRE.MatchString(machinePoolId) {
-		return fmt.Errorf("expected a valid identifier for the machine pool")
+		return nil, fmt.Errorf("expected a valid identifier for the machine pool")
 	}
 	if cluster.Hypershift().Enabled() {
 		clusterAutoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
 		if err != nil {
-			return errors.UserErrorf("failed to fetch cluster autoscaler for cluster '%s'", cluster.ID())
+			return nil, errors.UserErrorf("failed to fetch cluster autoscaler for cluster '%s'", cluster.ID())
 		}
-		return editNodePool(cmd, machinePoolId, clusterKey, cluster, clusterAutoscaler, r)
+		return editNodePool(cmd, machinePoolId, clusterKey, cluster, clusterAutoscaler, r, impactArgs)
 	}
-	return editMachinePool(cmd, machinePoolId, clusterKey, cluster, r)
+	return editMachinePool(cmd, machinePoolId, clusterKey, cluster, r, impactArgs)
 }
 
 // fillAutoScalingAndReplicas is filling either autoscaling or replicas value in the builder
@@ -1775,7 +1900,7 @@ func editMachinePoolAutoscaling(machinePool *cmv1.MachinePool,
 }
 
 func editMachinePool(cmd *cobra.Command, machinePoolId string,
-	clusterKey string, cluster *cmv1.Cluster, r *rosa.Runtime) error {
+	clusterKey string, cluster *cmv1.Cluster, r *rosa.Runtime, impactArgs EditImpactArgs) (*EditImpact, error) {
 	rosa.HostedClusterOnlyFlag(r, cmd, "autorepair")
 	rosa.HostedClusterOnlyFlag(r, cmd, "tuning-configs")
 	rosa.HostedClusterOnlyFlag(r, cmd, "kubelet-configs")
@@ -1788,7 +1913,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolId string,
 	isTaintsSet := cmd.Flags().Changed("taints")
 
 	if cmd.Flags().Changed("use-spot-instances") || cmd.Flags().Changed("spot-max-price") {
-		return fmt.Errorf("spot instance configuration is only supported for Hosted Control Plane machine pools")
+		return nil, fmt.Errorf("spot instance configuration is only supported for Hosted Control Plane machine pools")
 	}
 
 	// if no value set enter interactive mode
@@ -1801,7 +1926,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolId string,
 	r.Reporter.Debugf("Loading machine pools for cluster '%s'", clusterKey)
 	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
 	if err != nil {
-		return fmt.Errorf("failed to get machine pools for cluster '%s': %v", clusterKey, err)
+		return nil, fmt.Errorf("failed to get machine pools for cluster '%s': %v", clusterKey, err)
 	}
 
 	var machinePool *cmv1.MachinePool
@@ -1811,7 +1936,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolId string,
 		}
 	}
 	if machinePool == nil {
-		return fmt.Errorf("failed to get machine pool '%s' for cluster '%s'", machinePoolId, clusterKey)
+		return nil, fmt.Errorf("failed to get machine pool '%s' for cluster '%s'", machinePoolId, clusterKey)
 	}
 
 	autoscaling, replicas, minReplicas, maxReplicas, err :=
@@ -1819,13 +1944,13 @@ func editMachinePool(cmd *cobra.Command, machinePoolId string,
 			!isLabelsSet && !isTaintsSet, isMultiAZMachinePool(machinePool), cluster.OpenshiftVersion())
 
 	if err != nil {
-		return fmt.Errorf("failed to get autoscaling or replicas: '%s'", err)
+		return nil, fmt.Errorf("failed to get autoscaling or replicas: '%s'", err)
 	}
 
 	if cluster.MultiAZ() && isMultiAZMachinePool(machinePool) &&
 		(!autoscaling && replicas%3 != 0 ||
 			(autoscaling && (minReplicas%3 != 0 || maxReplicas%3 != 0))) {
-		return fmt.Errorf("multi AZ clusters require that the number of MachinePool replicas be a multiple of 3")
+		return nil, fmt.Errorf("multi AZ clusters require that the number of MachinePool replicas be a multiple of 3")
 	}
 
 	labels := cmd.Flags().Lookup("labels").Value.String()
@@ -1855,23 +1980,31 @@ func editMachinePool(cmd *cobra.Command, machinePoolId string,
 		}
 	}
 
-	machinePool, err = mpBuilder.Build()
+	update, err := mpBuilder.Build()
 	if err != nil {
-		return fmt.Errorf("failed to create machine pool for cluster '%s': %v", clusterKey, err)
+		return nil, fmt.Errorf("failed to create machine pool for cluster '%s': %v", clusterKey, err)
 	}
 
+	impact := machinePoolEditImpact(machinePool, update)
+	proceed, err := checkEditImpact(impact, impactArgs)
+	if err != nil || !proceed {
+		return impact, err
+	}
+	machinePool = update
+
 	r.Reporter.Debugf("Updating machine pool '%s' on cluster '%s'", machinePool.ID(), clusterKey)
 	_, err = r.OCMClient.UpdateMachinePool(cluster.ID(), machinePool)
 	if err != nil {
-		return fmt.Errorf("failed to update machine pool '%s' on cluster '%s': %s",
+		return nil, fmt.Errorf("failed to update machine pool '%s' on cluster '%s': %s",
 			machinePool.ID(), clusterKey, err)
 	}
 	r.Reporter.Infof("Updated machine pool '%s' on cluster '%s'", machinePool.ID(), clusterKey)
-	return nil
+	return impact, nil
 }
 
 func editNodePool(cmd *cobra.Command, nodePoolID string,
-	clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler, r *rosa.Runtime) error {
+	clusterKey string, cluster *cmv1.Cluster, clusterAutoscaler *cmv1.ClusterAutoscaler,
+	r *rosa.Runtime, impactArgs EditImpactArgs) (*EditImpact, error) {
 	var err error
 
 	isMinReplicasSet := cmd.Flags().Changed("min-replicas")
@@ -1883,7 +2016,6 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 	isAutorepairSet := cmd.Flags().Changed("autorepair")
 	isTuningsConfigSet := cmd.Flags().Changed("tuning-configs")
 	isKubeletConfigSet := cmd.Flags().Changed("kubelet-configs")
-	isNodeDrainGracePeriodSet := cmd.Flags().Changed("node-drain-grace-period")
 	isUpgradeMaxSurgeSet := cmd.Flags().Changed("max-surge")
 	isUpgradeMaxUnavailableSet := cmd.Flags().Changed("max-unavailable")
 	isSpotSet := cmd.Flags().Changed("use-spot-instances")
@@ -1892,10 +2024,10 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 	if isSpotSet {
 		requestedUseSpotInstances, err = strconv.ParseBool(cmd.Flags().Lookup("use-spot-instances").Value.String())
 		if err != nil {
-			return fmt.Errorf("failed to parse use-spot-instances flag: %s", err)
+			return nil, fmt.Errorf("failed to parse use-spot-instances flag: %s", err)
 		}
 		if !requestedUseSpotInstances {
-			return fmt.Errorf("disabling spot instances on hosted machine pools is not supported yet")
+			return nil, fmt.Errorf("disabling spot instances on hosted machine pools is not supported yet")
 		}
 	}
 
@@ -1914,22 +2046,22 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 	r.Reporter.Debugf("Loading machine pool for hosted cluster '%s'", clusterKey)
 	nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), nodePoolID)
 	if err != nil {
-		return fmt.Errorf("failed to get machine pools for hosted cluster '%s': %v", clusterKey, err)
+		return nil, fmt.Errorf("failed to get machine pools for hosted cluster '%s': %v", clusterKey, err)
 	}
 	if !exists {
-		return fmt.Errorf("machine pool '%s' does not exist for hosted cluster '%s'", nodePoolID, clusterKey)
+		return nil, fmt.Errorf("machine pool '%s' does not exist for hosted cluster '%s'", nodePoolID, clusterKey)
 	}
 
 	autoscaling, replicas, minReplicas, maxReplicas, err := getNodePoolReplicas(cmd, nodePoolID,
 		nodePool.Replicas(), nodePool.Autoscaling(), isAnyAdditionalParameterSet, cluster.OpenshiftVersion())
 	if err != nil {
-		return fmt.Errorf("failed to get autoscaling or replicas: '%s'", err)
+		return nil, fmt.Errorf("failed to get autoscaling or replicas: '%s'", err)
 	}
 
 	err = validateNodePoolEdit(cmd, autoscaling, replicas, minReplicas, maxReplicas, nodePool,
 		isMinReplicasSet, isMaxReplicasSet)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	existingSpotEnabled := nodePool.AWSNodePool() != nil && nodePool.AWSNodePool().SpotMarketOptions() != nil
@@ -1939,14 +2071,122 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 	}
 
 	if isSpotMaxPriceSet && !isSpotSet && !existingSpotEnabled {
-		return fmt.Errorf("can't set max price when not using spot instances")
+		return nil, fmt.Errorf("can't set max price when not using spot instances")
 	}
 
 	if isSpotMaxPriceSet || (isSpotSet && targetUseSpotInstances && !existingSpotEnabled) {
-		return fmt.Errorf("modifying spot configuration on an existing machine pool is not supported; " +
+		return nil, fmt.Errorf("modifying spot configuration on an existing machine pool is not supported; " +
 			"delete the machine pool and create a new one with the desired spot settings")
 	}
 
+	npBuilder, isKubeletConfigSet, err := buildNodePoolEdit(r, cmd, nodePool, cluster)
+	if err != nil {
+		return nil, err
+	}
+	fillAutoScalingAndReplicas(npBuilder, autoscaling, nodePool, minReplicas, maxReplicas, replicas,
+		isMinReplicasSet, isMaxReplicasSet)
+
+	sumOfReplicas := replicas
+	sumOfMaxReplicas := maxReplicas
+	sumOfMinReplicas := minReplicas
+
+	nodepools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		r.Reporter.Errorf("error getting node pools from cluster '%s': %s", cluster.ID(), err)
+	}
+
+	for _, np := range nodepools {
+		// If autoscaling, calculate min and max, use min and max in separate messages below
+		npAutoscaling, ok := np.GetAutoscaling()
+		if !ok || npAutoscaling == nil {
+			npReplicas, _ := np.GetReplicas()
+			sumOfReplicas += npReplicas
+		} else {
+			sumOfMaxReplicas += npAutoscaling.MaxReplica()
+			sumOfMinReplicas += npAutoscaling.MinReplica()
+		}
+	}
+
+	maxNodesTotal := 0
+	if clusterAutoscaler != nil && clusterAutoscaler.ResourceLimits() != nil {
+		maxNodesTotal = clusterAutoscaler.ResourceLimits().MaxNodesTotal()
+	}
+
+	if maxNodesTotal > 0 { // Do not perform if maxNodesTotal == 0
+		// Informational message for cluster autoscaler + scaling out to max nodes
+		if autoscaling {
+			r.Reporter.Infof("Scaling max replicas to the maximum allowed value is subject to cluster autoscaler" +
+				" configuration")
+		}
+
+		// Informational message for sum of replicas > MaxNodesTotal
+		if sumOfReplicas+sumOfMaxReplicas > maxNodesTotal {
+			r.Reporter.Infof("Actual maximum replicas can be lowered, since the replicas defined exceeds "+
+				"%s", clusterAutoscalerLimitMessage)
+		}
+
+		// Informational message for min-replicas or replicas > MaxNodesTotal
+		if sumOfReplicas+sumOfMinReplicas > maxNodesTotal {
+			r.Reporter.Infof("Actual total nodes in the cluster will be more than the maximum nodes configured " +
+				"in the cluster autoscaler")
+		}
+	}
+
+	update, err := npBuilder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
+	}
+
+	impact := nodePoolEditImpact(nodePool, update)
+	proceed, err := checkEditImpact(impact, impactArgs)
+	if err != nil || !proceed {
+		return impact, err
+	}
+
+	if isKubeletConfigSet && !promptForNodePoolNodeRecreate(
+		nodePool, update, kubeletconfig.PromptToAcceptNodePoolNodeRecreate, r) {
+		return impact, nil
+	}
+
+	r.Reporter.Debugf("Updating machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
+	_, err = r.OCMClient.UpdateNodePool(cluster.ID(), update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update machine pool '%s' on hosted cluster '%s': %s",
+			nodePool.ID(), clusterKey, err)
+	}
+	r.Reporter.Infof("Updated machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
+	return impact, nil
+}
+
+// BuildNodePoolEdit validates the flags that edit the attributes of a node pool other than its replicas,
+// asking for their values in interactive mode, and returns the update of those attributes
+func (m *machinePool) BuildNodePoolEdit(r *rosa.Runtime, cmd *cobra.Command, nodePool *cmv1.NodePool,
+	cluster *cmv1.Cluster) (*cmv1.NodePool, error) {
+	npBuilder, _, err := buildNodePoolEdit(r, cmd, nodePool, cluster)
+	if err != nil {
+		return nil, err
+	}
+	update, err := npBuilder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update machine pool '%s': %v", nodePool.ID(), err)
+	}
+	return update, nil
+}
+
+// buildNodePoolEdit returns the builder of a node pool update with the attributes set by the flags other
+// than the replicas, and whether the kubelet configs are updated
+func buildNodePoolEdit(r *rosa.Runtime, cmd *cobra.Command, nodePool *cmv1.NodePool,
+	cluster *cmv1.Cluster) (*cmv1.NodePoolBuilder, bool, error) {
+	var err error
+	isLabelsSet := cmd.Flags().Changed("labels")
+	isTaintsSet := cmd.Flags().Changed("taints")
+	isAutorepairSet := cmd.Flags().Changed("autorepair")
+	isTuningsConfigSet := cmd.Flags().Changed("tuning-configs")
+	isKubeletConfigSet := cmd.Flags().Changed("kubelet-configs")
+	isNodeDrainGracePeriodSet := cmd.Flags().Changed("node-drain-grace-period")
+	isUpgradeMaxSurgeSet := cmd.Flags().Changed("max-surge")
+	isUpgradeMaxUnavailableSet := cmd.Flags().Changed("max-unavailable")
+
 	labels := cmd.Flags().Lookup("labels").Value.String()
 	labelMap := mpHelpers.GetLabelMap(cmd, r, nodePool.Labels(), labels)
 
@@ -1966,13 +2206,10 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 		npBuilder = npBuilder.Taints(taintBuilders...)
 	}
 
-	fillAutoScalingAndReplicas(npBuilder, autoscaling, nodePool, minReplicas, maxReplicas, replicas,
-		isMinReplicasSet, isMaxReplicasSet)
-
 	if isAutorepairSet || interactive.Enabled() {
 		autorepair, err := strconv.ParseBool(cmd.Flags().Lookup("autorepair").Value.String())
 		if err != nil {
-			return fmt.Errorf("failed to parse autorepair flag: %s", err)
+			return nil, false, fmt.Errorf("failed to parse autorepair flag: %s", err)
 		}
 		if interactive.Enabled() {
 			autorepair, err = interactive.GetBool(interactive.Input{
@@ -1982,7 +2219,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 				Required: false,
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for autorepair: %s", err)
+				return nil, false, fmt.Errorf("expected a valid value for autorepair: %s", err)
 			}
 		}
 
@@ -1995,7 +2232,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 		// Get the list of available tuning configs
 		availableTuningConfigs, err := r.OCMClient.GetTuningConfigsName(cluster.ID())
 		if err != nil {
-			return fmt.Errorf("%s", err)
+			return nil, false, fmt.Errorf("%s", err)
 		}
 		if tuningConfigs != "" {
 			if len(availableTuningConfigs) > 0 {
@@ -2023,7 +2260,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 					Required: false,
 				})
 				if err != nil {
-					return fmt.Errorf("expected a valid value for tuning configs: %s", err)
+					return nil, false, fmt.Errorf("expected a valid value for tuning configs: %s", err)
 				}
 			}
 		}
@@ -2037,7 +2274,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 		// Get the list of available tuning configs
 		availableKubeletConfigs, err := r.OCMClient.ListKubeletConfigNames(cluster.ID())
 		if err != nil {
-			return fmt.Errorf("%s", err)
+			return nil, false, fmt.Errorf("%s", err)
 		}
 		if kubeletConfigs != "" {
 			if len(availableKubeletConfigs) > 0 {
@@ -2068,7 +2305,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 					},
 				})
 				if err != nil {
-					return fmt.Errorf("expected a valid value for kubelet config: %s", err)
+					return nil, false, fmt.Errorf("expected a valid value for kubelet config: %s", err)
 				}
 			}
 		}
@@ -2099,14 +2336,14 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("expected a valid value for Node drain grace period: %s", err)
+				return nil, false, fmt.Errorf("expected a valid value for Node drain grace period: %s", err)
 			}
 		}
 
 		if nodeDrainGracePeriod != "" {
 			nodeDrainBuilder, err := mpHelpers.CreateNodeDrainGracePeriodBuilder(nodeDrainGracePeriod)
 			if err != nil {
-				return fmt.Errorf("%v", err.Error())
+				return nil, false, fmt.Errorf("%v", err.Error())
 			}
 			npBuilder.NodeDrainGracePeriod(nodeDrainBuilder)
 		}
@@ -2163,71 +2400,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string,
 			npBuilder.ManagementUpgrade(mgmtUpgradeBuilder)
 		}
 	}
-
-	sumOfReplicas := replicas
-	sumOfMaxReplicas := maxReplicas
-	sumOfMinReplicas := minReplicas
-
-	nodepools, err := r.OCMClient.GetNodePools(cluster.ID())
-	if err != nil {
-		r.Reporter.Errorf("error getting node pools from cluster '%s': %s", cluster.ID(), err)
-	}
-
-	for _, np := range nodepools {
-		// If autoscaling, calculate min and max, use min and max in separate messages below
-		npAutoscaling, ok := np.GetAutoscaling()
-		if !ok || npAutoscaling == nil {
-			npReplicas, _ := np.GetReplicas()
-			sumOfReplicas += npReplicas
-		} else {
-			sumOfMaxReplicas += npAutoscaling.MaxReplica()
-			sumOfMinReplicas += npAutoscaling.MinReplica()
-		}
-	}
-
-	maxNodesTotal := 0
-	if clusterAutoscaler != nil && clusterAutoscaler.ResourceLimits() != nil {
-		maxNodesTotal = clusterAutoscaler.ResourceLimits().MaxNodesTotal()
-	}
-
-	if maxNodesTotal > 0 { // Do not perform if maxNodesTotal == 0
-		// Informational message for cluster autoscaler + scaling out to max nodes
-		if autoscaling {
-			r.Reporter.Infof("Scaling max replicas to the maximum allowed value is subject to cluster autoscaler" +
-				" configuration")
-		}
-
-		// Informational message for sum of replicas > MaxNodesTotal
-		if sumOfReplicas+sumOfMaxReplicas > maxNodesTotal {
-			r.Reporter.Infof("Actual maximum replicas can be lowered, since the replicas defined exceeds "+
-				"%s", clusterAutoscalerLimitMessage)
-		}
-
-		// Informational message for min-replicas or replicas > MaxNodesTotal
-		if sumOfReplicas+sumOfMinReplicas > maxNodesTotal {
-			r.Reporter.Infof("Actual total nodes in the cluster will be more than the maximum nodes configured " +
-				"in the cluster autoscaler")
-		}
-	}
-
-	update, err := npBuilder.Build()
-	if err != nil {
-		return fmt.Errorf("failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
-	}
-
-	if isKubeletConfigSet && !promptForNodePoolNodeRecreate(
-		nodePool, update, kubeletconfig.PromptToAcceptNodePoolNodeRecreate, r) {
-		return nil
-	}
-
-	r.Reporter.Debugf("Updating machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
-	_, err = r.OCMClient.UpdateNodePool(cluster.ID(), update)
-	if err != nil {
-		return fmt.Errorf("failed to update machine pool '%s' on hosted cluster '%s': %s",
-			nodePool.ID(), clusterKey, err)
-	}
-	r.Reporter.Infof("Updated machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
-	return nil
+	return npBuilder, isKubeletConfigSet, nil
 }
 
 func validateNodePoolEdit(cmd *cobra.Command, autoscaling bool, replicas int, minReplicas int, maxReplicas int,
@@ -2481,6 +2654,14 @@ func manageReplicas(cmd *cobra.Command, args *mpOpts.CreateMachinepoolUserOption
 	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
 	isReplicasSet := cmd.Flags().Changed("replicas")
 
+	// The scaling of the machine pool referenced by '--from' is used when no scaling flag is given
+	if args.From != "" && !isMinReplicasSet && !isMaxReplicasSet && !isAutoscalingSet && !isReplicasSet {
+		isAutoscalingSet = true
+		isMinReplicasSet = args.AutoscalingEnabled
+		isMaxReplicasSet = args.AutoscalingEnabled
+		isReplicasSet = !args.AutoscalingEnabled
+	}
+
 	minReplicas = args.MinReplicas
 	maxReplicas = args.MaxReplicas
 	autoscaling = args.AutoscalingEnabled
@@ -42,6 +42,51 @@ func (m *MockMachinePoolService) EXPECT() *MockMachinePoolServiceMockRecorder {
 	return m.recorder
 }
 
+// BuildMachinePool mocks base method.
+func (m *MockMachinePoolService) BuildMachinePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *v1.Cluster, args *machinepool.CreateMachinepoolUserOptions) (*v1.MachinePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildMachinePool", r, cmd, clusterKey, cluster, args)
+	ret0, _ := ret[0].(*v1.MachinePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildMachinePool indicates an expected call of BuildMachinePool.
+func (mr *MockMachinePoolServiceMockRecorder) BuildMachinePool(r, cmd, clusterKey, cluster, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildMachinePool", reflect.TypeOf((*MockMachinePoolService)(nil).BuildMachinePool), r, cmd, clusterKey, cluster, args)
+}
+
+// BuildNodePool mocks base method.
+func (m *MockMachinePoolService) BuildNodePool(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *v1.Cluster, clusterAutoscaler *v1.ClusterAutoscaler, args *machinepool.CreateMachinepoolUserOptions) (*v1.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildNodePool", r, cmd, clusterKey, cluster, clusterAutoscaler, args)
+	ret0, _ := ret[0].(*v1.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildNodePool indicates an expected call of BuildNodePool.
+func (mr *MockMachinePoolServiceMockRecorder) BuildNodePool(r, cmd, clusterKey, cluster, clusterAutoscaler, args any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildNodePool", reflect.TypeOf((*MockMachinePoolService)(nil).BuildNodePool), r, cmd, clusterKey, cluster, clusterAutoscaler, args)
+}
+
+// BuildNodePoolEdit mocks base method.
+func (m *MockMachinePoolService) BuildNodePoolEdit(r *rosa.Runtime, cmd *cobra.Command, nodePool *v1.NodePool, cluster *v1.Cluster) (*v1.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildNodePoolEdit", r, cmd, nodePool, cluster)
+	ret0, _ := ret[0].(*v1.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildNodePoolEdit indicates an expected call of BuildNodePoolEdit.
+func (mr *MockMachinePoolServiceMockRecorder) BuildNodePoolEdit(r, cmd, nodePool, cluster any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildNodePoolEdit", reflect.TypeOf((*MockMachinePoolService)(nil).BuildNodePoolEdit), r, cmd, nodePool, cluster)
+}
+
 // CreateMachinePoolBasedOnClusterType mocks base method.
 func (m *MockMachinePoolService) CreateMachinePoolBasedOnClusterType(r *rosa.Runtime, cmd *cobra.Command, clusterKey string, cluster *v1.Cluster, clusterAutoscaler *v1.ClusterAutoscaler, options *machinepool.CreateMachinepoolUserOptions) error {
 	m.ctrl.T.Helper()
@@ -85,17 +130,18 @@ func (mr *MockMachinePoolServiceMockRecorder) DescribeMachinePool(r, cluster, cl
 }
 
 // EditMachinePool mocks base method.
-func (m *MockMachinePoolService) EditMachinePool(cmd *cobra.Command, machinePoolID, clusterKey string, cluster *v1.Cluster, r *rosa.Runtime) error {
+func (m *MockMachinePoolService) EditMachinePool(cmd *cobra.Command, machinePoolID, clusterKey string, cluster *v1.Cluster, r *rosa.Runtime, impactArgs EditImpactArgs) (*EditImpact, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditMachinePool", cmd, machinePoolID, clusterKey, cluster, r)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "EditMachinePool", cmd, machinePoolID, clusterKey, cluster, r, impactArgs)
+	ret0, _ := ret[0].(*EditImpact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // EditMachinePool indicates an expected call of EditMachinePool.
-func (mr *MockMachinePoolServiceMockRecorder) EditMachinePool(cmd, machinePoolID, clusterKey, cluster, r any) *gomock.Call {
+func (mr *MockMachinePoolServiceMockRecorder) EditMachinePool(cmd, machinePoolID, clusterKey, cluster, r, impactArgs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMachinePool", reflect.TypeOf((*MockMachinePoolService)(nil).EditMachinePool), cmd, machinePoolID, clusterKey, cluster, r)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMachinePool", reflect.TypeOf((*MockMachinePoolService)(nil).EditMachinePool), cmd, machinePoolID, clusterKey, cluster, r, impactArgs)
 }
 
 // ListMachinePools mocks base method.
@@ -301,7 +301,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 					Subnet("sn").Version(cmv1.NewVersion().ID("1")).AutoRepair(false)))
 			cluster, err := clusterBuilder.Build()
 			Expect(err).ToNot(HaveOccurred())
-			out := getNodePoolsString(cluster.NodePools().Slice())
+			out := getNodePoolsString(cluster.NodePools().Slice(), nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(out).To(Equal(fmt.Sprintf("ID\tAUTOSCALING\tREPLICAS\t"+
 				"INSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONE\tSUBNET\tSPOT INSTANCES\tDISK SIZE\tVERSION\tAUTOREPAIR\t\n"+
@@ -333,7 +333,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 					Subnet("sn").Version(cmv1.NewVersion().ID("1")).AutoRepair(false)))
 			cluster, err := clusterBuilder.Build()
 			Expect(err).ToNot(HaveOccurred())
-			out := getNodePoolsString(cluster.NodePools().Slice())
+			out := getNodePoolsString(cluster.NodePools().Slice(), nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(out).To(Equal(fmt.Sprintf("ID\tAUTOSCALING\tREPLICAS\t"+
 				"INSTANCE TYPE\tLABELS\t\tTAINTS\t\tAVAILABILITY ZONE\tSUBNET\tSPOT INSTANCES\tDISK SIZE\tVERSION\tAUTOREPAIR\t\n"+
@@ -495,7 +495,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tTAINTS\tSUBNETS\tSPOT INSTANCES\tDISK SIZE\n" +
 				"mp-1\tNo\t3\tm5.large\ttest-key=test-value:\tsubnet-1, subnet-2\tNo\tdefault\n"
@@ -510,7 +510,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			// When there are no machine pools, only headers are returned
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tLABELS\tTAINTS\tAVAILABILITY ZONES\tSUBNETS\tSPOT INSTANCES\tDISK SIZE\tSG IDS\n"
@@ -528,7 +528,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{ShowAll: true}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tLABELS\tTAINTS\tAVAILABILITY ZONES\tSUBNETS\tSPOT INSTANCES\tDISK SIZE\tSG IDS\tAZ TYPE\tWIN-LI ENABLED\tDEDICATED HOST\n" +
 				"mp-1\tNo\t3\tm5.large\t\t\t\t\tNo\tdefault\t\tN/A\tNo\tNo\n"
@@ -545,7 +545,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{ShowAZType: true}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\tAZ TYPE\n" +
 				"mp-1\tNo\t3\tm5.large\tNo\tdefault\tN/A\n"
@@ -562,7 +562,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{ShowDedicated: true}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\tDEDICATED HOST\n" +
 				"mp-1\tNo\t3\tm5.large\tNo\tdefault\tNo\n"
@@ -579,7 +579,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{ShowWindowsLI: true}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\tWIN-LI ENABLED\n" +
 				"mp-1\tNo\t3\tm5.large\tNo\tdefault\tNo\n"
@@ -596,7 +596,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{ShowAZType: true, ShowDedicated: true}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\tAZ TYPE\tDEDICATED HOST\n" +
 				"mp-1\tNo\t3\tm5.large\tNo\tdefault\tN/A\tNo\n"
@@ -615,7 +615,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\n" +
 				"mp-autoscale\tYes\t2-10\tm5.xlarge\tNo\tdefault\n"
@@ -637,7 +637,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\n" +
 				"mp-1\tNo\t3\tm5.large\tNo\tdefault\n" +
@@ -661,7 +661,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tAVAILABILITY ZONES\tSUBNETS\tSPOT INSTANCES\tDISK SIZE\n" +
 				"mp-minimal\tNo\t1\tt3.small\t\t\tNo\tdefault\n" +
@@ -679,7 +679,7 @@ var _ = Describe("Machinepool and nodepool", func() {
 
 			r := &rosa.Runtime{}
 			args := ListMachinePoolArgs{}
-			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args)
+			out := getMachinePoolsString(r, cluster.MachinePools().Slice(), args, nil)
 
 			// Only columns with actual data should be included
 			expectedOutput := "ID\tAUTOSCALING\tREPLICAS\tINSTANCE TYPE\tSPOT INSTANCES\tDISK SIZE\n" +
@@ -1694,6 +1694,8 @@ var _ = Describe("NodePools", func() {
 				[]*cmv1.Version{versionObj})))
 			mockClient.EXPECT().GetVPCPrivateSubnets(gomock.Any()).Return(privateSubnets, nil)
 			mockClient.EXPECT().GetSubnetAvailabilityZone(subnet).Return(az, nil)
+			mockClient.EXPECT().ListCapacityReservations("fake-capacity-reservation-id").Return(
+				[]ec2types.CapacityReservation{capacityReservation("fake-capacity-reservation-id", "t3.small", az, 3)}, nil)
 			mockClient.EXPECT().IsLocalAvailabilityZone(az).Return(false, nil)
 			mtBuilder := cmv1.NewMachineType().ID("t3.small").Name("t3.small")
 			machineType, err := mtBuilder.Build()
@@ -1833,6 +1835,8 @@ var _ = Describe("NodePools", func() {
 
 			mockClient.EXPECT().GetVPCPrivateSubnets(gomock.Any()).Return(privateSubnets, nil)
 			mockClient.EXPECT().GetSubnetAvailabilityZone(subnet).Return(az, nil)
+			mockClient.EXPECT().ListCapacityReservations("fake-capacity-reservation-id").Return(
+				[]ec2types.CapacityReservation{capacityReservation("fake-capacity-reservation-id", "t3.small", az, 3)}, nil)
 
 			mtBuilder := cmv1.NewMachineType().ID("t3.small").Name("t3.small")
 			machineType, err := mtBuilder.Build()
@@ -1912,6 +1916,8 @@ var _ = Describe("NodePools", func() {
 
 			mockClient.EXPECT().GetVPCPrivateSubnets(gomock.Any()).Return(privateSubnets, nil)
 			mockClient.EXPECT().GetSubnetAvailabilityZone(subnet).Return(az, nil)
+			mockClient.EXPECT().ListCapacityReservations("fake-capacity-reservation-id").Return(
+				[]ec2types.CapacityReservation{capacityReservation("fake-capacity-reservation-id", "t3.small", az, 3)}, nil)
 
 			mtBuilder := cmv1.NewMachineType().ID("t3.small").Name("t3.small")
 			machineType, err := mtBuilder.Build()
@@ -2403,7 +2409,8 @@ var _ = Describe("NodePools", func() {
 			Expect(cmd.Flags().Set("use-spot-instances", "true")).To(Succeed())
 			Expect(cmd.Flags().Set("spot-max-price", "1.00")).To(Succeed())
 
-			err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime)
+			_, err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime,
+				EditImpactArgs{})
 			Expect(err).To(MatchError(ContainSubstring(
 				"delete the machine pool and create a new one with the desired spot settings")))
 		})
@@ -2423,7 +2430,8 @@ var _ = Describe("NodePools", func() {
 
 			Expect(cmd.Flags().Set("spot-max-price", "2.00")).To(Succeed())
 
-			err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime)
+			_, err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime,
+				EditImpactArgs{})
 			Expect(err).To(MatchError(ContainSubstring(
 				"delete the machine pool and create a new one with the desired spot settings")))
 		})
@@ -2431,7 +2439,8 @@ var _ = Describe("NodePools", func() {
 		It("rejects disabling spot instances for now", func() {
 			Expect(cmd.Flags().Set("use-spot-instances", "false")).To(Succeed())
 
-			err := editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime)
+			_, err := editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime,
+				EditImpactArgs{})
 			Expect(err).To(MatchError("disabling spot instances on hosted machine pools is not supported yet"))
 		})
 
@@ -2447,7 +2456,8 @@ var _ = Describe("NodePools", func() {
 			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResources(existingNodePool)))
 			Expect(cmd.Flags().Set("spot-max-price", "1.00")).To(Succeed())
 
-			err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime)
+			_, err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime,
+				EditImpactArgs{})
 			Expect(err).To(MatchError("can't set max price when not using spot instances"))
 		})
 
@@ -2465,7 +2475,8 @@ var _ = Describe("NodePools", func() {
 			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatResources(existingNodePool)))
 			Expect(cmd.Flags().Set("use-spot-instances", "true")).To(Succeed())
 
-			err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime)
+			_, err = editNodePool(cmd, editNodePoolID, editClusterKey, cluster, nil, t.RosaRuntime,
+				EditImpactArgs{})
 			Expect(err).To(MatchError(ContainSubstring(
 				"delete the machine pool and create a new one with the desired spot settings")))
 		})
@@ -2533,6 +2544,20 @@ var _ = Describe("ManageReplicas", func() {
 			Expect(autoscaling).To(BeFalse())
 		})
 	})
+
+	When("the machine pool is copied from another one", func() {
+		It("uses the scaling of the source machine pool without asking for it", func() {
+			args.From = "workers"
+			args.AutoscalingEnabled = true
+			args.MinReplicas = 3
+			args.MaxReplicas = 6
+			minReplicas, maxReplicas, _, autoscaling, err := manageReplicas(cmd, args, replicaSizeValidation)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(autoscaling).To(BeTrue())
+			Expect(minReplicas).To(Equal(3))
+			Expect(maxReplicas).To(Equal(6))
+		})
+	})
 })
 
 var _ = Describe("Utility Functions", func() {
@@ -2657,7 +2682,7 @@ var _ = Describe("Utility Functions", func() {
 
 			r := rosa.NewRuntime()
 
-			err = editMachinePool(cmd, "mp1", "cls", cluster, r)
+			_, err = editMachinePool(cmd, "mp1", "cls", cluster, r, EditImpactArgs{})
 			Expect(err).To(MatchError(ContainSubstring(
 				"spot instance configuration is only supported for Hosted Control Plane machine pools")))
 		})
@@ -2675,7 +2700,7 @@ var _ = Describe("Utility Functions", func() {
 
 			r := rosa.NewRuntime()
 
-			err = editMachinePool(cmd, "mp1", "cls", cluster, r)
+			_, err = editMachinePool(cmd, "mp1", "cls", cluster, r, EditImpactArgs{})
 			Expect(err).To(MatchError(ContainSubstring(
 				"spot instance configuration is only supported for Hosted Control Plane machine pools")))
 		})
@@ -0,0 +1,93 @@
+package machinepool
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/rosa" //nolint:depguard
+)
+
+// CapacityReservationConsumers returns the IDs of the node pools that target each capacity reservation
+func CapacityReservationConsumers(nodePools []*cmv1.NodePool) map[string][]string {
+	consumers := map[string][]string{}
+	for _, nodePool := range nodePools {
+		id := nodePool.AWSNodePool().CapacityReservation().Id()
+		if id == "" {
+			continue
+		}
+		consumers[id] = append(consumers[id], nodePool.ID())
+	}
+	for id := range consumers {
+		sort.Strings(consumers[id])
+	}
+	return consumers
+}
+
+// ValidateCapacityReservation checks that a machine pool of the instance type, in one of the availability
+// zones, can launch its nodes in the capacity reservation. The minimum nodes must fit in the instances
+// still available in the reservation.
+func ValidateCapacityReservation(reservation ec2types.CapacityReservation, instanceType string,
+	availabilityZones []string, minNodes int, isHostedCP bool) error {
+	id := awssdk.ToString(reservation.CapacityReservationId)
+	if reservation.State != ec2types.CapacityReservationStateActive {
+		return fmt.Errorf("capacity reservation '%s' is '%s', expected it to be '%s'", id, reservation.State,
+			ec2types.CapacityReservationStateActive)
+	}
+	if reservedType := awssdk.ToString(reservation.InstanceType); reservedType != instanceType {
+		return fmt.Errorf("capacity reservation '%s' reserves instance type '%s', the machine pool uses "+
+			"instance type '%s'", id, reservedType, instanceType)
+	}
+	zone := awssdk.ToString(reservation.AvailabilityZone)
+	if len(availabilityZones) > 0 && !slices.Contains(availabilityZones, zone) {
+		return fmt.Errorf("capacity reservation '%s' is in availability zone '%s', %s", id, zone,
+			availabilityZoneHint(isHostedCP))
+	}
+	if len(availabilityZones) > 1 {
+		return fmt.Errorf("capacity reservation '%s' is in availability zone '%s', %s", id, zone,
+			availabilityZoneHint(isHostedCP))
+	}
+	if available := int(awssdk.ToInt32(reservation.AvailableInstanceCount)); minNodes > available {
+		return fmt.Errorf("capacity reservation '%s' has %d available instances, the machine pool needs %d",
+			id, available, minNodes)
+	}
+	return nil
+}
+
+// availabilityZoneHint tells how to place the machine pool in a single availability zone: node pools
+// of hosted control plane clusters are placed with a subnet, classic machine pools with a zone.
+func availabilityZoneHint(isHostedCP bool) string {
+	if isHostedCP {
+		return "select the subnet of that availability zone with '--subnet'"
+	}
+	return "select that availability zone with '--availability-zone'"
+}
+
+// validateCapacityReservation fetches a capacity reservation and validates it for a new machine pool of
+// the cluster. Nodes of autoscaling machine pools beyond the available instances of the reservation are
+// only a warning.
+func validateCapacityReservation(r *rosa.Runtime, cluster *cmv1.Cluster, capacityReservationID string,
+	instanceType string, availabilityZones []string, minNodes int, maxNodes int) error {
+	reservations, err := r.AWSClient.ListCapacityReservations(capacityReservationID)
+	if err != nil {
+		return fmt.Errorf("failed to get capacity reservation '%s': %v", capacityReservationID, err)
+	}
+	if len(reservations) == 0 {
+		return fmt.Errorf("capacity reservation '%s' does not exist", capacityReservationID)
+	}
+	reservation := reservations[0]
+	err = ValidateCapacityReservation(reservation, instanceType, availabilityZones, minNodes,
+		cluster.Hypershift().Enabled())
+	if err != nil {
+		return err
+	}
+	if available := int(awssdk.ToInt32(reservation.AvailableInstanceCount)); maxNodes > available {
+		r.Reporter.Warnf("Capacity reservation '%s' has %d available instances, the machine pool can scale "+
+			"up to %d nodes", capacityReservationID, available, maxNodes)
+	}
+	return nil
+}
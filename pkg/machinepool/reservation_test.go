@@ -0,0 +1,90 @@
+package machinepool
+
+import (
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func capacityReservation(id string, instanceType string, zone string, available int32) ec2types.CapacityReservation {
+	return ec2types.CapacityReservation{
+		CapacityReservationId:  awssdk.String(id),
+		InstanceType:           awssdk.String(instanceType),
+		AvailabilityZone:       awssdk.String(zone),
+		AvailableInstanceCount: awssdk.Int32(available),
+		State:                  ec2types.CapacityReservationStateActive,
+	}
+}
+
+var _ = Describe("Capacity reservations", func() {
+	It("Finds the node pools that target each capacity reservation", func() {
+		nodePool := func(id string, capacityReservationID string) *cmv1.NodePool {
+			awsNodePool := cmv1.NewAWSNodePool().InstanceType("m5.xlarge")
+			if capacityReservationID != "" {
+				awsNodePool.CapacityReservation(cmv1.NewAWSCapacityReservation().Id(capacityReservationID))
+			}
+			nodePool, err := cmv1.NewNodePool().ID(id).AWSNodePool(awsNodePool).Build()
+			Expect(err).NotTo(HaveOccurred())
+			return nodePool
+		}
+		consumers := CapacityReservationConsumers([]*cmv1.NodePool{
+			nodePool("workers", ""),
+			nodePool("gpu-b", "cr-1"),
+			nodePool("gpu-a", "cr-1"),
+			nodePool("db", "cr-2"),
+		})
+		Expect(consumers).To(Equal(map[string][]string{
+			"cr-1": {"gpu-a", "gpu-b"},
+			"cr-2": {"db"},
+		}))
+	})
+
+	Context("ValidateCapacityReservation", func() {
+		reservation := capacityReservation("cr-1", "m5.xlarge", "us-east-1a", 3)
+
+		It("Accepts a matching reservation", func() {
+			err := ValidateCapacityReservation(reservation, "m5.xlarge", []string{"us-east-1a"}, 3, true)
+			Expect(err).To(Succeed())
+		})
+
+		It("Rejects a reservation of another instance type", func() {
+			err := ValidateCapacityReservation(reservation, "m5.2xlarge", []string{"us-east-1a"}, 1, true)
+			Expect(err).To(MatchError("capacity reservation 'cr-1' reserves instance type 'm5.xlarge', the " +
+				"machine pool uses instance type 'm5.2xlarge'"))
+		})
+
+		It("Rejects a reservation in another availability zone", func() {
+			err := ValidateCapacityReservation(reservation, "m5.xlarge", []string{"us-east-1b"}, 1, true)
+			Expect(err).To(MatchError(ContainSubstring("is in availability zone 'us-east-1a'")))
+		})
+
+		It("Requires the subnet of a single availability zone for hosted control plane clusters", func() {
+			err := ValidateCapacityReservation(reservation, "m5.xlarge", []string{"us-east-1a", "us-east-1b"}, 1,
+				true)
+			Expect(err).To(MatchError("capacity reservation 'cr-1' is in availability zone 'us-east-1a', " +
+				"select the subnet of that availability zone with '--subnet'"))
+		})
+
+		It("Requires a single availability zone for classic clusters", func() {
+			err := ValidateCapacityReservation(reservation, "m5.xlarge", []string{"us-east-1a", "us-east-1b"}, 1,
+				false)
+			Expect(err).To(MatchError("capacity reservation 'cr-1' is in availability zone 'us-east-1a', " +
+				"select that availability zone with '--availability-zone'"))
+		})
+
+		It("Rejects more nodes than the available instances", func() {
+			err := ValidateCapacityReservation(reservation, "m5.xlarge", []string{"us-east-1a"}, 4, true)
+			Expect(err).To(MatchError("capacity reservation 'cr-1' has 3 available instances, the machine " +
+				"pool needs 4"))
+		})
+
+		It("Rejects a reservation that isn't active", func() {
+			expired := capacityReservation("cr-1", "m5.xlarge", "us-east-1a", 3)
+			expired.State = ec2types.CapacityReservationStateExpired
+			err := ValidateCapacityReservation(expired, "m5.xlarge", []string{"us-east-1a"}, 1, true)
+			Expect(err).To(MatchError("capacity reservation 'cr-1' is 'expired', expected it to be 'active'"))
+		})
+	})
+})
@@ -0,0 +1,273 @@
+package machinepool
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	commonUtils "github.com/openshift-online/ocm-common/pkg/utils"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa" //nolint:depguard
+)
+
+const (
+	onDemandPoolSuffix = "-on-demand"
+	spotPoolSuffix     = "-spot-"
+)
+
+// SpotMachinePoolID returns the name of the spot machine pool of a group for the instance type at the
+// given index. The first instance type uses the name of the group, the others are numbered, for example
+// 'workers-spot-1'.
+func SpotMachinePoolID(groupName string, index int) string {
+	if index == 0 {
+		return groupName
+	}
+	return fmt.Sprintf("%s%s%d", groupName, spotPoolSuffix, index)
+}
+
+// OnDemandMachinePoolID returns the name of the on-demand machine pool that the spot machine pools of a
+// group fall back to, for example 'workers-on-demand'
+func OnDemandMachinePoolID(groupName string) string {
+	return groupName + onDemandPoolSuffix
+}
+
+// ValidateSpotPercentage checks that the spot percentage leaves replicas to both the spot machine pools
+// and the on-demand machine pool
+func ValidateSpotPercentage(spotPercentage int) error {
+	if spotPercentage < 1 || spotPercentage > 99 {
+		return fmt.Errorf("spot percentage must be between 1 and 99, use '--use-spot-instances' without a "+
+			"spot percentage for a machine pool of spot instances only, got %d", spotPercentage)
+	}
+	return nil
+}
+
+// SpotSplit is the number of replicas of each spot machine pool of a group and of its on-demand machine pool
+type SpotSplit struct {
+	Spot     []int
+	OnDemand int
+}
+
+// SplitSpotReplicas divides replicas between the spot machine pools, one per instance type, and the on-demand
+// machine pool. Replicas are split in steps of the number of availability zones of the machine pools so that
+// multi-AZ machine pools keep the same number of nodes in every zone.
+func SplitSpotReplicas(replicas int, spotPercentage int, instanceTypes int, zones int) SpotSplit {
+	units := replicas / zones
+	spotUnits := int(math.Round(float64(units*spotPercentage) / 100))
+	spot := DistributeReplicas(spotUnits, instanceTypes)
+	for i := range spot {
+		spot[i] *= zones
+	}
+	return SpotSplit{Spot: spot, OnDemand: replicas - spotUnits*zones}
+}
+
+// BuildSpotGroup returns the machine pools of a spot group built from the machine pool of the group: one
+// spot machine pool per instance type and the on-demand machine pool, with the replicas of the split. The
+// machine pools keep the other settings of the machine pool of the group, the first instance type is its
+// instance type.
+func BuildSpotGroup(machinePool *cmv1.MachinePool, instanceTypes []string, spotMaxPrice string,
+	split SpotSplit, minSplit SpotSplit) ([]*cmv1.MachinePool, error) {
+	err := spotMaxPriceValidator(spotMaxPrice)
+	if err != nil {
+		return nil, err
+	}
+	spotBuilder := cmv1.NewAWSSpotMarketOptions()
+	if spotMaxPrice != spotPriceOnDemand {
+		price, _ := strconv.ParseFloat(spotMaxPrice, commonUtils.MaxByteSize)
+		spotBuilder = spotBuilder.MaxPrice(price)
+	}
+	_, autoscaling := machinePool.GetAutoscaling()
+	build := func(id string, instanceType string, minReplicas int, replicas int,
+		spot *cmv1.AWSSpotMarketOptionsBuilder) (*cmv1.MachinePool, error) {
+		builder := cmv1.NewMachinePool().Copy(machinePool).
+			ID(id).
+			InstanceType(instanceType).
+			AWS(cmv1.NewAWSMachinePool().Copy(machinePool.AWS()).SpotMarketOptions(spot))
+		if autoscaling {
+			builder = builder.Autoscaling(cmv1.NewMachinePoolAutoscaling().
+				MinReplicas(minReplicas).
+				MaxReplicas(replicas))
+		} else {
+			builder = builder.Replicas(replicas)
+		}
+		return builder.Build()
+	}
+
+	machinePools := []*cmv1.MachinePool{}
+	for i, instanceType := range instanceTypes {
+		spotMachinePool, err := build(SpotMachinePoolID(machinePool.ID(), i), instanceType, minSplit.Spot[i],
+			split.Spot[i], spotBuilder)
+		if err != nil {
+			return nil, err
+		}
+		machinePools = append(machinePools, spotMachinePool)
+	}
+	onDemand, err := build(OnDemandMachinePoolID(machinePool.ID()), machinePool.InstanceType(),
+		minSplit.OnDemand, split.OnDemand, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(machinePools, onDemand), nil
+}
+
+// RollbackSpotMachinePools deletes the machine pools of a spot group that were created before the creation
+// of another one failed. The error tells which machine pools could not be deleted.
+func RollbackSpotMachinePools(ocmClient *ocm.Client, clusterID string, created []string, err error) error {
+	if len(created) == 0 {
+		return err
+	}
+	remaining := []string{}
+	for _, id := range created {
+		if ocmClient.DeleteMachinePool(clusterID, id) != nil {
+			remaining = append(remaining, id)
+		}
+	}
+	if len(remaining) > 0 {
+		return fmt.Errorf("%v, machine pools '%s' were created and could not be deleted", err,
+			strings.Join(remaining, "', '"))
+	}
+	return fmt.Errorf("%v, machine pools '%s' that were already created have been deleted", err,
+		strings.Join(created, "', '"))
+}
+
+// SpotGroup is a set of classic machine pools created with a spot percentage: the spot machine pools, one
+// per instance type, and the on-demand machine pool with the same labels and taints that they fall back to
+type SpotGroup struct {
+	Name     string
+	Spot     []*cmv1.MachinePool
+	OnDemand *cmv1.MachinePool
+}
+
+// FindSpotGroup returns the spot group that a machine pool is part of, or nil if there is none. A group
+// needs both spot machine pools and an on-demand machine pool named after the group.
+func FindSpotGroup(machinePools []*cmv1.MachinePool, machinePoolId string) *SpotGroup {
+	name := spotGroupName(machinePoolId)
+	group := &SpotGroup{Name: name}
+	for _, machinePool := range machinePools {
+		switch {
+		case machinePool.ID() == OnDemandMachinePoolID(name):
+			if !isSpotMachinePool(machinePool) {
+				group.OnDemand = machinePool
+			}
+		case spotGroupName(machinePool.ID()) == name:
+			if isSpotMachinePool(machinePool) {
+				group.Spot = append(group.Spot, machinePool)
+			}
+		}
+	}
+	if group.OnDemand == nil || len(group.Spot) == 0 {
+		return nil
+	}
+	sort.Slice(group.Spot, func(i, j int) bool {
+		return spotPoolIndex(group.Spot[i].ID()) < spotPoolIndex(group.Spot[j].ID())
+	})
+	return group
+}
+
+// spotGroupName returns the name of the group that a machine pool would belong to based on its name
+func spotGroupName(machinePoolId string) string {
+	if name, ok := strings.CutSuffix(machinePoolId, onDemandPoolSuffix); ok && name != "" {
+		return name
+	}
+	if index := strings.LastIndex(machinePoolId, spotPoolSuffix); index > 0 {
+		if _, err := strconv.Atoi(machinePoolId[index+len(spotPoolSuffix):]); err == nil {
+			return machinePoolId[:index]
+		}
+	}
+	return machinePoolId
+}
+
+func spotPoolIndex(machinePoolId string) int {
+	if index := strings.LastIndex(machinePoolId, spotPoolSuffix); index > 0 {
+		if number, err := strconv.Atoi(machinePoolId[index+len(spotPoolSuffix):]); err == nil {
+			return number
+		}
+	}
+	return 0
+}
+
+// mayBeInSpotGroup returns true if the machine pool can be part of a spot group, so that the other machine
+// pools of the cluster are only fetched when needed
+func mayBeInSpotGroup(machinePool *cmv1.MachinePool) bool {
+	return isSpotMachinePool(machinePool) || strings.HasSuffix(machinePool.ID(), onDemandPoolSuffix)
+}
+
+func isSpotMachinePool(machinePool *cmv1.MachinePool) bool {
+	return machinePool.AWS().SpotMarketOptions() != nil
+}
+
+// machinePoolReplicaRange returns the minimum and maximum replicas of a machine pool, the same number for
+// a machine pool without autoscaling
+func machinePoolReplicaRange(machinePool *cmv1.MachinePool) (int, int) {
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		return autoscaling.MinReplicas(), autoscaling.MaxReplicas()
+	}
+	return machinePool.Replicas(), machinePool.Replicas()
+}
+
+func formatReplicaRange(minReplicas int, maxReplicas int) string {
+	if minReplicas == maxReplicas {
+		return fmt.Sprintf("%d replicas", maxReplicas)
+	}
+	return fmt.Sprintf("%d-%d replicas", minReplicas, maxReplicas)
+}
+
+// spotGroupOutput reports the effective split of the replicas of a spot group between spot and on-demand
+// instances. Autoscaling machine pools are weighed by their maximum replicas.
+func spotGroupOutput(group *SpotGroup) string {
+	spotReplicas := 0
+	lines := ""
+	addLine := func(machinePool *cmv1.MachinePool, market string) int {
+		minReplicas, maxReplicas := machinePoolReplicaRange(machinePool)
+		lines += fmt.Sprintf("\n  - %-35s%s, %s, %s", machinePool.ID()+":", market, machinePool.InstanceType(),
+			formatReplicaRange(minReplicas, maxReplicas))
+		return maxReplicas
+	}
+	for _, machinePool := range group.Spot {
+		spotReplicas += addLine(machinePool, "spot")
+	}
+	onDemandReplicas := addLine(group.OnDemand, "on-demand")
+
+	split := "-"
+	if total := spotReplicas + onDemandReplicas; total > 0 {
+		spotPercentage := int(math.Round(float64(spotReplicas*100) / float64(total)))
+		split = fmt.Sprintf("%d%% spot, %d%% on-demand", spotPercentage, 100-spotPercentage)
+	}
+	return fmt.Sprintf("Spot/on-demand split:                  %s%s\n", split, lines)
+}
+
+// ValidateSpotInstanceTypes checks that the instance types of the spot machine pools of a group are offered
+// in the availability zones of the machine pool and that there is quota for them
+func ValidateSpotInstanceTypes(r *rosa.Runtime, cluster *cmv1.Cluster, instanceTypes []string,
+	multiAZMachinePool bool, availabilityZone string, subnet string) error {
+	availabilityZones, err := getMachinePoolAvailabilityZones(r, cluster, multiAZMachinePool, availabilityZone,
+		subnet)
+	if err != nil {
+		return err
+	}
+	instanceTypeList, err := r.OCMClient.GetAvailableMachineTypesInRegion(
+		cluster.Region().ID(),
+		availabilityZones,
+		cluster.AWS().STS().RoleARN(),
+		r.AWSClient,
+		cluster.AWS().STS().ExternalID(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get the available instance types: %v", err)
+	}
+	for _, instanceType := range instanceTypes {
+		machineType := instanceTypeList.Find(instanceType)
+		if machineType == nil || !machineType.Available {
+			return fmt.Errorf("spot instance type '%s' is not available in availability zones '%s'",
+				instanceType, strings.Join(availabilityZones, ", "))
+		}
+		err = instanceTypeList.ValidateMachineType(instanceType, cluster.MultiAZ())
+		if err != nil {
+			return fmt.Errorf("expected a valid spot instance type: %s", err)
+		}
+	}
+	return nil
+}
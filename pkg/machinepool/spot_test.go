@@ -0,0 +1,118 @@
+package machinepool
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Spot machine pools", func() {
+	machinePool := func(id string, instanceType string, spot bool, replicas int) *cmv1.MachinePool {
+		awsMachinePool := cmv1.NewAWSMachinePool()
+		if spot {
+			awsMachinePool.SpotMarketOptions(cmv1.NewAWSSpotMarketOptions())
+		}
+		machinePool, err := cmv1.NewMachinePool().ID(id).InstanceType(instanceType).Replicas(replicas).
+			AWS(awsMachinePool).Build()
+		Expect(err).NotTo(HaveOccurred())
+		return machinePool
+	}
+
+	It("Names the machine pools of a group", func() {
+		Expect(SpotMachinePoolID("workers", 0)).To(Equal("workers"))
+		Expect(SpotMachinePoolID("workers", 2)).To(Equal("workers-spot-2"))
+		Expect(OnDemandMachinePoolID("workers")).To(Equal("workers-on-demand"))
+	})
+
+	It("Validates the spot percentage", func() {
+		Expect(ValidateSpotPercentage(50)).To(Succeed())
+		Expect(ValidateSpotPercentage(0)).To(MatchError(ContainSubstring("must be between 1 and 99")))
+		Expect(ValidateSpotPercentage(100)).To(MatchError(ContainSubstring("must be between 1 and 99")))
+	})
+
+	It("Splits the replicas between spot and on-demand machine pools", func() {
+		Expect(SplitSpotReplicas(6, 67, 2, 1)).To(Equal(SpotSplit{Spot: []int{2, 2}, OnDemand: 2}))
+		Expect(SplitSpotReplicas(10, 75, 1, 1)).To(Equal(SpotSplit{Spot: []int{8}, OnDemand: 2}))
+		Expect(SplitSpotReplicas(9, 50, 1, 3)).To(Equal(SpotSplit{Spot: []int{6}, OnDemand: 3}))
+		Expect(SplitSpotReplicas(12, 50, 2, 3)).To(Equal(SpotSplit{Spot: []int{3, 3}, OnDemand: 6}))
+	})
+
+	It("Builds the machine pools of a group from the machine pool of the group", func() {
+		groupMachinePool, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").
+			Labels(map[string]string{"team": "a"}).
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(3).MaxReplicas(6)).
+			AWS(cmv1.NewAWSMachinePool().Tags(map[string]string{"owner": "a"})).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		machinePools, err := BuildSpotGroup(groupMachinePool, []string{"m5.xlarge", "m5a.xlarge"}, "0.5",
+			SplitSpotReplicas(6, 67, 2, 1), SplitSpotReplicas(3, 67, 2, 1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machinePools).To(HaveLen(3))
+		for i, expected := range []struct {
+			id           string
+			instanceType string
+			minReplicas  int
+			maxReplicas  int
+			spot         bool
+		}{
+			{"workers", "m5.xlarge", 1, 2, true},
+			{"workers-spot-1", "m5a.xlarge", 1, 2, true},
+			{"workers-on-demand", "m5.xlarge", 1, 2, false},
+		} {
+			Expect(machinePools[i].ID()).To(Equal(expected.id))
+			Expect(machinePools[i].InstanceType()).To(Equal(expected.instanceType))
+			Expect(machinePools[i].Autoscaling().MinReplicas()).To(Equal(expected.minReplicas))
+			Expect(machinePools[i].Autoscaling().MaxReplicas()).To(Equal(expected.maxReplicas))
+			Expect(isSpotMachinePool(machinePools[i])).To(Equal(expected.spot))
+			Expect(machinePools[i].Labels()).To(Equal(map[string]string{"team": "a"}))
+			Expect(machinePools[i].AWS().Tags()).To(Equal(map[string]string{"owner": "a"}))
+		}
+		Expect(machinePools[0].AWS().SpotMarketOptions().MaxPrice()).To(Equal(0.5))
+	})
+
+	It("Builds spot machine pools without a max price for the on-demand price", func() {
+		groupMachinePool := machinePool("workers", "m5.xlarge", false, 4)
+		machinePools, err := BuildSpotGroup(groupMachinePool, []string{"m5.xlarge"}, "on-demand",
+			SplitSpotReplicas(4, 50, 1, 1), SplitSpotReplicas(0, 50, 1, 1))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(machinePools[0].Replicas()).To(Equal(2))
+		_, ok := machinePools[0].AWS().SpotMarketOptions().GetMaxPrice()
+		Expect(ok).To(BeFalse())
+		Expect(machinePools[1].Replicas()).To(Equal(2))
+		Expect(isSpotMachinePool(machinePools[1])).To(BeFalse())
+	})
+
+	It("Finds the group of a machine pool", func() {
+		machinePools := []*cmv1.MachinePool{
+			machinePool("workers-spot-1", "m5a.xlarge", true, 2),
+			machinePool("workers", "m5.xlarge", true, 2),
+			machinePool("workers-on-demand", "m5.xlarge", false, 2),
+			machinePool("infra", "m5.xlarge", true, 3),
+		}
+		for _, id := range []string{"workers", "workers-spot-1", "workers-on-demand"} {
+			group := FindSpotGroup(machinePools, id)
+			Expect(group).NotTo(BeNil())
+			Expect(group.Name).To(Equal("workers"))
+			Expect(group.Spot).To(HaveLen(2))
+			Expect(group.Spot[0].ID()).To(Equal("workers"))
+			Expect(group.OnDemand.ID()).To(Equal("workers-on-demand"))
+		}
+		Expect(FindSpotGroup(machinePools, "infra")).To(BeNil())
+	})
+
+	It("Reports the spot and on-demand split", func() {
+		group := &SpotGroup{
+			Name: "workers",
+			Spot: []*cmv1.MachinePool{
+				machinePool("workers", "m5.xlarge", true, 2),
+				machinePool("workers-spot-1", "m5a.xlarge", true, 2),
+			},
+			OnDemand: machinePool("workers-on-demand", "m5.xlarge", false, 2),
+		}
+		Expect(spotGroupOutput(group)).To(Equal("" +
+			"Spot/on-demand split:                  67% spot, 33% on-demand\n" +
+			"  - workers:                           spot, m5.xlarge, 2 replicas\n" +
+			"  - workers-spot-1:                    spot, m5a.xlarge, 2 replicas\n" +
+			"  - workers-on-demand:                 on-demand, m5.xlarge, 2 replicas\n"))
+	})
+})
@@ -0,0 +1,303 @@
+package machinepool
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/poolmigration"
+)
+
+// SpreadGroupLabel marks the node pools created by spreading a machine pool across subnets, its value is
+// the name of the machine pool
+const SpreadGroupLabel = "rosa.openshift.io/spread-group"
+
+// SpreadSubnet is a private subnet of a hosted cluster that receives one node pool of a spread group
+type SpreadSubnet struct {
+	ID               string
+	AvailabilityZone string
+}
+
+// NodePoolGroup is a set of node pools spread across subnets that is shown as one machine pool. Node pools
+// that are not part of a group form a group of their own named after the node pool.
+type NodePoolGroup struct {
+	Name      string
+	NodePools []*cmv1.NodePool
+}
+
+// IsSpread returns true if the node pools of the group were spread across subnets
+func (g *NodePoolGroup) IsSpread() bool {
+	_, ok := spreadGroupName(g.NodePools[0])
+	return ok
+}
+
+// SpreadNodePoolID returns the name of the node pool of a group in the given availability zone, the
+// name of the group followed by the zone letter, for example 'workers-a' for zone 'us-east-1a'
+func SpreadNodePoolID(groupName string, availabilityZone string) string {
+	return fmt.Sprintf("%s-%s", groupName, zoneSuffix(availabilityZone))
+}
+
+// ValidateSpreadGroupName checks that the node pools of the group will have valid names in all the zones
+func ValidateSpreadGroupName(groupName string, subnets []SpreadSubnet) error {
+	for _, subnet := range subnets {
+		id := SpreadNodePoolID(groupName, subnet.AvailabilityZone)
+		if len(id) > poolmigration.MaxNodePoolNameLength {
+			return fmt.Errorf("machine pool name '%s' is too long to spread across subnets, the name of "+
+				"node pool '%s' must not exceed %d characters", groupName, id, poolmigration.MaxNodePoolNameLength)
+		}
+		if !MachinePoolKeyRE.MatchString(id) {
+			return fmt.Errorf("expected a valid name for machine pool '%s'", id)
+		}
+	}
+	return nil
+}
+
+func zoneSuffix(availabilityZone string) string {
+	// Zone names end with a letter after the region number, local zones with a letter after a number too
+	index := strings.LastIndexFunc(availabilityZone, unicode.IsDigit)
+	return availabilityZone[index+1:]
+}
+
+// GetSpreadSubnets returns one private subnet per availability zone of the cluster, sorted by zone, and
+// the private subnets that were not selected because their zone already has one
+func GetSpreadSubnets(awsClient aws.Client, cluster *cmv1.Cluster) ([]SpreadSubnet, []string, error) {
+	subnets, err := awsClient.ListSubnets(cluster.AWS().SubnetIDs()...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the subnets of the cluster: %v", err)
+	}
+	publicSubnets, err := awsClient.FetchPublicSubnetMap(subnets)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get the route tables of the cluster subnets: %v", err)
+	}
+	private := []SpreadSubnet{}
+	for _, subnet := range subnets {
+		id := awssdk.ToString(subnet.SubnetId)
+		if publicSubnets[id] {
+			continue
+		}
+		private = append(private, SpreadSubnet{ID: id, AvailabilityZone: awssdk.ToString(subnet.AvailabilityZone)})
+	}
+	sort.Slice(private, func(i, j int) bool {
+		if private[i].AvailabilityZone != private[j].AvailabilityZone {
+			return private[i].AvailabilityZone < private[j].AvailabilityZone
+		}
+		return private[i].ID < private[j].ID
+	})
+
+	selected := []SpreadSubnet{}
+	skipped := []string{}
+	zones := map[string]bool{}
+	for _, subnet := range private {
+		if zones[subnet.AvailabilityZone] {
+			skipped = append(skipped, subnet.ID)
+			continue
+		}
+		zones[subnet.AvailabilityZone] = true
+		selected = append(selected, subnet)
+	}
+	if len(selected) == 0 {
+		return nil, nil, fmt.Errorf("the cluster has no private subnets")
+	}
+	return selected, skipped, nil
+}
+
+// ReplicaShare is the part of the replicas or autoscaling limits of a spread group that one of its node
+// pools receives
+type ReplicaShare struct {
+	Autoscaling bool
+	Replicas    int
+	MinReplicas int
+	MaxReplicas int
+}
+
+// ShareReplicas divides the replicas or autoscaling limits of a spread group between its node pools
+func ShareReplicas(autoscaling bool, replicas int, minReplicas int, maxReplicas int, count int) []ReplicaShare {
+	replicaShares := DistributeReplicas(replicas, count)
+	minShares := DistributeReplicas(minReplicas, count)
+	maxShares := DistributeReplicas(maxReplicas, count)
+	shares := make([]ReplicaShare, count)
+	for i := range shares {
+		shares[i] = ReplicaShare{
+			Autoscaling: autoscaling,
+			Replicas:    replicaShares[i],
+			MinReplicas: minShares[i],
+			MaxReplicas: maxShares[i],
+		}
+	}
+	return shares
+}
+
+// BuildSpreadGroup returns the node pools of a group spread across the given subnets. Every node pool is a
+// copy of the node pool of the group, named after the group and the availability zone of its subnet,
+// labeled with the name of the group and sized with its share of the replicas.
+func BuildSpreadGroup(groupNodePool *cmv1.NodePool, subnets []SpreadSubnet,
+	shares []ReplicaShare) ([]*cmv1.NodePool, error) {
+	nodePools := []*cmv1.NodePool{}
+	for i, subnet := range subnets {
+		builder := cmv1.NewNodePool().Copy(groupNodePool).
+			ID(SpreadNodePoolID(groupNodePool.ID(), subnet.AvailabilityZone)).
+			Subnet(subnet.ID).
+			Labels(spreadGroupLabels(groupNodePool.Labels(), groupNodePool.ID()))
+		setReplicaShare(builder, shares[i])
+		nodePool, err := builder.Build()
+		if err != nil {
+			return nil, err
+		}
+		nodePools = append(nodePools, nodePool)
+	}
+	return nodePools, nil
+}
+
+// SpreadNodePoolUpdate returns the update of a node pool of a spread group: the edit of the group and the
+// share of the replicas of the node pool. The label that groups the node pools is kept when the labels
+// are edited.
+func SpreadNodePoolUpdate(edit *cmv1.NodePool, id string, groupName string,
+	share ReplicaShare) (*cmv1.NodePool, error) {
+	builder := cmv1.NewNodePool().ID(id)
+	applySpreadEdit(builder, edit, groupName)
+	setReplicaShare(builder, share)
+	return builder.Build()
+}
+
+// AddSpreadNodePool returns a node pool that adds a subnet to a spread group, a copy of one of the node
+// pools of the group with the edit of the group and its share of the replicas
+func AddSpreadNodePool(template *cmv1.NodePool, edit *cmv1.NodePool, groupName string, subnet SpreadSubnet,
+	share ReplicaShare) (*cmv1.NodePool, error) {
+	builder, _ := poolmigration.CloneNodePoolSettings(template, SpreadNodePoolID(groupName, subnet.AvailabilityZone),
+		template.AWSNodePool().InstanceType())
+	builder.Subnet(subnet.ID)
+	applySpreadEdit(builder, edit, groupName)
+	setReplicaShare(builder, share)
+	return builder.Build()
+}
+
+// applySpreadEdit sets the attributes changed by the edit of a spread group
+func applySpreadEdit(builder *cmv1.NodePoolBuilder, edit *cmv1.NodePool, groupName string) {
+	if labels, ok := edit.GetLabels(); ok {
+		builder.Labels(spreadGroupLabels(labels, groupName))
+	}
+	if taints, ok := edit.GetTaints(); ok {
+		builder.Taints(poolmigration.CloneTaints(taints)...)
+	}
+	if autoRepair, ok := edit.GetAutoRepair(); ok {
+		builder.AutoRepair(autoRepair)
+	}
+	if tuningConfigs, ok := edit.GetTuningConfigs(); ok {
+		builder.TuningConfigs(tuningConfigs...)
+	}
+	if kubeletConfigs, ok := edit.GetKubeletConfigs(); ok {
+		builder.KubeletConfigs(kubeletConfigs...)
+	}
+	if gracePeriod, ok := edit.GetNodeDrainGracePeriod(); ok {
+		builder.NodeDrainGracePeriod(cmv1.NewValue().Value(gracePeriod.Value()).Unit(gracePeriod.Unit()))
+	}
+	if upgrade, ok := edit.GetManagementUpgrade(); ok {
+		upgradeBuilder := cmv1.NewNodePoolManagementUpgrade()
+		if maxSurge, ok := upgrade.GetMaxSurge(); ok {
+			upgradeBuilder.MaxSurge(maxSurge)
+		}
+		if maxUnavailable, ok := upgrade.GetMaxUnavailable(); ok {
+			upgradeBuilder.MaxUnavailable(maxUnavailable)
+		}
+		builder.ManagementUpgrade(upgradeBuilder)
+	}
+}
+
+func setReplicaShare(builder *cmv1.NodePoolBuilder, share ReplicaShare) {
+	if share.Autoscaling {
+		builder.Autoscaling(cmv1.NewNodePoolAutoscaling().
+			MinReplica(share.MinReplicas).
+			MaxReplica(share.MaxReplicas))
+	} else {
+		builder.Autoscaling(nil).Replicas(share.Replicas)
+	}
+}
+
+// spreadGroupLabels returns the labels with the label that groups the node pools of a spread group
+func spreadGroupLabels(labels map[string]string, groupName string) map[string]string {
+	result := map[string]string{}
+	for key, value := range labels {
+		result[key] = value
+	}
+	result[SpreadGroupLabel] = groupName
+	return result
+}
+
+// RollbackSpreadNodePools deletes the node pools already created for a spread group when the creation of
+// the next one failed with err. The returned error lists the node pools that could not be deleted.
+func RollbackSpreadNodePools(ocmClient *ocm.Client, clusterID string, created []string, err error) error {
+	if len(created) == 0 {
+		return err
+	}
+	remaining := []string{}
+	for _, id := range created {
+		if ocmClient.DeleteNodePool(clusterID, id) != nil {
+			remaining = append(remaining, id)
+		}
+	}
+	if len(remaining) > 0 {
+		return fmt.Errorf("%v, machine pools '%s' were created and could not be deleted", err,
+			strings.Join(remaining, "', '"))
+	}
+	return fmt.Errorf("%v, machine pools '%s' that were already created have been deleted", err,
+		strings.Join(created, "', '"))
+}
+
+// DistributeReplicas splits a number of replicas across node pools, the first ones receiving one more
+// replica when they can't be split evenly
+func DistributeReplicas(total int, count int) []int {
+	shares := make([]int, count)
+	for i := range shares {
+		shares[i] = total / count
+		if i < total%count {
+			shares[i]++
+		}
+	}
+	return shares
+}
+
+// GroupNodePools groups the node pools that were spread across subnets, keeping the order of the node
+// pools. Node pools labeled with the name of a group belong to it.
+func GroupNodePools(nodePools []*cmv1.NodePool) []*NodePoolGroup {
+	members := map[string][]*cmv1.NodePool{}
+	for _, nodePool := range nodePools {
+		if name, ok := spreadGroupName(nodePool); ok {
+			members[name] = append(members[name], nodePool)
+		}
+	}
+	groups := []*NodePoolGroup{}
+	added := map[string]bool{}
+	for _, nodePool := range nodePools {
+		name, ok := spreadGroupName(nodePool)
+		if !ok {
+			groups = append(groups, &NodePoolGroup{Name: nodePool.ID(), NodePools: []*cmv1.NodePool{nodePool}})
+			continue
+		}
+		if !added[name] {
+			added[name] = true
+			groups = append(groups, &NodePoolGroup{Name: name, NodePools: members[name]})
+		}
+	}
+	return groups
+}
+
+// FindNodePoolGroup returns the spread group with the given name, or nil if there is none
+func FindNodePoolGroup(nodePools []*cmv1.NodePool, name string) *NodePoolGroup {
+	for _, group := range GroupNodePools(nodePools) {
+		if group.IsSpread() && group.Name == name {
+			return group
+		}
+	}
+	return nil
+}
+
+func spreadGroupName(nodePool *cmv1.NodePool) (string, bool) {
+	name, ok := nodePool.Labels()[SpreadGroupLabel]
+	return name, ok && name != ""
+}
@@ -0,0 +1,175 @@
+package machinepool
+
+import (
+	"strings"
+
+	"go.uber.org/mock/gomock"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	mock "github.com/openshift/rosa/pkg/aws"
+)
+
+var _ = Describe("Spread machine pools", func() {
+	spreadNodePool := func(id string, group string, zone string, replicas int) *cmv1.NodePool {
+		builder := cmv1.NewNodePool().ID(id).AvailabilityZone(zone).Subnet("subnet-" + id).
+			Replicas(replicas).Status(cmv1.NewNodePoolStatus().CurrentReplicas(replicas)).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge"))
+		if group != "" {
+			builder.Labels(map[string]string{SpreadGroupLabel: group})
+		}
+		nodePool, err := builder.Build()
+		Expect(err).NotTo(HaveOccurred())
+		return nodePool
+	}
+	nodePool := func(id string, zone string, replicas int) *cmv1.NodePool {
+		return spreadNodePool(id, "", zone, replicas)
+	}
+
+	It("Names the node pools after the zone", func() {
+		Expect(SpreadNodePoolID("workers", "us-east-1a")).To(Equal("workers-a"))
+		Expect(SpreadNodePoolID("workers", "us-east-1-bos-1a")).To(Equal("workers-a"))
+	})
+
+	It("Validates the length of the node pool names", func() {
+		subnets := []SpreadSubnet{{ID: "subnet-1", AvailabilityZone: "us-east-1a"}}
+		Expect(ValidateSpreadGroupName("workers", subnets)).To(Succeed())
+		err := ValidateSpreadGroupName("very-long-name", subnets)
+		Expect(err).To(MatchError(ContainSubstring("node pool 'very-long-name-a' must not exceed 15 characters")))
+	})
+
+	It("Distributes replicas evenly", func() {
+		Expect(DistributeReplicas(7, 3)).To(Equal([]int{3, 2, 2}))
+		Expect(DistributeReplicas(6, 3)).To(Equal([]int{2, 2, 2}))
+		Expect(DistributeReplicas(1, 3)).To(Equal([]int{1, 0, 0}))
+	})
+
+	It("Builds the node pools of a group from the node pool of the group", func() {
+		groupNodePool, err := cmv1.NewNodePool().ID("workers").Subnet("subnet-1").
+			Labels(map[string]string{"team": "a"}).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).
+			Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(3).MaxReplica(5)).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		subnets := []SpreadSubnet{
+			{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+			{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+		}
+		nodePools, err := BuildSpreadGroup(groupNodePool, subnets, ShareReplicas(true, 0, 3, 5, 2))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodePools).To(HaveLen(2))
+		Expect(nodePools[1].ID()).To(Equal("workers-b"))
+		Expect(nodePools[1].Subnet()).To(Equal("subnet-2"))
+		Expect(nodePools[1].AWSNodePool().InstanceType()).To(Equal("m5.xlarge"))
+		Expect(nodePools[1].Labels()).To(Equal(map[string]string{"team": "a", SpreadGroupLabel: "workers"}))
+		Expect(nodePools[0].Autoscaling().MinReplica()).To(Equal(2))
+		Expect(nodePools[0].Autoscaling().MaxReplica()).To(Equal(3))
+		Expect(nodePools[1].Autoscaling().MinReplica()).To(Equal(1))
+		Expect(nodePools[1].Autoscaling().MaxReplica()).To(Equal(2))
+		Expect(groupNodePool.Labels()).NotTo(HaveKey(SpreadGroupLabel))
+	})
+
+	It("Keeps the spread group label when the labels of a node pool are edited", func() {
+		edit, err := cmv1.NewNodePool().ID("workers-a").Labels(map[string]string{"team": "b"}).Build()
+		Expect(err).NotTo(HaveOccurred())
+		update, err := SpreadNodePoolUpdate(edit, "workers-b", "workers", ReplicaShare{Replicas: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(update.ID()).To(Equal("workers-b"))
+		Expect(update.Labels()).To(Equal(map[string]string{"team": "b", SpreadGroupLabel: "workers"}))
+		Expect(update.Replicas()).To(Equal(2))
+		_, ok := update.GetAutoscaling()
+		Expect(ok).To(BeFalse())
+
+		edit, err = cmv1.NewNodePool().ID("workers-a").Build()
+		Expect(err).NotTo(HaveOccurred())
+		update, err = SpreadNodePoolUpdate(edit, "workers-b", "workers", ReplicaShare{Replicas: 2})
+		Expect(err).NotTo(HaveOccurred())
+		_, ok = update.GetLabels()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Adds a node pool to a group from an existing one with the edit of the group", func() {
+		template := spreadNodePool("workers-a", "workers", "us-east-1a", 2)
+		edit, err := cmv1.NewNodePool().ID("workers-a").AutoRepair(false).Build()
+		Expect(err).NotTo(HaveOccurred())
+		nodePool, err := AddSpreadNodePool(template, edit, "workers",
+			SpreadSubnet{ID: "subnet-2", AvailabilityZone: "us-east-1b"}, ReplicaShare{Replicas: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodePool.ID()).To(Equal("workers-b"))
+		Expect(nodePool.Subnet()).To(Equal("subnet-2"))
+		Expect(nodePool.AWSNodePool().InstanceType()).To(Equal("m5.xlarge"))
+		Expect(nodePool.Labels()).To(HaveKeyWithValue(SpreadGroupLabel, "workers"))
+		Expect(nodePool.AutoRepair()).To(BeFalse())
+		Expect(nodePool.Replicas()).To(Equal(1))
+	})
+
+	It("Groups the node pools spread across zones", func() {
+		nodePools := []*cmv1.NodePool{
+			spreadNodePool("workers-a", "workers", "us-east-1a", 2),
+			nodePool("db", "us-east-1a", 1),
+			spreadNodePool("workers-b", "workers", "us-east-1b", 1),
+			nodePool("infra-c", "us-east-1c", 1),
+		}
+		groups := GroupNodePools(nodePools)
+		Expect(groups).To(HaveLen(3))
+		Expect(groups[0].Name).To(Equal("workers"))
+		Expect(groups[0].IsSpread()).To(BeTrue())
+		Expect(groups[0].NodePools).To(HaveLen(2))
+		Expect(groups[1].Name).To(Equal("db"))
+		Expect(groups[2].Name).To(Equal("infra-c"))
+		Expect(groups[2].IsSpread()).To(BeFalse())
+
+		Expect(FindNodePoolGroup(nodePools, "workers")).NotTo(BeNil())
+		Expect(FindNodePoolGroup(nodePools, "infra")).To(BeNil())
+	})
+
+	It("Doesn't group node pools that are only named like a spread group", func() {
+		nodePools := []*cmv1.NodePool{
+			nodePool("workers-a", "us-east-1a", 2),
+			nodePool("workers-b", "us-east-1b", 1),
+		}
+		groups := GroupNodePools(nodePools)
+		Expect(groups).To(HaveLen(2))
+		Expect(groups[0].Name).To(Equal("workers-a"))
+		Expect(groups[1].Name).To(Equal("workers-b"))
+		Expect(FindNodePoolGroup(nodePools, "workers")).To(BeNil())
+	})
+
+	It("Lists a spread group as one machine pool", func() {
+		out := getNodePoolsString([]*cmv1.NodePool{
+			spreadNodePool("workers-a", "workers", "us-east-1a", 2),
+			spreadNodePool("workers-b", "workers", "us-east-1b", 1),
+		}, nil)
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[1]).To(HavePrefix("workers\tNo\t3/3\tm5.xlarge\t"))
+		Expect(lines[1]).To(ContainSubstring("us-east-1a, us-east-1b\tsubnet-workers-a, subnet-workers-b\t"))
+	})
+
+	It("Selects one private subnet per zone", func() {
+		awsClient := mock.NewMockClient(gomock.NewController(GinkgoT()))
+		cluster, err := cmv1.NewCluster().AWS(cmv1.NewAWS().
+			SubnetIDs("subnet-1", "subnet-2", "subnet-3", "subnet-4")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		subnets := []ec2types.Subnet{
+			{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+			{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-3"), AvailabilityZone: awssdk.String("us-east-1a")},
+			{SubnetId: awssdk.String("subnet-4"), AvailabilityZone: awssdk.String("us-east-1a")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2", "subnet-3", "subnet-4").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{"subnet-4": true}, nil)
+
+		selected, skipped, err := GetSpreadSubnets(awsClient, cluster)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selected).To(Equal([]SpreadSubnet{
+			{ID: "subnet-1", AvailabilityZone: "us-east-1a"},
+			{ID: "subnet-2", AvailabilityZone: "us-east-1b"},
+		}))
+		Expect(skipped).To(Equal([]string{"subnet-3"}))
+	})
+})
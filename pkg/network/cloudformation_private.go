@@ -0,0 +1,393 @@
+package network
+
+// This file is used for binary builds
+//
+//nolint:lll
+const PrivateTemplateBody = `
+AWSTemplateFormatVersion: '2010-09-09'
+Description: CloudFormation template to create a ROSA Quickstart private VPC without internet access.
+  Only private subnets are created, AWS services are reached through VPC endpoints.
+  Use it for private or PrivateLink clusters that have no internet egress.
+
+Parameters:
+  AvailabilityZoneCount:
+    Type: Number
+    Description: "Number of Availability Zones to use"
+    Default: 1
+    MinValue: 1
+    MaxValue: 4
+  AZ1:
+    Type: String
+    Description: "First availability zone to use"
+    Default: ""
+  AZ2:
+    Type: String
+    Description: "Second availability zone to use"
+    Default: ""
+  AZ3:
+    Type: String
+    Description: "Third availability zone to use"
+    Default: ""
+  AZ4:
+    Type: String
+    Description: "Fourth availability zone to use"
+    Default: ""
+  Region:
+    Type: String
+    Description: "AWS Region"
+    Default: "us-west-2"
+  Name:
+    Type: String
+    Description: "Name prefix for resources"
+    MinLength: 1
+    MaxLength: 64
+  VpcCidr:
+    Type: String
+    Description: "CIDR block for the VPC, private subnets are /20 blocks of it"
+    Default: '10.0.0.0/16'
+    AllowedPattern: '^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])/(1[6-8])$'
+    ConstraintDescription: "must be an IPv4 CIDR block with a prefix length between /16 and /18"
+
+Conditions:
+  AZ1Explicit: !Not [!Equals [!Ref AZ1, ""]]
+  AZ2Explicit: !Not [!Equals [!Ref AZ2, ""]]
+  AZ3Explicit: !Not [!Equals [!Ref AZ3, ""]]
+  AZ4Explicit: !Not [!Equals [!Ref AZ4, ""]]
+
+  ExplicitAZs:   !Or [!Condition AZ1Explicit, !Condition AZ2Explicit, !Condition AZ3Explicit, !Condition AZ4Explicit]
+  NoExplicitAZs: !Not [!Condition ExplicitAZs]
+
+  AZ4Implicit: !Equals [!Ref AvailabilityZoneCount, 4]
+  AZ3Implicit: !Or [!Equals [!Ref AvailabilityZoneCount, 3], !Condition AZ4Implicit]
+  AZ2Implicit: !Or [!Equals [!Ref AvailabilityZoneCount, 2], !Condition AZ3Implicit]
+  AZ1Implicit: !Or [!Equals [!Ref AvailabilityZoneCount, 1], !Condition AZ2Implicit]
+
+  One:   !Or [!And [!Condition ExplicitAZs, !Condition AZ1Explicit], !And [!Condition NoExplicitAZs, !Condition AZ1Implicit]]
+  Two:   !Or [!And [!Condition ExplicitAZs, !Condition AZ2Explicit], !And [!Condition NoExplicitAZs, !Condition AZ2Implicit]]
+  Three: !Or [!And [!Condition ExplicitAZs, !Condition AZ3Explicit], !And [!Condition NoExplicitAZs, !Condition AZ3Implicit]]
+  Four:  !Or [!And [!Condition ExplicitAZs, !Condition AZ4Explicit], !And [!Condition NoExplicitAZs, !Condition AZ4Implicit]]
+
+Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: !Ref VpcCidr
+      EnableDnsSupport: true
+      EnableDnsHostnames: true
+      Tags:
+        - Key: Name
+          Value: !Ref Name
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+
+  SubnetPrivate1:
+    Type: AWS::EC2::Subnet
+    Condition: One
+    Properties:
+      VpcId: !Ref VPC
+      CidrBlock: !Select [0, !Cidr [!Ref VpcCidr, 4, 12]]
+      AvailabilityZone: !If [ExplicitAZs, !Ref AZ1, !Select [0, !GetAZs '']]
+      MapPublicIpOnLaunch: false
+      Tags:
+        - Key: Name
+          Value: !Sub "${Name}-Private-Subnet-1"
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+        - Key: 'kubernetes.io/role/internal-elb'
+          Value: '1'
+
+  SubnetPrivate2:
+    Type: AWS::EC2::Subnet
+    Condition: Two
+    Properties:
+      VpcId: !Ref VPC
+      CidrBlock: !Select [1, !Cidr [!Ref VpcCidr, 4, 12]]
+      AvailabilityZone: !If [ExplicitAZs, !Ref AZ2, !Select [1, !GetAZs '']]
+      MapPublicIpOnLaunch: false
+      Tags:
+        - Key: Name
+          Value: !Sub "${Name}-Private-Subnet-2"
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+        - Key: 'kubernetes.io/role/internal-elb'
+          Value: '1'
+
+  SubnetPrivate3:
+    Type: AWS::EC2::Subnet
+    Condition: Three
+    Properties:
+      VpcId: !Ref VPC
+      CidrBlock: !Select [2, !Cidr [!Ref VpcCidr, 4, 12]]
+      AvailabilityZone: !If [ExplicitAZs, !Ref AZ3, !Select [2, !GetAZs '']]
+      MapPublicIpOnLaunch: false
+      Tags:
+        - Key: Name
+          Value: !Sub "${Name}-Private-Subnet-3"
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+        - Key: 'kubernetes.io/role/internal-elb'
+          Value: '1'
+
+  SubnetPrivate4:
+    Type: AWS::EC2::Subnet
+    Condition: Four
+    Properties:
+      VpcId: !Ref VPC
+      CidrBlock: !Select [3, !Cidr [!Ref VpcCidr, 4, 12]]
+      AvailabilityZone: !If [ExplicitAZs, !Ref AZ4, !Select [3, !GetAZs '']]
+      MapPublicIpOnLaunch: false
+      Tags:
+        - Key: Name
+          Value: !Sub "${Name}-Private-Subnet-4"
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+        - Key: 'kubernetes.io/role/internal-elb'
+          Value: '1'
+
+  PrivateRouteTable:
+    Type: AWS::EC2::RouteTable
+    Properties:
+      VpcId: !Ref VPC
+      Tags:
+        - Key: Name
+          Value: !Sub "${Name}-Private-Route-Table"
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+
+  PrivateSubnetRouteTableAssociation1:
+    Condition: One
+    Type: AWS::EC2::SubnetRouteTableAssociation
+    Properties:
+      SubnetId: !Ref SubnetPrivate1
+      RouteTableId: !Ref PrivateRouteTable
+
+  PrivateSubnetRouteTableAssociation2:
+    Condition: Two
+    Type: AWS::EC2::SubnetRouteTableAssociation
+    Properties:
+      SubnetId: !Ref SubnetPrivate2
+      RouteTableId: !Ref PrivateRouteTable
+
+  PrivateSubnetRouteTableAssociation3:
+    Condition: Three
+    Type: AWS::EC2::SubnetRouteTableAssociation
+    Properties:
+      SubnetId: !Ref SubnetPrivate3
+      RouteTableId: !Ref PrivateRouteTable
+
+  PrivateSubnetRouteTableAssociation4:
+    Condition: Four
+    Type: AWS::EC2::SubnetRouteTableAssociation
+    Properties:
+      SubnetId: !Ref SubnetPrivate4
+      RouteTableId: !Ref PrivateRouteTable
+
+  S3VPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.s3"
+      VpcEndpointType: Gateway
+      RouteTableIds:
+        - !Ref PrivateRouteTable
+
+  SecurityGroup:
+    Type: AWS::EC2::SecurityGroup
+    Properties:
+      GroupDescription: "Authorize HTTPS traffic from the VPC to the VPC endpoints"
+      VpcId: !Ref VPC
+      SecurityGroupIngress:
+        - IpProtocol: tcp
+          FromPort: 443
+          ToPort: 443
+          CidrIp: !Ref VpcCidr
+      SecurityGroupEgress:
+        - IpProtocol: -1
+          FromPort: 0
+          ToPort: 0
+          CidrIp: !Ref VpcCidr
+      Tags:
+        - Key: Name
+          Value: !Ref Name
+        - Key: 'rosa_managed_policies'
+          Value: 'true'
+        - Key: 'rosa_hcp_policies'
+          Value: 'true'
+        - Key: 'service'
+          Value: 'ROSA'
+
+  EC2VPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.ec2"
+      PrivateDnsEnabled: true
+      VpcEndpointType: Interface
+      SubnetIds:
+        - !If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"]
+        - !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"]
+        - !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"]
+        - !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]
+      SecurityGroupIds:
+        - !Ref SecurityGroup
+
+  ELBVPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.elasticloadbalancing"
+      PrivateDnsEnabled: true
+      VpcEndpointType: Interface
+      SubnetIds:
+        - !If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"]
+        - !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"]
+        - !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"]
+        - !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]
+      SecurityGroupIds:
+        - !Ref SecurityGroup
+
+  KMSVPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.kms"
+      PrivateDnsEnabled: true
+      VpcEndpointType: Interface
+      SubnetIds:
+        - !If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"]
+        - !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"]
+        - !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"]
+        - !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]
+      SecurityGroupIds:
+        - !Ref SecurityGroup
+
+  STSVPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.sts"
+      PrivateDnsEnabled: true
+      VpcEndpointType: Interface
+      SubnetIds:
+        - !If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"]
+        - !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"]
+        - !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"]
+        - !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]
+      SecurityGroupIds:
+        - !Ref SecurityGroup
+
+  EcrApiVPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.ecr.api"
+      PrivateDnsEnabled: true
+      VpcEndpointType: Interface
+      SubnetIds:
+        - !If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"]
+        - !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"]
+        - !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"]
+        - !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]
+      SecurityGroupIds:
+        - !Ref SecurityGroup
+
+  EcrDkrVPCEndpoint:
+    Type: AWS::EC2::VPCEndpoint
+    Properties:
+      VpcId: !Ref VPC
+      ServiceName: !Sub "com.amazonaws.${Region}.ecr.dkr"
+      PrivateDnsEnabled: true
+      VpcEndpointType: Interface
+      SubnetIds:
+        - !If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"]
+        - !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"]
+        - !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"]
+        - !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]
+      SecurityGroupIds:
+        - !Ref SecurityGroup
+
+Outputs:
+  VPCId:
+    Description: "VPC Id"
+    Value: !Ref VPC
+    Export:
+      Name: !Sub "${Name}-VPCId"
+
+  PrivateSubnets:
+    Description: "Private Subnet Ids"
+    Value: !Join [",", [!If [One, !Ref SubnetPrivate1, !Ref "AWS::NoValue"], !If [Two, !Ref SubnetPrivate2, !Ref "AWS::NoValue"], !If [Three, !Ref SubnetPrivate3, !Ref "AWS::NoValue"], !If [Four, !Ref SubnetPrivate4, !Ref "AWS::NoValue"]]]
+    Export:
+      Name: !Sub "${Name}-PrivateSubnets"
+
+  PrivateRouteTableId:
+    Description: The ID of the private route table
+    Value: !Ref PrivateRouteTable
+    Export:
+      Name: !Sub "${Name}-PrivateRouteTableId"
+
+  SecurityGroupId:
+    Description: The ID of the VPC endpoints security group
+    Value: !Ref SecurityGroup
+    Export:
+      Name: !Sub "${Name}-SecurityGroupId"
+
+  EC2VPCEndpointId:
+    Description: The ID of the ec2 VPC Endpoint
+    Value: !Ref EC2VPCEndpoint
+    Export:
+      Name: !Sub "${Name}-EC2VPCEndpointId"
+
+  ELBVPCEndpointId:
+    Description: The ID of the elasticloadbalancing VPC Endpoint
+    Value: !Ref ELBVPCEndpoint
+    Export:
+      Name: !Sub "${Name}-ELBVPCEndpointId"
+
+  KMSVPCEndpointId:
+    Description: The ID of the kms VPC Endpoint
+    Value: !Ref KMSVPCEndpoint
+    Export:
+      Name: !Sub "${Name}-KMSVPCEndpointId"
+
+  STSVPCEndpointId:
+    Description: The ID of the sts VPC Endpoint
+    Value: !Ref STSVPCEndpoint
+    Export:
+      Name: !Sub "${Name}-STSVPCEndpointId"
+
+  EcrApiVPCEndpointId:
+    Description: The ID of the ecr.api VPC Endpoint
+    Value: !Ref EcrApiVPCEndpoint
+    Export:
+      Name: !Sub "${Name}-EcrApiVPCEndpointId"
+
+  EcrDkrVPCEndpointId:
+    Description: The ID of the ecr.dkr VPC Endpoint
+    Value: !Ref EcrDkrVPCEndpoint
+    Export:
+      Name: !Sub "${Name}-EcrDkrVPCEndpointId"
+`
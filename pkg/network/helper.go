@@ -138,7 +138,7 @@ func formatTags(tags map[string]string) string {
 func deleteHelperMessage(logger *logrus.Logger, params map[string]string, err error) {
 	logger.Errorf("Failed to create CloudFormation stack: %v", err)
 	logger.Infof("To delete all created resource stacks, run "+
-		"`aws cloudformation delete-stack --stack-name %s --region %s`",
+		"`rosa delete network %s --region %s`",
 		params["Name"], params["Region"])
 }
 
@@ -0,0 +1,255 @@
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+const (
+	DefaultMachineCIDR    = "10.0.0.0/16"
+	DefaultServiceCIDR    = "172.30.0.0/16"
+	DefaultPodCIDR        = "10.128.0.0/14"
+	DefaultHostPrefix     = 23
+	DefaultMaxPodsPerNode = 250
+
+	MinHostPrefix = 23
+	MaxHostPrefix = 26
+
+	serviceCIDRPrefix = 16
+	// Addresses of each node's pod subnet that are not available to pods (network, gateway and
+	// management port)
+	reservedAddressesPerNode = 3
+	// Machine addresses needed per node, leaving room for the public/private subnet split across
+	// availability zones, the addresses AWS reserves in each subnet and load balancers
+	machineAddressesPerNode = 4
+	maxMachinePrefix        = 25
+)
+
+// Ranges from which new CIDRs are proposed
+var privateRanges = mustParseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16")
+
+// Ranges used internally by OVN-Kubernetes that must not overlap with the cluster networks
+var ovnReservedRanges = mustParseCIDRs("100.64.0.0/16", "100.88.0.0/16", "169.254.169.0/29")
+
+// PlanInput holds the constraints used to plan the networks of a new cluster
+type PlanInput struct {
+	// MachineCIDR is the CIDR of an existing VPC, or nil to propose the CIDR of a new VPC
+	MachineCIDR *net.IPNet
+	// Reserved are the CIDRs already in use, such as VPC CIDR blocks and route destinations
+	Reserved       []*net.IPNet
+	MaxNodes       int
+	MaxPodsPerNode int
+}
+
+// Plan holds the proposed cluster networks
+type Plan struct {
+	MachineCIDR *net.IPNet
+	ServiceCIDR *net.IPNet
+	PodCIDR     *net.IPNet
+	HostPrefix  int
+	NewVpc      bool
+	Warnings    []string
+}
+
+// PlanNetwork proposes machine, service and pod CIDRs that don't overlap with each other or with the
+// reserved CIDRs, and the host prefix that fits the requested number of pods per node. The OpenShift
+// defaults are preferred whenever they are free.
+func PlanNetwork(input PlanInput) (*Plan, error) {
+	if input.MaxNodes < 1 {
+		return nil, fmt.Errorf("the maximum number of nodes must be greater than zero")
+	}
+	if input.MaxPodsPerNode < 1 {
+		return nil, fmt.Errorf("the maximum number of pods per node must be greater than zero")
+	}
+	if input.MaxPodsPerNode > UsablePodsPerNode(MinHostPrefix) {
+		return nil, fmt.Errorf("a node can run at most %d pods", UsablePodsPerNode(MinHostPrefix))
+	}
+
+	plan := &Plan{}
+	reserved := append([]*net.IPNet{}, input.Reserved...)
+	reserved = append(reserved, ovnReservedRanges...)
+
+	machineAddresses := input.MaxNodes * machineAddressesPerNode
+	if input.MachineCIDR == nil {
+		machinePrefix := min(32-bitsFor(machineAddresses), maxMachinePrefix)
+		plan.NewVpc = true
+		plan.MachineCIDR = allocate(DefaultMachineCIDR, min(machinePrefix, 16), machinePrefix, reserved)
+		if plan.MachineCIDR == nil {
+			return nil, fmt.Errorf("there is no free private range for a VPC with %d nodes", input.MaxNodes)
+		}
+		reserved = append(reserved, plan.MachineCIDR)
+	} else {
+		if input.MachineCIDR.IP.To4() == nil {
+			return nil, fmt.Errorf("only IPv4 machine CIDRs are supported")
+		}
+		plan.MachineCIDR = input.MachineCIDR
+		if size(plan.MachineCIDR) < machineAddresses {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+				"Machine CIDR '%s' has %d addresses, %d nodes might need up to %d",
+				plan.MachineCIDR, size(plan.MachineCIDR), input.MaxNodes, machineAddresses))
+		}
+		for _, ovnRange := range ovnReservedRanges {
+			if overlaps(plan.MachineCIDR, ovnRange) {
+				return nil, fmt.Errorf("machine CIDR '%s' overlaps with '%s', which is reserved by "+
+					"OVN-Kubernetes", plan.MachineCIDR, ovnRange)
+			}
+		}
+		reserved = append(reserved, plan.MachineCIDR)
+	}
+
+	plan.ServiceCIDR = allocate(DefaultServiceCIDR, serviceCIDRPrefix, serviceCIDRPrefix, reserved)
+	if plan.ServiceCIDR == nil {
+		return nil, fmt.Errorf("there is no free private range for the service network")
+	}
+	reserved = append(reserved, plan.ServiceCIDR)
+
+	nodeBits := bitsFor(input.MaxNodes)
+	for hostPrefix := MinHostPrefix; hostPrefix <= MaxHostPrefix; hostPrefix++ {
+		if UsablePodsPerNode(hostPrefix) < input.MaxPodsPerNode {
+			break
+		}
+		podPrefix := hostPrefix - nodeBits
+		if podPrefix < 8 {
+			continue
+		}
+		podCIDR := allocate(DefaultPodCIDR, podPrefix, podPrefix, reserved)
+		if podCIDR == nil {
+			continue
+		}
+		plan.PodCIDR = podCIDR
+		plan.HostPrefix = hostPrefix
+		break
+	}
+	if plan.PodCIDR == nil {
+		return nil, fmt.Errorf("there is no free private range for the pod network of %d nodes "+
+			"with %d pods each", input.MaxNodes, input.MaxPodsPerNode)
+	}
+	if plan.HostPrefix != DefaultHostPrefix {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(
+			"Using host prefix /%d instead of the default /%d, nodes can run at most %d pods",
+			plan.HostPrefix, DefaultHostPrefix, UsablePodsPerNode(plan.HostPrefix)))
+	}
+	return plan, nil
+}
+
+// ReservedCIDRs returns the CIDR blocks of the VPCs and the destinations of their routes, ignoring
+// the default route. Routes through transit gateways, peering connections and VPN gateways point to
+// networks the cluster must be able to reach, so they can't be reused for the cluster networks.
+func ReservedCIDRs(vpcs []ec2types.Vpc, routeTables []ec2types.RouteTable) []*net.IPNet {
+	reserved := []*net.IPNet{}
+	seen := map[string]bool{}
+	add := func(cidr string) {
+		if cidr == "" || seen[cidr] {
+			return
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil || network.IP.To4() == nil || size(network) == 1<<32 {
+			return
+		}
+		seen[cidr] = true
+		reserved = append(reserved, network)
+	}
+	for _, vpc := range vpcs {
+		add(aws.ToString(vpc.CidrBlock))
+		for _, association := range vpc.CidrBlockAssociationSet {
+			add(aws.ToString(association.CidrBlock))
+		}
+	}
+	for _, routeTable := range routeTables {
+		for _, route := range routeTable.Routes {
+			add(aws.ToString(route.DestinationCidrBlock))
+		}
+	}
+	sort.Slice(reserved, func(i, j int) bool {
+		return toUint32(reserved[i].IP) < toUint32(reserved[j].IP)
+	})
+	return reserved
+}
+
+// UsablePodsPerNode returns the number of pods a node can run with the given host prefix
+func UsablePodsPerNode(hostPrefix int) int {
+	return 1<<(32-hostPrefix) - reservedAddressesPerNode
+}
+
+// allocate returns the preferred CIDR when it is free, otherwise the first free block of the private
+// ranges, trying block sizes from the largest prefix length given down to the smallest
+func allocate(preferred string, fromPrefix int, toPrefix int, reserved []*net.IPNet) *net.IPNet {
+	_, preferredNetwork, _ := net.ParseCIDR(preferred)
+	for prefix := fromPrefix; prefix <= toPrefix; prefix++ {
+		candidate := &net.IPNet{IP: preferredNetwork.IP, Mask: net.CIDRMask(prefix, 32)}
+		if candidate.IP.Equal(candidate.IP.Mask(candidate.Mask)) && isFree(candidate, reserved) {
+			return candidate
+		}
+	}
+	for prefix := fromPrefix; prefix <= toPrefix; prefix++ {
+		for _, privateRange := range privateRanges {
+			rangePrefix, _ := privateRange.Mask.Size()
+			if rangePrefix > prefix {
+				continue
+			}
+			start := toUint32(privateRange.IP)
+			step := uint32(1) << (32 - prefix)
+			for i := uint32(0); i < uint32(1)<<(prefix-rangePrefix); i++ {
+				candidate := &net.IPNet{IP: fromUint32(start + i*step), Mask: net.CIDRMask(prefix, 32)}
+				if isFree(candidate, reserved) {
+					return candidate
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isFree(candidate *net.IPNet, reserved []*net.IPNet) bool {
+	for _, network := range reserved {
+		if overlaps(candidate, network) {
+			return false
+		}
+	}
+	return true
+}
+
+func overlaps(a *net.IPNet, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func size(network *net.IPNet) int {
+	ones, totalBits := network.Mask.Size()
+	return 1 << (totalBits - ones)
+}
+
+// bitsFor returns the number of bits needed to address n items
+func bitsFor(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
+
+func toUint32(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+func fromUint32(value uint32) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, value)
+	return ip
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
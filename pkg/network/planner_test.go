@@ -0,0 +1,122 @@
+package network
+
+import (
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func parseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	Expect(err).NotTo(HaveOccurred())
+	return network
+}
+
+var _ = Describe("PlanNetwork", func() {
+	It("Uses the defaults when they are free", func() {
+		plan, err := PlanNetwork(PlanInput{
+			MachineCIDR:    parseCIDR("10.0.0.0/16"),
+			Reserved:       []*net.IPNet{parseCIDR("10.0.0.0/16")},
+			MaxNodes:       200,
+			MaxPodsPerNode: DefaultMaxPodsPerNode,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.MachineCIDR.String()).To(Equal("10.0.0.0/16"))
+		Expect(plan.ServiceCIDR.String()).To(Equal(DefaultServiceCIDR))
+		Expect(plan.PodCIDR.String()).To(Equal("10.128.0.0/15"))
+		Expect(plan.HostPrefix).To(Equal(DefaultHostPrefix))
+		Expect(plan.NewVpc).To(BeFalse())
+		Expect(plan.Warnings).To(BeEmpty())
+	})
+
+	It("Avoids CIDRs reachable through routes", func() {
+		plan, err := PlanNetwork(PlanInput{
+			MachineCIDR: parseCIDR("10.0.0.0/16"),
+			Reserved: []*net.IPNet{
+				parseCIDR("10.0.0.0/16"),
+				parseCIDR("172.30.0.0/16"),
+				parseCIDR("10.128.0.0/9"),
+			},
+			MaxNodes:       100,
+			MaxPodsPerNode: DefaultMaxPodsPerNode,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.ServiceCIDR.String()).To(Equal("10.1.0.0/16"))
+		Expect(plan.PodCIDR.String()).To(Equal("10.2.0.0/16"))
+		Expect(plan.HostPrefix).To(Equal(23))
+	})
+
+	It("Proposes a new VPC CIDR sized for the nodes", func() {
+		plan, err := PlanNetwork(PlanInput{
+			Reserved:       []*net.IPNet{parseCIDR("10.0.0.0/16")},
+			MaxNodes:       50,
+			MaxPodsPerNode: 100,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.NewVpc).To(BeTrue())
+		Expect(plan.MachineCIDR.String()).To(Equal("10.1.0.0/16"))
+	})
+
+	It("Uses a larger host prefix when the pod network does not fit otherwise", func() {
+		reserved := []*net.IPNet{parseCIDR("10.0.0.0/8"), parseCIDR("192.168.0.0/16")}
+		for _, cidr := range []string{"172.16.0.0/13", "172.24.0.0/14", "172.28.0.0/16", "172.31.0.0/16"} {
+			reserved = append(reserved, parseCIDR(cidr))
+		}
+		plan, err := PlanNetwork(PlanInput{
+			MachineCIDR:    parseCIDR("10.0.0.0/16"),
+			Reserved:       reserved,
+			MaxNodes:       256,
+			MaxPodsPerNode: 100,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.ServiceCIDR.String()).To(Equal("172.30.0.0/16"))
+		Expect(plan.HostPrefix).To(Equal(24))
+		Expect(plan.PodCIDR.String()).To(Equal("172.29.0.0/16"))
+		Expect(plan.Warnings).To(HaveLen(1))
+	})
+
+	It("Rejects more pods per node than any host prefix allows", func() {
+		_, err := PlanNetwork(PlanInput{MaxNodes: 10, MaxPodsPerNode: 600})
+		Expect(err).To(MatchError("a node can run at most 509 pods"))
+	})
+
+	It("Warns when the machine CIDR is too small for the nodes", func() {
+		plan, err := PlanNetwork(PlanInput{
+			MachineCIDR:    parseCIDR("10.0.0.0/24"),
+			MaxNodes:       100,
+			MaxPodsPerNode: DefaultMaxPodsPerNode,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.Warnings).To(ConsistOf(ContainSubstring("has 256 addresses")))
+	})
+})
+
+var _ = Describe("ReservedCIDRs", func() {
+	It("Collects VPC CIDR blocks and route destinations except the default route", func() {
+		reserved := ReservedCIDRs([]ec2types.Vpc{
+			{
+				CidrBlock: aws.String("10.0.0.0/16"),
+				CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+					{CidrBlock: aws.String("10.0.0.0/16")},
+					{CidrBlock: aws.String("100.70.0.0/16")},
+				},
+			},
+		}, []ec2types.RouteTable{
+			{
+				Routes: []ec2types.Route{
+					{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+					{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")},
+					{DestinationCidrBlock: aws.String("192.168.0.0/16"), TransitGatewayId: aws.String("tgw-1")},
+				},
+			},
+		})
+		cidrs := []string{}
+		for _, network := range reserved {
+			cidrs = append(cidrs, network.String())
+		}
+		Expect(cidrs).To(Equal([]string{"10.0.0.0/16", "100.70.0.0/16", "192.168.0.0/16"}))
+	})
+})
@@ -0,0 +1,215 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+)
+
+const (
+	// TemplateTagKey is the stack tag that records the template used by 'rosa create network'
+	TemplateTagKey = "rosa_network_template"
+
+	vpcOutput       = "VPCId"
+	vpcCidrParam    = "VpcCidr"
+	subnetType      = "AWS::EC2::Subnet"
+	vpcType         = "AWS::EC2::VPC"
+	natGatewayType  = "AWS::EC2::NatGateway"
+	igwType         = "AWS::EC2::InternetGateway"
+	tgwAttachType   = "AWS::EC2::TransitGatewayAttachment"
+	unknownTemplate = "unknown"
+)
+
+// Stack is a CloudFormation stack that holds the network of ROSA clusters
+type Stack struct {
+	Name                     string    `json:"name"`
+	Status                   string    `json:"status"`
+	Template                 string    `json:"template"`
+	VpcID                    string    `json:"vpcId,omitempty"`
+	CreationTime             time.Time `json:"creationTime"`
+	Subnets                  []Subnet  `json:"subnets,omitempty"`
+	NatGateways              []string  `json:"natGateways,omitempty"`
+	InternetGateway          string    `json:"internetGateway,omitempty"`
+	TransitGatewayAttachment string    `json:"transitGatewayAttachment,omitempty"`
+}
+
+// Subnet is a subnet created by a network stack
+type Subnet struct {
+	ID               string `json:"id"`
+	AvailabilityZone string `json:"availabilityZone"`
+	CidrBlock        string `json:"cidrBlock"`
+	Public           bool   `json:"public"`
+}
+
+// SubnetIDs returns the IDs of the subnets of the stack, only the private ones when requested
+func (s *Stack) SubnetIDs(privateOnly bool) []string {
+	ids := []string{}
+	for _, subnet := range s.Subnets {
+		if privateOnly && subnet.Public {
+			continue
+		}
+		ids = append(ids, subnet.ID)
+	}
+	return ids
+}
+
+// IsNetworkStack returns true if the stack was created by 'rosa create network'. Stacks created before
+// the template tag was introduced are recognized by the VPC CIDR parameter and VPC ID output shared by
+// the quickstart templates.
+func IsNetworkStack(stack cfTypes.Stack) bool {
+	if stackTemplate(stack) != "" {
+		return true
+	}
+	hasVpcCidr := false
+	for _, parameter := range stack.Parameters {
+		if aws.ToString(parameter.ParameterKey) == vpcCidrParam {
+			hasVpcCidr = true
+		}
+	}
+	return hasVpcCidr && stackOutput(stack, vpcOutput) != ""
+}
+
+// ListStacks returns the network stacks of the region, sorted by name
+func ListStacks(ctx context.Context, awsClient rosaaws.Client) ([]*Stack, error) {
+	stacks, err := awsClient.ListCFStacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := []*Stack{}
+	for _, stack := range stacks {
+		if IsNetworkStack(stack) {
+			result = append(result, newStack(stack))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// GetStack returns the network stack with the given name, including the subnets, NAT gateways and
+// gateways it created
+func GetStack(ctx context.Context, awsClient rosaaws.Client, name string) (*Stack, error) {
+	cfStack, err := awsClient.GetCFStack(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !IsNetworkStack(*cfStack) {
+		return nil, fmt.Errorf("stack '%s' was not created by 'rosa create network'", name)
+	}
+	stack := newStack(*cfStack)
+
+	resources, err := awsClient.DescribeCFStackResources(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe resources of stack '%s': %v", name, err)
+	}
+	subnetIDs := []string{}
+	for _, resource := range *resources {
+		id := aws.ToString(resource.PhysicalResourceId)
+		if id == "" {
+			continue
+		}
+		switch aws.ToString(resource.ResourceType) {
+		case vpcType:
+			stack.VpcID = id
+		case subnetType:
+			subnetIDs = append(subnetIDs, id)
+		case natGatewayType:
+			stack.NatGateways = append(stack.NatGateways, id)
+		case igwType:
+			stack.InternetGateway = id
+		case tgwAttachType:
+			stack.TransitGatewayAttachment = id
+		}
+	}
+	sort.Strings(stack.NatGateways)
+	if len(subnetIDs) == 0 {
+		return stack, nil
+	}
+
+	subnets, err := awsClient.ListSubnets(subnetIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe subnets of stack '%s': %v", name, err)
+	}
+	publicSubnets, err := awsClient.FetchPublicSubnetMap(subnets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe route tables of stack '%s': %v", name, err)
+	}
+	for _, subnet := range subnets {
+		id := aws.ToString(subnet.SubnetId)
+		stack.Subnets = append(stack.Subnets, Subnet{
+			ID:               id,
+			AvailabilityZone: aws.ToString(subnet.AvailabilityZone),
+			CidrBlock:        aws.ToString(subnet.CidrBlock),
+			Public:           publicSubnets[id],
+		})
+	}
+	sort.Slice(stack.Subnets, func(i, j int) bool {
+		a, b := stack.Subnets[i], stack.Subnets[j]
+		if a.AvailabilityZone != b.AvailabilityZone {
+			return a.AvailabilityZone < b.AvailabilityZone
+		}
+		if a.Public != b.Public {
+			return a.Public
+		}
+		return a.ID < b.ID
+	})
+	return stack, nil
+}
+
+// ClustersUsingSubnets returns the clusters that were installed in any of the given subnets
+func ClustersUsingSubnets(clusters []*cmv1.Cluster, subnetIDs []string) []*cmv1.Cluster {
+	ids := map[string]bool{}
+	for _, id := range subnetIDs {
+		ids[id] = true
+	}
+	result := []*cmv1.Cluster{}
+	for _, cluster := range clusters {
+		for _, id := range cluster.AWS().SubnetIDs() {
+			if ids[id] {
+				result = append(result, cluster)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func newStack(stack cfTypes.Stack) *Stack {
+	template := stackTemplate(stack)
+	if template == "" {
+		template = unknownTemplate
+	}
+	return &Stack{
+		Name:         aws.ToString(stack.StackName),
+		Status:       string(stack.StackStatus),
+		Template:     template,
+		VpcID:        stackOutput(stack, vpcOutput),
+		CreationTime: aws.ToTime(stack.CreationTime),
+	}
+}
+
+func stackTemplate(stack cfTypes.Stack) string {
+	for _, tag := range stack.Tags {
+		if aws.ToString(tag.Key) == TemplateTagKey {
+			return aws.ToString(tag.Value)
+		}
+	}
+	return ""
+}
+
+func stackOutput(stack cfTypes.Stack, key string) string {
+	for _, output := range stack.Outputs {
+		if aws.ToString(output.OutputKey) == key {
+			return aws.ToString(output.OutputValue)
+		}
+	}
+	return ""
+}
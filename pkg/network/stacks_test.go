@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfTypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"go.uber.org/mock/gomock"
+
+	rosaaws "github.com/openshift/rosa/pkg/aws"
+)
+
+func networkStack(name string, template string) cfTypes.Stack {
+	return cfTypes.Stack{
+		StackName:    aws.String(name),
+		StackStatus:  cfTypes.StackStatusCreateComplete,
+		CreationTime: aws.Time(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Tags:         []cfTypes.Tag{{Key: aws.String(TemplateTagKey), Value: aws.String(template)}},
+		Outputs:      []cfTypes.Output{{OutputKey: aws.String("VPCId"), OutputValue: aws.String("vpc-1")}},
+	}
+}
+
+func stackResource(resourceType string, id string) cfTypes.StackResource {
+	return cfTypes.StackResource{ResourceType: aws.String(resourceType), PhysicalResourceId: aws.String(id)}
+}
+
+var _ = Describe("Network stacks", func() {
+	var awsClient *rosaaws.MockClient
+
+	BeforeEach(func() {
+		awsClient = rosaaws.NewMockClient(gomock.NewController(GinkgoT()))
+	})
+
+	It("Recognizes stacks created by 'rosa create network'", func() {
+		Expect(IsNetworkStack(networkStack("tagged", DefaultTemplateName))).To(BeTrue())
+		Expect(IsNetworkStack(cfTypes.Stack{
+			Parameters: []cfTypes.Parameter{{ParameterKey: aws.String("VpcCidr"), ParameterValue: aws.String("10.0.0.0/16")}},
+			Outputs:    []cfTypes.Output{{OutputKey: aws.String("VPCId"), OutputValue: aws.String("vpc-1")}},
+		})).To(BeTrue())
+		Expect(IsNetworkStack(cfTypes.Stack{StackName: aws.String("osdCcsAdminIAMUser")})).To(BeFalse())
+	})
+
+	It("Lists the network stacks sorted by name", func() {
+		awsClient.EXPECT().ListCFStacks(gomock.Any()).Return([]cfTypes.Stack{
+			networkStack("b-stack", PrivateTemplateName),
+			{StackName: aws.String("other")},
+			networkStack("a-stack", DefaultTemplateName),
+		}, nil)
+
+		stacks, err := ListStacks(context.Background(), awsClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stacks).To(HaveLen(2))
+		Expect(stacks[0].Name).To(Equal("a-stack"))
+		Expect(stacks[0].Template).To(Equal(DefaultTemplateName))
+		Expect(stacks[0].VpcID).To(Equal("vpc-1"))
+		Expect(stacks[1].Template).To(Equal(PrivateTemplateName))
+	})
+
+	It("Describes the resources of a network stack", func() {
+		stack := networkStack("my-stack", DefaultTemplateName)
+		awsClient.EXPECT().GetCFStack(gomock.Any(), "my-stack").Return(&stack, nil)
+		awsClient.EXPECT().DescribeCFStackResources(gomock.Any(), "my-stack").Return(&[]cfTypes.StackResource{
+			stackResource("AWS::EC2::VPC", "vpc-1"),
+			stackResource("AWS::EC2::Subnet", "subnet-b"),
+			stackResource("AWS::EC2::Subnet", "subnet-a"),
+			stackResource("AWS::EC2::Subnet", "subnet-c"),
+			stackResource("AWS::EC2::NatGateway", "nat-1"),
+			stackResource("AWS::EC2::InternetGateway", "igw-1"),
+		}, nil)
+		subnets := []ec2types.Subnet{
+			{SubnetId: aws.String("subnet-b"), AvailabilityZone: aws.String("us-east-1a")},
+			{SubnetId: aws.String("subnet-a"), AvailabilityZone: aws.String("us-east-1a")},
+			{SubnetId: aws.String("subnet-c"), AvailabilityZone: aws.String("us-east-1b")},
+		}
+		awsClient.EXPECT().ListSubnets("subnet-b", "subnet-a", "subnet-c").Return(subnets, nil)
+		awsClient.EXPECT().FetchPublicSubnetMap(subnets).Return(map[string]bool{"subnet-b": true}, nil)
+
+		result, err := GetStack(context.Background(), awsClient, "my-stack")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.VpcID).To(Equal("vpc-1"))
+		Expect(result.NatGateways).To(Equal([]string{"nat-1"}))
+		Expect(result.InternetGateway).To(Equal("igw-1"))
+		Expect(result.Subnets).To(Equal([]Subnet{
+			{ID: "subnet-b", AvailabilityZone: "us-east-1a", Public: true},
+			{ID: "subnet-a", AvailabilityZone: "us-east-1a"},
+			{ID: "subnet-c", AvailabilityZone: "us-east-1b"},
+		}))
+		Expect(result.SubnetIDs(false)).To(Equal([]string{"subnet-b", "subnet-a", "subnet-c"}))
+		Expect(result.SubnetIDs(true)).To(Equal([]string{"subnet-a", "subnet-c"}))
+	})
+
+	It("Refuses to describe stacks not created by 'rosa create network'", func() {
+		awsClient.EXPECT().GetCFStack(gomock.Any(), "other").Return(&cfTypes.Stack{StackName: aws.String("other")}, nil)
+		_, err := GetStack(context.Background(), awsClient, "other")
+		Expect(err).To(MatchError("stack 'other' was not created by 'rosa create network'"))
+	})
+
+	It("Finds the clusters using the subnets", func() {
+		cluster, err := cmv1.NewCluster().ID("cluster-1").
+			AWS(cmv1.NewAWS().SubnetIDs("subnet-x", "subnet-a")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		other, err := cmv1.NewCluster().ID("cluster-2").AWS(cmv1.NewAWS().SubnetIDs("subnet-y")).Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		clusters := ClustersUsingSubnets([]*cmv1.Cluster{cluster, other}, []string{"subnet-a", "subnet-b"})
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].ID()).To(Equal("cluster-1"))
+	})
+})
@@ -0,0 +1,294 @@
+package network
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultTemplateName        = "rosa-quickstart-default-vpc"
+	PrivateTemplateName        = "rosa-quickstart-private-vpc"
+	TransitGatewayTemplateName = "rosa-quickstart-tgw-egress-vpc"
+
+	TemplateSourceBuiltIn = "built-in"
+	TemplateSourceCustom  = "custom"
+
+	// Parameter that is turned into stack tags instead of being passed to the template
+	tagsParameter = "Tags"
+)
+
+var builtInTemplates = map[string]string{
+	DefaultTemplateName:        DefaultTemplateBody,
+	PrivateTemplateName:        PrivateTemplateBody,
+	TransitGatewayTemplateName: TransitGatewayTemplateBody,
+}
+
+// Template is a CloudFormation template that can be used to create a network stack
+type Template struct {
+	Name        string              `json:"name"`
+	Source      string              `json:"source"`
+	Path        string              `json:"path,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  []TemplateParameter `json:"parameters,omitempty"`
+	Body        string              `json:"-"`
+}
+
+// TemplateParameter describes a parameter of a template and the constraints its value must satisfy
+type TemplateParameter struct {
+	Name                  string   `json:"name" yaml:"-"`
+	Type                  string   `json:"type" yaml:"Type"`
+	Description           string   `json:"description,omitempty" yaml:"Description"`
+	Default               *string  `json:"default,omitempty" yaml:"Default"`
+	AllowedValues         []string `json:"allowedValues,omitempty" yaml:"AllowedValues"`
+	AllowedPattern        string   `json:"allowedPattern,omitempty" yaml:"AllowedPattern"`
+	MinValue              *float64 `json:"minValue,omitempty" yaml:"MinValue"`
+	MaxValue              *float64 `json:"maxValue,omitempty" yaml:"MaxValue"`
+	MinLength             *int     `json:"minLength,omitempty" yaml:"MinLength"`
+	MaxLength             *int     `json:"maxLength,omitempty" yaml:"MaxLength"`
+	ConstraintDescription string   `json:"constraintDescription,omitempty" yaml:"ConstraintDescription"`
+}
+
+type templateDocument struct {
+	Description string                        `yaml:"Description"`
+	Parameters  map[string]*TemplateParameter `yaml:"Parameters"`
+}
+
+// IsBuiltInTemplate returns true if the name belongs to a template embedded in the binary
+func IsBuiltInTemplate(name string) bool {
+	_, ok := builtInTemplates[name]
+	return ok
+}
+
+// ListTemplates returns the built-in templates followed by the custom templates found in the template
+// directory, sorted by name. Custom templates are the sub-directories that contain a
+// 'cloudformation.yaml' file, those named like a built-in template are ignored. An empty directory
+// lists the built-in templates only.
+func ListTemplates(templateDir string) ([]*Template, error) {
+	templates := []*Template{}
+	names := make([]string, 0, len(builtInTemplates))
+	for name := range builtInTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		template, err := newTemplate(name, TemplateSourceBuiltIn, "", builtInTemplates[name])
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+
+	if templateDir == "" {
+		return templates, nil
+	}
+	entries, err := os.ReadDir(templateDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template directory '%s': %v", templateDir, err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || IsBuiltInTemplate(entry.Name()) {
+			continue
+		}
+		path := SelectTemplate(templateDir, entry.Name())
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		template, err := readTemplate(entry.Name(), path)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// GetTemplate returns the built-in template with the given name, or the custom template of that name
+// in the template directory
+func GetTemplate(templateDir string, name string) (*Template, error) {
+	if body, ok := builtInTemplates[name]; ok {
+		return newTemplate(name, TemplateSourceBuiltIn, "", body)
+	}
+	if templateDir == "" {
+		return nil, fmt.Errorf("template '%s' is not a built-in template, use `--template-dir` "+
+			"to specify the directory of custom templates", name)
+	}
+	path := SelectTemplate(templateDir, name)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("template '%s' not found in template directory '%s'", name, templateDir)
+	}
+	return readTemplate(name, path)
+}
+
+// ParseTemplate parses the description and parameters of a template body
+func ParseTemplate(name string, body string) (*Template, error) {
+	source := TemplateSourceCustom
+	if IsBuiltInTemplate(name) {
+		source = TemplateSourceBuiltIn
+	}
+	return newTemplate(name, source, "", body)
+}
+
+func readTemplate(name string, path string) (*Template, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file: %v", err)
+	}
+	return newTemplate(name, TemplateSourceCustom, filepath.Clean(path), string(body))
+}
+
+func newTemplate(name string, source string, path string, body string) (*Template, error) {
+	document := &templateDocument{}
+	err := yaml.Unmarshal([]byte(body), document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template '%s': %v", name, err)
+	}
+	template := &Template{
+		Name:        name,
+		Source:      source,
+		Path:        path,
+		Description: strings.TrimSpace(document.Description),
+		Body:        body,
+		Parameters:  []TemplateParameter{},
+	}
+	for parameterName, parameter := range document.Parameters {
+		if parameter == nil {
+			parameter = &TemplateParameter{}
+		}
+		parameter.Name = parameterName
+		template.Parameters = append(template.Parameters, *parameter)
+	}
+	sort.Slice(template.Parameters, func(i, j int) bool {
+		return template.Parameters[i].Name < template.Parameters[j].Name
+	})
+	return template, nil
+}
+
+// Parameter returns the parameter with the given name, or nil if the template doesn't define it
+func (t *Template) Parameter(name string) *TemplateParameter {
+	for i := range t.Parameters {
+		if t.Parameters[i].Name == name {
+			return &t.Parameters[i]
+		}
+	}
+	return nil
+}
+
+// ValidateParams checks that every parameter is defined by the template and that its value satisfies
+// the constraints of the parameter, as CloudFormation would when creating the stack. Parameters
+// without a default value must be provided.
+func (t *Template) ValidateParams(params map[string]string) error {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parameter := t.Parameter(name)
+		if parameter == nil {
+			return fmt.Errorf("parameter '%s' is not defined by template '%s', valid parameters are: %s",
+				name, t.Name, strings.Join(t.parameterNames(), ", "))
+		}
+		err := parameter.Validate(params[name])
+		if err != nil {
+			return err
+		}
+	}
+	for _, parameter := range t.Parameters {
+		if _, ok := params[parameter.Name]; !ok && parameter.Default == nil {
+			return fmt.Errorf("parameter '%s' is required by template '%s'", parameter.Name, t.Name)
+		}
+	}
+	return nil
+}
+
+func (t *Template) parameterNames() []string {
+	names := []string{}
+	for _, parameter := range t.Parameters {
+		names = append(names, parameter.Name)
+	}
+	return append(names, tagsParameter)
+}
+
+// Validate checks the value against the type, allowed values, pattern and limits of the parameter.
+// List types are checked item by item.
+func (p *TemplateParameter) Validate(value string) error {
+	switch p.Type {
+	case "CommaDelimitedList":
+		return p.validateItems(strings.Split(value, ","), false)
+	case "List<Number>":
+		return p.validateItems(strings.Split(value, ","), true)
+	case "Number":
+		return p.validateItems([]string{value}, true)
+	default:
+		return p.validateItems([]string{value}, false)
+	}
+}
+
+func (p *TemplateParameter) validateItems(items []string, number bool) error {
+	for _, item := range items {
+		if number {
+			err := p.validateNumber(strings.TrimSpace(item))
+			if err != nil {
+				return err
+			}
+		} else {
+			err := p.validateString(item)
+			if err != nil {
+				return err
+			}
+		}
+		if len(p.AllowedValues) > 0 && !slices.Contains(p.AllowedValues, item) {
+			return p.constraintError(item, fmt.Sprintf("must be one of: %s", strings.Join(p.AllowedValues, ", ")))
+		}
+	}
+	return nil
+}
+
+func (p *TemplateParameter) validateNumber(value string) error {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return p.constraintError(value, "must be a number")
+	}
+	if p.MinValue != nil && number < *p.MinValue {
+		return p.constraintError(value, fmt.Sprintf("must be greater than or equal to %v", *p.MinValue))
+	}
+	if p.MaxValue != nil && number > *p.MaxValue {
+		return p.constraintError(value, fmt.Sprintf("must be less than or equal to %v", *p.MaxValue))
+	}
+	return nil
+}
+
+func (p *TemplateParameter) validateString(value string) error {
+	if p.MinLength != nil && len(value) < *p.MinLength {
+		return p.constraintError(value, fmt.Sprintf("must be at least %d characters long", *p.MinLength))
+	}
+	if p.MaxLength != nil && len(value) > *p.MaxLength {
+		return p.constraintError(value, fmt.Sprintf("must be at most %d characters long", *p.MaxLength))
+	}
+	if p.AllowedPattern != "" {
+		// CloudFormation requires the pattern to match the whole value
+		pattern, err := regexp.Compile("^(?:" + p.AllowedPattern + ")$")
+		if err != nil {
+			return fmt.Errorf("invalid allowed pattern '%s' for parameter '%s': %v", p.AllowedPattern, p.Name, err)
+		}
+		if !pattern.MatchString(value) {
+			return p.constraintError(value, fmt.Sprintf("must match pattern '%s'", p.AllowedPattern))
+		}
+	}
+	return nil
+}
+
+func (p *TemplateParameter) constraintError(value string, reason string) error {
+	if p.ConstraintDescription != "" {
+		reason = p.ConstraintDescription
+	}
+	return fmt.Errorf("invalid value '%s' for parameter '%s': %s", value, p.Name, reason)
+}
@@ -0,0 +1,151 @@
+package network
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const customTemplate = `
+AWSTemplateFormatVersion: '2010-09-09'
+Description: Custom VPC
+Parameters:
+  Name:
+    Type: String
+  Size:
+    Type: String
+    Default: small
+    AllowedValues:
+      - small
+      - large
+  Zones:
+    Type: CommaDelimitedList
+    Default: "a"
+    AllowedValues: [a, b, c]
+  Count:
+    Type: Number
+    Default: 2
+    MinValue: 1
+    MaxValue: 3
+Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: !Ref VpcCidr
+`
+
+var _ = Describe("Templates", func() {
+	var templateDir string
+
+	BeforeEach(func() {
+		templateDir = GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(templateDir, "custom-vpc"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(templateDir, "custom-vpc", "cloudformation.yaml"),
+			[]byte(customTemplate), 0600)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(templateDir, "empty"), 0755)).To(Succeed())
+	})
+
+	It("Parses the parameters of the built-in templates", func() {
+		for _, name := range []string{DefaultTemplateName, PrivateTemplateName, TransitGatewayTemplateName} {
+			template, err := GetTemplate("", name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(template.Source).To(Equal(TemplateSourceBuiltIn))
+			Expect(template.Description).NotTo(BeEmpty())
+			Expect(template.Parameter("VpcCidr")).NotTo(BeNil())
+		}
+		template, err := GetTemplate("", DefaultTemplateName)
+		Expect(err).NotTo(HaveOccurred())
+		count := template.Parameter("AvailabilityZoneCount")
+		Expect(count.Type).To(Equal("Number"))
+		Expect(*count.Default).To(Equal("1"))
+		Expect(*count.MinValue).To(Equal(float64(1)))
+		Expect(*count.MaxValue).To(Equal(float64(4)))
+		Expect(template.Parameter("Name").Default).To(BeNil())
+	})
+
+	It("Lists built-in templates followed by custom templates", func() {
+		templates, err := ListTemplates(templateDir)
+		Expect(err).NotTo(HaveOccurred())
+		names := []string{}
+		for _, template := range templates {
+			names = append(names, template.Name)
+		}
+		Expect(names).To(Equal([]string{DefaultTemplateName, PrivateTemplateName, TransitGatewayTemplateName,
+			"custom-vpc"}))
+		Expect(templates[3].Source).To(Equal(TemplateSourceCustom))
+		Expect(templates[3].Path).To(Equal(filepath.Join(templateDir, "custom-vpc", "cloudformation.yaml")))
+	})
+
+	It("Lists only built-in templates without a template directory", func() {
+		templates, err := ListTemplates("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(templates).To(HaveLen(3))
+	})
+
+	It("Fails to get an unknown template", func() {
+		_, err := GetTemplate(templateDir, "missing")
+		Expect(err).To(MatchError(ContainSubstring("template 'missing' not found")))
+		_, err = GetTemplate("", "custom-vpc")
+		Expect(err).To(MatchError(ContainSubstring("is not a built-in template")))
+	})
+
+	Context("ValidateParams", func() {
+		var template *Template
+
+		BeforeEach(func() {
+			var err error
+			template, err = GetTemplate(templateDir, "custom-vpc")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Accepts valid values", func() {
+			Expect(template.ValidateParams(map[string]string{
+				"Name": "vpc", "Size": "large", "Zones": "a,c", "Count": "3",
+			})).To(Succeed())
+		})
+
+		It("Rejects unknown parameters", func() {
+			err := template.ValidateParams(map[string]string{"Name": "vpc", "Unknown": "x"})
+			Expect(err).To(MatchError("parameter 'Unknown' is not defined by template 'custom-vpc', " +
+				"valid parameters are: Count, Name, Size, Zones, Tags"))
+		})
+
+		It("Rejects missing required parameters", func() {
+			err := template.ValidateParams(map[string]string{})
+			Expect(err).To(MatchError("parameter 'Name' is required by template 'custom-vpc'"))
+		})
+
+		It("Rejects values that are not allowed", func() {
+			err := template.ValidateParams(map[string]string{"Name": "vpc", "Size": "medium"})
+			Expect(err).To(MatchError("invalid value 'medium' for parameter 'Size': must be one of: small, large"))
+			err = template.ValidateParams(map[string]string{"Name": "vpc", "Zones": "a,d"})
+			Expect(err).To(MatchError("invalid value 'd' for parameter 'Zones': must be one of: a, b, c"))
+		})
+
+		It("Rejects numbers out of range", func() {
+			err := template.ValidateParams(map[string]string{"Name": "vpc", "Count": "4"})
+			Expect(err).To(MatchError("invalid value '4' for parameter 'Count': must be less than or equal to 3"))
+			err = template.ValidateParams(map[string]string{"Name": "vpc", "Count": "two"})
+			Expect(err).To(MatchError("invalid value 'two' for parameter 'Count': must be a number"))
+		})
+
+		It("Uses the constraint description of patterns", func() {
+			private, err := GetTemplate("", PrivateTemplateName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(private.ValidateParams(map[string]string{"Name": "vpc", "VpcCidr": "10.1.0.0/17"})).To(Succeed())
+			err = private.ValidateParams(map[string]string{"Name": "vpc", "VpcCidr": "10.1.0.0/24"})
+			Expect(err).To(MatchError("invalid value '10.1.0.0/24' for parameter 'VpcCidr': must be an IPv4 " +
+				"CIDR block with a prefix length between /16 and /18"))
+
+			tgw, err := GetTemplate("", TransitGatewayTemplateName)
+			Expect(err).NotTo(HaveOccurred())
+			err = tgw.ValidateParams(map[string]string{"Name": "vpc"})
+			Expect(err).To(MatchError("parameter 'TransitGatewayId' is required by template " +
+				"'rosa-quickstart-tgw-egress-vpc'"))
+			err = tgw.ValidateParams(map[string]string{"Name": "vpc", "TransitGatewayId": "igw-0123456789"})
+			Expect(err).To(MatchError(ContainSubstring("must be the ID of an existing transit gateway")))
+		})
+	})
+})
@@ -271,6 +271,24 @@ func (c *Client) GetAddOn(id string) (*asv1.Addon, error) {
 	return response.Body(), nil
 }
 
+func (c *Client) GetAddOnVersion(addOnID, versionID string) (*asv1.AddonVersion, error) {
+	response, err := c.ocm.AddonsMgmt().V1().Addons().Addon(addOnID).Versions().Version(versionID).Get().Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
+// GetAddOnStatus returns the status conditions reported for an add-on installed on a cluster
+func (c *Client) GetAddOnStatus(clusterID, addOnID string) (*asv1.AddonStatus, error) {
+	response, err := c.ocm.AddonsMgmt().V1().Clusters().Cluster(clusterID).
+		Status().Addon(addOnID).Get().Send()
+	if err != nil {
+		return nil, handleErr(response.Error(), err)
+	}
+	return response.Body(), nil
+}
+
 // Get all add-ons available for a cluster
 func (c *Client) GetClusterAddOns(cluster *cmv1.Cluster) ([]*ClusterAddOn, error) {
 	addOnResources, err := c.GetAvailableAddOns()
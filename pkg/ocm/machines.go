@@ -121,6 +121,15 @@ func (mt MachineType) HasQuota(multiAZ bool) bool {
 	return mt.MachineType.Category() != AcceleratedComputing || mt.availableQuota > getDefaultNodes(multiAZ)
 }
 
+// AvailableQuota returns the number of nodes of the machine type that the organization can still
+// create, and false when the machine type is not limited by quota
+func (mt MachineType) AvailableQuota() (int, bool) {
+	if mt.MachineType.Category() != AcceleratedComputing {
+		return 0, false
+	}
+	return mt.availableQuota, true
+}
+
 // GetAvailableMachineTypesInRegion get the supported machine type in the region.
 // The function triggers the 'api/clusters_mgmt/v1/aws_inquiries/machine_types'
 // and passes a role ARN for STS clusters or access keys for non-STS clusters.
@@ -37,6 +37,10 @@ type CreateMachinepoolUserOptions struct {
 	CapacityReservationId         string
 	Type                          string
 	CapacityReservationPreference string
+	From                          string
+	SpreadAcrossSubnets           bool
+	SpotPercentage                int
+	SpotInstanceTypes             []string
 }
 
 const (
@@ -57,8 +61,16 @@ const (
   # Add a machine pool with spot instances to a cluster
   rosa create machinepool -c mycluster --name=mp-1 --replicas=2 --instance-type=r5.2xlarge --use-spot-instances \
     --spot-max-price=0.5
+  # Add 6 replicas to a single-AZ classic cluster, 4 of them spot instances of two instance types and the other 2
+  # on-demand instances in a fallback machine pool named 'mp-1-on-demand'
+  rosa create machinepool -c mycluster --name=mp-1 --replicas=6 --instance-type=m5.xlarge \
+    --spot-percentage=67 --spot-instance-types=m5a.xlarge
   # Add a machine pool to a cluster and set the node drain grace period
-  rosa create machinepool -c mycluster --name=mp-1 --node-drain-grace-period="90 minutes"`
+  rosa create machinepool -c mycluster --name=mp-1 --node-drain-grace-period="90 minutes"
+  # Copy machine pool 'workers' of cluster 'othercluster' with a different instance type
+  rosa create machinepool -c mycluster --name=mp-1 --from=othercluster/workers --instance-type=r5.2xlarge
+  # Add 6 replicas to a hosted cluster spread across one machine pool per private subnet
+  rosa create machinepool -c mycluster --name=mp-1 --replicas=6 --spread-across-subnets`
 )
 
 type CreateMachinepoolOptions struct {
@@ -291,6 +303,31 @@ func BuildMachinePoolCreateCommandWithOptions() (*cobra.Command, *CreateMachinep
 		"",
 		"A configurable preference for a capacity-reservation. Options are: 'none' | "+
 			"'capacity-reservations-only' | 'open'")
+
+	flags.StringVar(&options.From,
+		"from",
+		"",
+		"Copy the settings of an existing machine pool, in the format '<cluster>/<machine-pool>' or "+
+			"'<machine-pool>' for a machine pool of the same cluster. Flags override the copied settings.")
+
+	flags.BoolVar(&options.SpreadAcrossSubnets,
+		"spread-across-subnets",
+		false,
+		"Create one machine pool per private subnet of a hosted cluster, named after the machine pool and the "+
+			"availability zone of the subnet, and divide the replicas or autoscaling limits between them.")
+
+	flags.IntVar(&options.SpotPercentage,
+		"spot-percentage",
+		0,
+		"Percentage of the replicas of a classic machine pool that use spot instances. The other replicas are "+
+			"on-demand instances of a fallback machine pool named after the machine pool with the '-on-demand' "+
+			"suffix and the same labels and taints.")
+
+	flags.StringSliceVar(&options.SpotInstanceTypes,
+		"spot-instance-types",
+		nil,
+		"Additional instance types to spread the spot replicas across, one spot machine pool per instance "+
+			"type. Requires '--spot-percentage'. Format should be a comma-separated list.")
 	output.AddFlag(cmd)
 	interactive.AddFlag(flags)
 	return cmd, options
@@ -23,12 +23,21 @@ const (
 		"\n" + `  rosa create network rosa-quickstart-default-vpc --param Region=us-west-2` +
 		` --param Name=quickstart-stack` +
 		` --param AZ1=us-west-2b --param AZ2=us-west-2d --param VpcCidr=10.0.0.0/16` +
-		"\n\n" + `  # To delete the AWS cloudformation stack` +
-		"\n" + `  aws cloudformation delete-stack --stack-name <name> --region <region>` +
+		"\n\n" + `  # ROSA quick start private VPC example for PrivateLink clusters without internet egress` +
+		"\n" + `  rosa create network rosa-quickstart-private-vpc --param Region=us-west-2` +
+		` --param Name=private-stack --param AvailabilityZoneCount=3` +
+		"\n\n" + `  # List the available templates and describe their parameters` +
+		"\n" + `  rosa list network-templates` +
+		"\n" + `  rosa describe network-template rosa-quickstart-tgw-egress-vpc` +
+		"\n\n" + `  # Show the subnets created by the stack and delete the stack` +
+		"\n" + `  rosa describe network <name>` +
+		"\n" + `  rosa delete network <name>` +
 		"\n\n" + `# TEMPLATE_NAME:` +
 		"\n" + `Specifies the name of the template to use. This should match the name of a directory ` +
 		"\n" + `under the path specified by '--template-dir' or the 'OCM_TEMPLATE_DIR' environment variable.` +
 		"\n" + `The directory should contain a YAML file defining the custom template structure.` +
+		"\n" + `The built-in templates 'rosa-quickstart-default-vpc', 'rosa-quickstart-private-vpc' and ` +
+		"\n" + `'rosa-quickstart-tgw-egress-vpc' don't need a template directory.` +
 		"\n\n" + `If no TEMPLATE_NAME is provided, or if no matching directory is found, the default ` +
 		"\n" + `built-in template 'rosa-quickstart-default-vpc' will be used.`
 	DefaultTemplateDir = "cmd/create/network/templates"
@@ -114,3 +123,13 @@ func BuildNetworkCommandWithOptions() (*cobra.Command, *NetworkUserOptions) {
 
 	return cmd, options
 }
+
+// CustomTemplateDir returns the directory of the custom templates, or an empty string when only the
+// built-in templates are available
+func (n *NetworkUserOptions) CustomTemplateDir() string {
+	n.CleanTemplateDir()
+	if n.TemplateDir == DefaultTemplateDir {
+		return ""
+	}
+	return n.TemplateDir
+}
@@ -0,0 +1,276 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// OperatorRoleGroup holds the operator roles sharing a prefix that is not used by any cluster
+type OperatorRoleGroup struct {
+	Prefix    string
+	ClusterID string
+	Roles     []aws.OperatorRoleDetail
+	CreatedAt time.Time
+}
+
+// RoleNames returns the names of the roles in the group
+func (g OperatorRoleGroup) RoleNames() []string {
+	names := make([]string, 0, len(g.Roles))
+	for _, role := range g.Roles {
+		names = append(names, role.RoleName)
+	}
+	return names
+}
+
+// OidcProvider is an OIDC provider whose issuer URL is not used by any cluster
+type OidcProvider struct {
+	Arn       string
+	ClusterID string
+	IssuerURL string
+	CreatedAt time.Time
+}
+
+// OidcConfig is a registered OIDC configuration whose issuer URL is not used by any cluster
+type OidcConfig struct {
+	ID         string
+	IssuerURL  string
+	Managed    bool
+	SecretArn  string
+	BucketName string
+	CreatedAt  time.Time
+}
+
+// Result lists all orphaned resources found in the current AWS account
+type Result struct {
+	OperatorRoles []OperatorRoleGroup
+	OidcProviders []OidcProvider
+	OidcConfigs   []OidcConfig
+}
+
+// IsEmpty returns true when no orphaned resources were found
+func (r *Result) IsEmpty() bool {
+	return len(r.OperatorRoles) == 0 && len(r.OidcProviders) == 0 && len(r.OidcConfigs) == 0
+}
+
+// Finder locates ROSA operator roles, OIDC providers and OIDC configurations that are no longer
+// referenced by any cluster
+type Finder struct {
+	awsClient aws.Client
+	ocmClient *ocm.Client
+	accountID string
+	olderThan time.Duration
+	now       func() time.Time
+}
+
+// NewFinder creates a Finder for the given AWS account. Resources younger than olderThan are
+// ignored; a zero duration disables the age filter.
+func NewFinder(awsClient aws.Client, ocmClient *ocm.Client, accountID string, olderThan time.Duration) *Finder {
+	return &Finder{
+		awsClient: awsClient,
+		ocmClient: ocmClient,
+		accountID: accountID,
+		olderThan: olderThan,
+		now:       time.Now,
+	}
+}
+
+// Find looks for every kind of orphaned resource
+func (f *Finder) Find() (*Result, error) {
+	operatorRoles, err := f.FindOperatorRoles()
+	if err != nil {
+		return nil, err
+	}
+	oidcProviders, err := f.FindOidcProviders()
+	if err != nil {
+		return nil, err
+	}
+	oidcConfigs, err := f.FindOidcConfigs()
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		OperatorRoles: operatorRoles,
+		OidcProviders: oidcProviders,
+		OidcConfigs:   oidcConfigs,
+	}, nil
+}
+
+// FindOperatorRoles returns the operator roles grouped by prefix whose prefix is not used by any cluster
+func (f *Finder) FindOperatorRoles() ([]OperatorRoleGroup, error) {
+	operatorRolesByPrefix, err := f.awsClient.ListOperatorRoles("", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list operator roles: %v", err)
+	}
+
+	groups := []OperatorRoleGroup{}
+	for _, prefix := range sortedKeys(operatorRolesByPrefix) {
+		roles := operatorRolesByPrefix[prefix]
+		if len(roles) == 0 {
+			continue
+		}
+		inUse, err := f.ocmClient.HasAClusterUsingOperatorRolesPrefix(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if any clusters are using operator roles prefix '%s': %v",
+				prefix, err)
+		}
+		if inUse {
+			continue
+		}
+		group := OperatorRoleGroup{
+			Prefix: prefix,
+			Roles:  roles,
+		}
+		for _, role := range roles {
+			if role.ClusterID != "" {
+				group.ClusterID = role.ClusterID
+				break
+			}
+		}
+		if f.olderThan > 0 {
+			group.CreatedAt, err = f.newestRoleCreateDate(roles)
+			if err != nil {
+				return nil, err
+			}
+			if !IsOlderThan(group.CreatedAt, f.olderThan, f.now()) {
+				continue
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// FindOidcProviders returns the Red Hat managed OIDC providers whose issuer URL is not used by any cluster
+func (f *Finder) FindOidcProviders() ([]OidcProvider, error) {
+	providers, err := f.awsClient.ListOidcProviders("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OIDC providers: %v", err)
+	}
+
+	orphans := []OidcProvider{}
+	for _, provider := range providers {
+		resourceId, err := aws.GetResourceIdFromOidcProviderARN(provider.Arn)
+		if err != nil {
+			return nil, err
+		}
+		issuerURL := fmt.Sprintf("https://%s", resourceId)
+		inUse, err := f.ocmClient.HasAClusterUsingOidcProvider(issuerURL, f.accountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if any clusters are using OIDC provider '%s': %v",
+				issuerURL, err)
+		}
+		if inUse {
+			continue
+		}
+		orphan := OidcProvider{
+			Arn:       provider.Arn,
+			ClusterID: provider.ClusterId,
+			IssuerURL: issuerURL,
+		}
+		if f.olderThan > 0 {
+			orphan.CreatedAt, err = f.awsClient.GetOpenIDConnectProviderCreateDate(provider.Arn)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get OIDC provider '%s': %v", provider.Arn, err)
+			}
+			if !IsOlderThan(orphan.CreatedAt, f.olderThan, f.now()) {
+				continue
+			}
+		}
+		orphans = append(orphans, orphan)
+	}
+	return orphans, nil
+}
+
+// FindOidcConfigs returns the OIDC configurations registered for the account that no cluster uses.
+// The age of a configuration is measured from its last use, or from its creation if it was never used.
+func (f *Finder) FindOidcConfigs() ([]OidcConfig, error) {
+	configs, err := f.ocmClient.ListOidcConfigs(f.accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list OIDC configurations: %v", err)
+	}
+
+	orphans := []OidcConfig{}
+	for _, config := range configs {
+		inUse, err := f.ocmClient.HasAClusterUsingOidcEndpointUrl(config.IssuerUrl())
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if any clusters are using OIDC config '%s': %v",
+				config.ID(), err)
+		}
+		if inUse {
+			continue
+		}
+		createdAt := config.LastUsedTimestamp()
+		if createdAt.IsZero() {
+			createdAt = config.CreationTimestamp()
+		}
+		if f.olderThan > 0 && !IsOlderThan(createdAt, f.olderThan, f.now()) {
+			continue
+		}
+		orphan := OidcConfig{
+			ID:        config.ID(),
+			IssuerURL: config.IssuerUrl(),
+			Managed:   config.Managed(),
+			SecretArn: config.SecretArn(),
+			CreatedAt: createdAt,
+		}
+		if !orphan.Managed && orphan.SecretArn != "" {
+			orphan.BucketName, err = aws.GetBucketNameFromPrivateKeySecretArn(orphan.SecretArn)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse secret ARN '%s': %v", orphan.SecretArn, err)
+			}
+		}
+		orphans = append(orphans, orphan)
+	}
+	return orphans, nil
+}
+
+func (f *Finder) newestRoleCreateDate(roles []aws.OperatorRoleDetail) (time.Time, error) {
+	newest := time.Time{}
+	for _, operatorRole := range roles {
+		role, err := f.awsClient.GetRoleByName(operatorRole.RoleName)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to get operator role '%s': %v", operatorRole.RoleName, err)
+		}
+		if role.CreateDate != nil && role.CreateDate.After(newest) {
+			newest = *role.CreateDate
+		}
+	}
+	return newest, nil
+}
+
+// IsOlderThan returns true when the given timestamp is known and at least the given age before now
+func IsOlderThan(timestamp time.Time, age time.Duration, now time.Time) bool {
+	if timestamp.IsZero() {
+		return false
+	}
+	return !timestamp.Add(age).After(now)
+}
+
+func sortedKeys(m map[string][]aws.OperatorRoleDetail) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
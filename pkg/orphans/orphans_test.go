@@ -0,0 +1,137 @@
+package orphans
+
+import (
+	"net/http"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Orphans", func() {
+	var (
+		t         *TestingRuntime
+		awsClient *aws.MockClient
+		now       time.Time
+	)
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		now = time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	})
+
+	newFinder := func(olderThan time.Duration) *Finder {
+		finder := NewFinder(awsClient, t.RosaRuntime.OCMClient, "123", olderThan)
+		finder.now = func() time.Time { return now }
+		return finder
+	}
+
+	noClusters := func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})))
+	}
+	oneCluster := func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			FormatClusterList([]*cmv1.Cluster{MockCluster(nil)})))
+	}
+
+	Context("IsOlderThan", func() {
+		It("Returns false for unknown timestamps", func() {
+			Expect(IsOlderThan(time.Time{}, time.Hour, now)).To(BeFalse())
+		})
+		It("Compares the age with the current time", func() {
+			Expect(IsOlderThan(now.Add(-2*time.Hour), time.Hour, now)).To(BeTrue())
+			Expect(IsOlderThan(now.Add(-time.Hour), time.Hour, now)).To(BeTrue())
+			Expect(IsOlderThan(now.Add(-30*time.Minute), time.Hour, now)).To(BeFalse())
+		})
+	})
+
+	Context("FindOperatorRoles", func() {
+		It("Returns only the prefixes not used by any cluster", func() {
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+				"in-use": {{RoleName: "in-use-openshift-ingress-operator-cloud-credentials"}},
+				"unused": {
+					{RoleName: "unused-openshift-ingress-operator-cloud-credentials", ClusterID: "abc"},
+					{RoleName: "unused-kube-system-kube-controller-manager"},
+				},
+			}, nil)
+			oneCluster()
+			noClusters()
+
+			groups, err := newFinder(0).FindOperatorRoles()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(HaveLen(1))
+			Expect(groups[0].Prefix).To(Equal("unused"))
+			Expect(groups[0].ClusterID).To(Equal("abc"))
+			Expect(groups[0].RoleNames()).To(ConsistOf(
+				"unused-openshift-ingress-operator-cloud-credentials",
+				"unused-kube-system-kube-controller-manager"))
+		})
+
+		It("Skips prefixes with recently created roles", func() {
+			awsClient.EXPECT().ListOperatorRoles("", "", "").Return(map[string][]aws.OperatorRoleDetail{
+				"unused": {
+					{RoleName: "unused-openshift-ingress-operator-cloud-credentials"},
+					{RoleName: "unused-kube-system-kube-controller-manager"},
+				},
+			}, nil)
+			noClusters()
+			awsClient.EXPECT().GetRoleByName("unused-openshift-ingress-operator-cloud-credentials").
+				Return(iamtypes.Role{CreateDate: awssdk.Time(now.Add(-48 * time.Hour))}, nil)
+			awsClient.EXPECT().GetRoleByName("unused-kube-system-kube-controller-manager").
+				Return(iamtypes.Role{CreateDate: awssdk.Time(now.Add(-time.Hour))}, nil)
+
+			groups, err := newFinder(24 * time.Hour).FindOperatorRoles()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(groups).To(BeEmpty())
+		})
+	})
+
+	Context("FindOidcProviders", func() {
+		It("Returns the providers whose issuer is not used by any cluster", func() {
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{
+				{Arn: "arn:aws:iam::123:oidc-provider/oidc.example.com/used", ClusterId: "a"},
+				{Arn: "arn:aws:iam::123:oidc-provider/oidc.example.com/unused", ClusterId: "b"},
+			}, nil)
+			oneCluster()
+			noClusters()
+			awsClient.EXPECT().GetOpenIDConnectProviderCreateDate(
+				"arn:aws:iam::123:oidc-provider/oidc.example.com/unused").Return(now.Add(-48*time.Hour), nil)
+
+			providers, err := newFinder(24 * time.Hour).FindOidcProviders()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providers).To(HaveLen(1))
+			Expect(providers[0].ClusterID).To(Equal("b"))
+			Expect(providers[0].IssuerURL).To(Equal("https://oidc.example.com/unused"))
+		})
+	})
+
+	Context("FindOidcConfigs", func() {
+		It("Returns unused configurations and derives the bucket of unmanaged ones", func() {
+			used, err := cmv1.NewOidcConfig().ID("used").IssuerUrl("https://used").Managed(true).Build()
+			Expect(err).NotTo(HaveOccurred())
+			unused, err := cmv1.NewOidcConfig().ID("unused").IssuerUrl("https://unused").Managed(false).
+				SecretArn("arn:aws:secretsmanager:us-east-1:123:secret:rosa-private-key-foo-oidc-ab12-Xyz123").
+				CreationTimestamp(now.Add(-48 * time.Hour)).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{used, unused}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+			oneCluster()
+			noClusters()
+
+			configs, err := newFinder(24 * time.Hour).FindOidcConfigs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configs).To(HaveLen(1))
+			Expect(configs[0].ID).To(Equal("unused"))
+			Expect(configs[0].BucketName).To(Equal("foo-oidc-ab12"))
+		})
+	})
+})
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolmigration
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// CloneMachinePool returns a machine pool with the given ID and instance type that has the labels,
+// taints, subnets, availability zones, disk size, security groups, spot options, tags and
+// replicas or autoscaling bounds of the source machine pool
+func CloneMachinePool(source *cmv1.MachinePool, id string, instanceType string) *cmv1.MachinePoolBuilder {
+	builder := cmv1.NewMachinePool().
+		ID(id).
+		InstanceType(instanceType).
+		Labels(source.Labels()).
+		Taints(CloneTaints(source.Taints())...)
+	if len(source.Subnets()) > 0 {
+		builder.Subnets(source.Subnets()...)
+	} else if len(source.AvailabilityZones()) > 0 {
+		builder.AvailabilityZones(source.AvailabilityZones()...)
+	}
+	if autoscaling, ok := source.GetAutoscaling(); ok {
+		builder.Autoscaling(cmv1.NewMachinePoolAutoscaling().
+			MinReplicas(autoscaling.MinReplicas()).
+			MaxReplicas(autoscaling.MaxReplicas()))
+	} else {
+		builder.Replicas(source.Replicas())
+	}
+	if size, ok := source.RootVolume().AWS().GetSize(); ok {
+		builder.RootVolume(cmv1.NewRootVolume().AWS(cmv1.NewAWSVolume().Size(size)))
+	}
+	if aws, ok := source.GetAWS(); ok {
+		awsBuilder := cmv1.NewAWSMachinePool().
+			AdditionalSecurityGroupIds(aws.AdditionalSecurityGroupIds()...).
+			Tags(aws.Tags())
+		if spot, ok := aws.GetSpotMarketOptions(); ok {
+			spotBuilder := cmv1.NewAWSSpotMarketOptions()
+			if maxPrice, ok := spot.GetMaxPrice(); ok {
+				spotBuilder.MaxPrice(maxPrice)
+			}
+			awsBuilder.SpotMarketOptions(spotBuilder)
+		}
+		builder.AWS(awsBuilder)
+	}
+	return builder
+}
+
+// CloneNodePool returns a node pool with the given ID and instance type that has the settings of the
+// source node pool and its replicas or autoscaling bounds. It also returns the settings that can't be
+// carried over to the new instance type.
+func CloneNodePool(source *cmv1.NodePool, id string, instanceType string) (*cmv1.NodePoolBuilder, []string) {
+	builder, skipped := CloneNodePoolSettings(source, id, instanceType)
+	if autoscaling, ok := source.GetAutoscaling(); ok {
+		builder.Autoscaling(cmv1.NewNodePoolAutoscaling().
+			MinReplica(autoscaling.MinReplica()).
+			MaxReplica(autoscaling.MaxReplica()))
+	} else {
+		builder.Replicas(source.Replicas())
+	}
+	return builder, skipped
+}
+
+// CloneNodePoolSettings returns a node pool with the given ID and instance type that has the labels,
+// taints, subnet, tuning and kubelet configs, version, disk size, security groups, spot options, tags and
+// upgrade and drain settings of the source node pool, leaving the replicas to the caller. It also returns
+// the settings that can't be carried over to the new instance type.
+func CloneNodePoolSettings(source *cmv1.NodePool, id string, instanceType string) (*cmv1.NodePoolBuilder,
+	[]string) {
+	skipped := []string{}
+	builder := cmv1.NewNodePool().
+		ID(id).
+		Labels(source.Labels()).
+		Taints(CloneTaints(source.Taints())...).
+		Subnet(source.Subnet()).
+		TuningConfigs(source.TuningConfigs()...).
+		KubeletConfigs(source.KubeletConfigs()...)
+	if autoRepair, ok := source.GetAutoRepair(); ok {
+		builder.AutoRepair(autoRepair)
+	}
+	if imageType, ok := source.GetImageType(); ok {
+		builder.ImageType(imageType)
+	}
+	if version, ok := source.Version().GetID(); ok {
+		builder.Version(cmv1.NewVersion().ID(version))
+	}
+	if gracePeriod, ok := source.GetNodeDrainGracePeriod(); ok {
+		builder.NodeDrainGracePeriod(cmv1.NewValue().
+			Value(gracePeriod.Value()).
+			Unit(gracePeriod.Unit()))
+	}
+	if upgrade, ok := source.GetManagementUpgrade(); ok {
+		builder.ManagementUpgrade(cmv1.NewNodePoolManagementUpgrade().
+			Type(upgrade.Type()).
+			MaxSurge(upgrade.MaxSurge()).
+			MaxUnavailable(upgrade.MaxUnavailable()))
+	}
+
+	awsBuilder := cmv1.NewAWSNodePool().InstanceType(instanceType)
+	if aws, ok := source.GetAWSNodePool(); ok {
+		awsBuilder.
+			AdditionalSecurityGroupIds(aws.AdditionalSecurityGroupIds()...).
+			Tags(aws.Tags())
+		if size, ok := aws.RootVolume().GetSize(); ok {
+			awsBuilder.RootVolume(cmv1.NewAWSVolume().Size(size))
+		}
+		if spot, ok := aws.GetSpotMarketOptions(); ok {
+			spotBuilder := cmv1.NewAwsNodePoolSpotMarketOptions()
+			if maxPrice, ok := spot.GetMaxPrice(); ok {
+				spotBuilder.MaxPrice(maxPrice)
+			}
+			awsBuilder.SpotMarketOptions(spotBuilder)
+		}
+		if _, ok := aws.GetCapacityReservation(); ok {
+			// Capacity reservations are bought for a specific instance type
+			skipped = append(skipped, fmt.Sprintf("capacity reservation (reserved for instance type '%s')",
+				aws.InstanceType()))
+		}
+	}
+	builder.AWSNodePool(awsBuilder)
+	return builder, skipped
+}
+
+// CloneTaints returns builders for a copy of the given taints
+func CloneTaints(taints []*cmv1.Taint) []*cmv1.TaintBuilder {
+	builders := []*cmv1.TaintBuilder{}
+	for _, taint := range taints {
+		builders = append(builders, cmv1.NewTaint().
+			Key(taint.Key()).
+			Value(taint.Value()).
+			Effect(taint.Effect()))
+	}
+	return builders
+}
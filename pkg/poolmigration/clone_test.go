@@ -0,0 +1,117 @@
+package poolmigration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Clone", func() {
+	It("Clones a machine pool with a new instance type", func() {
+		source, err := cmv1.NewMachinePool().
+			ID("workers").
+			InstanceType("m5.xlarge").
+			Labels(map[string]string{"team": "a"}).
+			Taints(cmv1.NewTaint().Key("dedicated").Value("a").Effect("NoSchedule")).
+			Subnets("subnet-1", "subnet-2").
+			AvailabilityZones("us-east-1a", "us-east-1b").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(6)).
+			RootVolume(cmv1.NewRootVolume().AWS(cmv1.NewAWSVolume().Size(300))).
+			AWS(cmv1.NewAWSMachinePool().
+				AdditionalSecurityGroupIds("sg-1").
+				Tags(map[string]string{"owner": "me"}).
+				SpotMarketOptions(cmv1.NewAWSSpotMarketOptions().MaxPrice(0.5))).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		clone, err := CloneMachinePool(source, "workers-r6i-2xlarge", "r6i.2xlarge").Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clone.ID()).To(Equal("workers-r6i-2xlarge"))
+		Expect(clone.InstanceType()).To(Equal("r6i.2xlarge"))
+		Expect(clone.Labels()).To(Equal(map[string]string{"team": "a"}))
+		Expect(clone.Taints()).To(HaveLen(1))
+		Expect(clone.Taints()[0].Effect()).To(Equal("NoSchedule"))
+		Expect(clone.Subnets()).To(Equal([]string{"subnet-1", "subnet-2"}))
+		Expect(clone.AvailabilityZones()).To(BeEmpty())
+		Expect(clone.Autoscaling().MinReplicas()).To(Equal(2))
+		Expect(clone.Autoscaling().MaxReplicas()).To(Equal(6))
+		Expect(clone.RootVolume().AWS().Size()).To(Equal(300))
+		Expect(clone.AWS().AdditionalSecurityGroupIds()).To(Equal([]string{"sg-1"}))
+		Expect(clone.AWS().Tags()).To(Equal(map[string]string{"owner": "me"}))
+		Expect(clone.AWS().SpotMarketOptions().MaxPrice()).To(Equal(0.5))
+	})
+
+	It("Clones a node pool and reports its capacity reservation", func() {
+		source, err := cmv1.NewNodePool().
+			ID("workers").
+			Replicas(3).
+			Subnet("subnet-1").
+			Labels(map[string]string{"team": "a"}).
+			TuningConfigs("tuning").
+			KubeletConfigs("kubelet").
+			Version(cmv1.NewVersion().ID("openshift-v4.16.1")).
+			AutoRepair(true).
+			NodeDrainGracePeriod(cmv1.NewValue().Value(30).Unit("minutes")).
+			AWSNodePool(cmv1.NewAWSNodePool().
+				InstanceType("m5.xlarge").
+				RootVolume(cmv1.NewAWSVolume().Size(200)).
+				CapacityReservation(cmv1.NewAWSCapacityReservation().Id("cr-1"))).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+
+		builder, skipped := CloneNodePool(source, "workers-r6i-2xlarge", "r6i.2xlarge")
+		clone, err := builder.Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clone.ID()).To(Equal("workers-r6i-2xlarge"))
+		Expect(clone.Replicas()).To(Equal(3))
+		Expect(clone.Subnet()).To(Equal("subnet-1"))
+		Expect(clone.TuningConfigs()).To(Equal([]string{"tuning"}))
+		Expect(clone.KubeletConfigs()).To(Equal([]string{"kubelet"}))
+		Expect(clone.Version().ID()).To(Equal("openshift-v4.16.1"))
+		Expect(clone.AutoRepair()).To(BeTrue())
+		Expect(clone.NodeDrainGracePeriod().Value()).To(Equal(float64(30)))
+		Expect(clone.AWSNodePool().InstanceType()).To(Equal("r6i.2xlarge"))
+		Expect(clone.AWSNodePool().RootVolume().Size()).To(Equal(200))
+		Expect(clone.AWSNodePool().CapacityReservation()).To(BeNil())
+		Expect(skipped).To(Equal([]string{"capacity reservation (reserved for instance type 'm5.xlarge')"}))
+	})
+})
+
+var _ = Describe("Scale", func() {
+	It("Sets the replicas of a machine pool", func() {
+		update, err := ScaleMachinePool(&Pool{ID: "workers", Replicas: 3}, 2).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(update.Replicas()).To(Equal(2))
+		Expect(update.Autoscaling()).To(BeNil())
+	})
+
+	It("Pins the autoscaling of an autoscaling machine pool", func() {
+		pool := &Pool{ID: "workers", Autoscaling: true, MinReplicas: 2, MaxReplicas: 6}
+		update, err := ScaleMachinePool(pool, 4).Build()
+		Expect(err).NotTo(HaveOccurred())
+		_, ok := update.GetReplicas()
+		Expect(ok).To(BeFalse())
+		Expect(update.Autoscaling().MinReplicas()).To(Equal(4))
+		Expect(update.Autoscaling().MaxReplicas()).To(Equal(4))
+
+		update, err = ScaleMachinePool(pool, 0).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(update.Autoscaling()).To(BeNil())
+		replicas, ok := update.GetReplicas()
+		Expect(ok).To(BeTrue())
+		Expect(replicas).To(Equal(0))
+	})
+
+	It("Pins the autoscaling of an autoscaling node pool", func() {
+		pool := &Pool{ID: "workers", Autoscaling: true, MinReplicas: 2, MaxReplicas: 6}
+		update, err := ScaleNodePool(pool, 1).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(update.Autoscaling().MinReplica()).To(Equal(1))
+		Expect(update.Autoscaling().MaxReplica()).To(Equal(1))
+
+		update, err = ScaleNodePool(&Pool{ID: "workers", Replicas: 3}, 0).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(update.Autoscaling()).To(BeNil())
+		Expect(update.Replicas()).To(Equal(0))
+	})
+})
@@ -0,0 +1,201 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolmigration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultStep         = 1
+	DefaultTimeout      = 30 * time.Minute
+	DefaultPollInterval = 30 * time.Second
+
+	// MaxNodePoolNameLength is the longest name of a node pool, it is limited by the host names of its nodes
+	MaxNodePoolNameLength = 15
+)
+
+var invalidIDChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// TargetID returns the ID of the pool that replaces the source pool, derived from the instance type so
+// that running the migration again finds the pool it created
+func TargetID(sourceID string, instanceType string) string {
+	suffix := invalidIDChars.ReplaceAllString(strings.ToLower(instanceType), "-")
+	return strings.Trim(fmt.Sprintf("%s-%s", sourceID, suffix), "-")
+}
+
+// Options configures a migration
+type Options struct {
+	SourceID     string
+	TargetID     string
+	InstanceType string
+	// Step is the number of nodes removed from the source pool at a time
+	Step int
+	// Timeout is the time to wait for each change of the number of nodes
+	Timeout      time.Duration
+	PollInterval time.Duration
+}
+
+// Migration moves the workloads of a pool to a new pool with a different instance type. The state of
+// the migration is read from the pools, running it again after an interruption resumes it.
+type Migration struct {
+	pools    Pools
+	options  Options
+	progress func(format string, args ...interface{})
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+// NewMigration returns a migration that reports its progress with the given function
+func NewMigration(pools Pools, options Options, progress func(format string, args ...interface{})) *Migration {
+	if options.Step <= 0 {
+		options.Step = DefaultStep
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = DefaultTimeout
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultPollInterval
+	}
+	return &Migration{
+		pools:    pools,
+		options:  options,
+		progress: progress,
+		sleep:    sleep,
+	}
+}
+
+// Run creates the target pool, waits for its nodes, scales the source pool down step by step and
+// deletes it
+func (m *Migration) Run(ctx context.Context) error {
+	source, sourceExists, err := m.pools.Get(m.options.SourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get pool '%s': %v", m.options.SourceID, err)
+	}
+	target, targetExists, err := m.pools.Get(m.options.TargetID)
+	if err != nil {
+		return fmt.Errorf("failed to get pool '%s': %v", m.options.TargetID, err)
+	}
+	if targetExists && target.InstanceType != m.options.InstanceType {
+		return fmt.Errorf("pool '%s' already exists with instance type '%s'",
+			m.options.TargetID, target.InstanceType)
+	}
+	if !sourceExists {
+		if targetExists {
+			m.progress("Pool '%s' was already migrated to pool '%s'", m.options.SourceID, m.options.TargetID)
+			return nil
+		}
+		return fmt.Errorf("pool '%s' does not exist", m.options.SourceID)
+	}
+
+	if targetExists {
+		m.progress("Resuming the migration to existing pool '%s'", m.options.TargetID)
+	} else {
+		if source.InstanceType == m.options.InstanceType {
+			return fmt.Errorf("pool '%s' already uses instance type '%s'",
+				m.options.SourceID, m.options.InstanceType)
+		}
+		skipped, err := m.pools.Clone(m.options.SourceID, m.options.TargetID, m.options.InstanceType)
+		if err != nil {
+			return fmt.Errorf("failed to create pool '%s': %v", m.options.TargetID, err)
+		}
+		for _, setting := range skipped {
+			m.progress("Pool '%s' was created without the %s of pool '%s'",
+				m.options.TargetID, setting, m.options.SourceID)
+		}
+		m.progress("Created pool '%s' with instance type '%s'", m.options.TargetID, m.options.InstanceType)
+		target, _, err = m.pools.Get(m.options.TargetID)
+		if err != nil {
+			return fmt.Errorf("failed to get pool '%s': %v", m.options.TargetID, err)
+		}
+	}
+
+	desired := target.DesiredReplicas()
+	m.progress("Waiting for %d nodes of pool '%s' to be ready", desired, m.options.TargetID)
+	err = m.waitFor(ctx, m.options.TargetID, func(ready int) bool {
+		return ready >= desired
+	})
+	if err != nil {
+		return err
+	}
+
+	current := source.Replicas
+	if source.Autoscaling {
+		current, err = m.pools.ReadyReplicas(m.options.SourceID)
+		if err != nil {
+			return fmt.Errorf("failed to get the nodes of pool '%s': %v", m.options.SourceID, err)
+		}
+	}
+	for current > 0 {
+		next := current - m.options.Step
+		if next < 0 {
+			next = 0
+		}
+		err = m.pools.Scale(m.options.SourceID, next)
+		if err != nil {
+			return fmt.Errorf("failed to scale pool '%s' to %d nodes: %v", m.options.SourceID, next, err)
+		}
+		m.progress("Scaled pool '%s' down to %d nodes", m.options.SourceID, next)
+		err = m.waitFor(ctx, m.options.SourceID, func(ready int) bool {
+			return ready <= next
+		})
+		if err != nil {
+			return err
+		}
+		current = next
+	}
+
+	err = m.pools.Delete(m.options.SourceID)
+	if err != nil {
+		return fmt.Errorf("failed to delete pool '%s': %v", m.options.SourceID, err)
+	}
+	m.progress("Deleted pool '%s'", m.options.SourceID)
+	return nil
+}
+
+func (m *Migration) waitFor(ctx context.Context, id string, done func(ready int) bool) error {
+	deadline := time.Now().Add(m.options.Timeout)
+	for {
+		ready, err := m.pools.ReadyReplicas(id)
+		if err != nil {
+			return fmt.Errorf("failed to get the nodes of pool '%s': %v", id, err)
+		}
+		if done(ready) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for the nodes of pool '%s', %d nodes are ready",
+				m.options.Timeout, id, ready)
+		}
+		err = m.sleep(ctx, m.options.PollInterval)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
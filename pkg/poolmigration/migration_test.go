@@ -0,0 +1,171 @@
+package poolmigration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakePools simulates pools whose nodes follow the requested replicas after one poll
+type fakePools struct {
+	pools   map[string]*Pool
+	ready   map[string]int
+	calls   []string
+	skipped []string
+	stuck   bool
+}
+
+func (f *fakePools) Get(id string) (*Pool, bool, error) {
+	pool, ok := f.pools[id]
+	if !ok {
+		return nil, false, nil
+	}
+	copied := *pool
+	return &copied, true, nil
+}
+
+func (f *fakePools) Clone(sourceID string, targetID string, instanceType string) ([]string, error) {
+	f.calls = append(f.calls, fmt.Sprintf("clone %s %s %s", sourceID, targetID, instanceType))
+	target := *f.pools[sourceID]
+	target.ID = targetID
+	target.InstanceType = instanceType
+	f.pools[targetID] = &target
+	return f.skipped, nil
+}
+
+func (f *fakePools) ReadyReplicas(id string) (int, error) {
+	ready := f.ready[id]
+	if !f.stuck {
+		f.ready[id] = f.pools[id].DesiredReplicas()
+	}
+	return ready, nil
+}
+
+func (f *fakePools) Scale(id string, replicas int) error {
+	f.calls = append(f.calls, fmt.Sprintf("scale %s %d", id, replicas))
+	f.pools[id].Replicas = replicas
+	f.pools[id].Autoscaling = false
+	return nil
+}
+
+func (f *fakePools) Delete(id string) error {
+	f.calls = append(f.calls, fmt.Sprintf("delete %s", id))
+	delete(f.pools, id)
+	return nil
+}
+
+var _ = Describe("Migration", func() {
+	var pools *fakePools
+	var messages []string
+
+	newMigration := func(step int) *Migration {
+		migration := NewMigration(pools, Options{
+			SourceID:     "workers",
+			TargetID:     "workers-r6i-2xlarge",
+			InstanceType: "r6i.2xlarge",
+			Step:         step,
+		}, func(format string, args ...interface{}) {
+			messages = append(messages, fmt.Sprintf(format, args...))
+		})
+		migration.sleep = func(_ context.Context, _ time.Duration) error {
+			return nil
+		}
+		return migration
+	}
+
+	BeforeEach(func() {
+		messages = []string{}
+		pools = &fakePools{
+			pools: map[string]*Pool{
+				"workers": {ID: "workers", InstanceType: "m5.xlarge", Replicas: 3},
+			},
+			ready: map[string]int{"workers": 3},
+		}
+	})
+
+	It("Derives the target pool from the instance type", func() {
+		Expect(TargetID("workers", "r6i.2xlarge")).To(Equal("workers-r6i-2xlarge"))
+		Expect(TargetID("gpu", "p4d.24xlarge")).To(Equal("gpu-p4d-24xlarge"))
+	})
+
+	It("Creates the new pool, scales the old pool down in steps and deletes it", func() {
+		Expect(newMigration(2).Run(context.Background())).To(Succeed())
+		Expect(pools.calls).To(Equal([]string{
+			"clone workers workers-r6i-2xlarge r6i.2xlarge",
+			"scale workers 1",
+			"scale workers 0",
+			"delete workers",
+		}))
+		Expect(messages).To(ContainElement("Waiting for 3 nodes of pool 'workers-r6i-2xlarge' to be ready"))
+		Expect(messages[len(messages)-1]).To(Equal("Deleted pool 'workers'"))
+	})
+
+	It("Scales autoscaling pools down from their current nodes", func() {
+		pools.pools["workers"] = &Pool{ID: "workers", InstanceType: "m5.xlarge", Autoscaling: true,
+			MinReplicas: 2, MaxReplicas: 6}
+		pools.ready["workers"] = 4
+		Expect(newMigration(3).Run(context.Background())).To(Succeed())
+		Expect(pools.calls).To(Equal([]string{
+			"clone workers workers-r6i-2xlarge r6i.2xlarge",
+			"scale workers 1",
+			"scale workers 0",
+			"delete workers",
+		}))
+		Expect(messages).To(ContainElement("Waiting for 2 nodes of pool 'workers-r6i-2xlarge' to be ready"))
+	})
+
+	It("Reports the settings that could not be cloned", func() {
+		pools.skipped = []string{"capacity reservation"}
+		Expect(newMigration(1).Run(context.Background())).To(Succeed())
+		Expect(messages).To(ContainElement(
+			"Pool 'workers-r6i-2xlarge' was created without the capacity reservation of pool 'workers'"))
+	})
+
+	It("Resumes an interrupted migration", func() {
+		pools.pools["workers"].Replicas = 1
+		pools.pools["workers-r6i-2xlarge"] = &Pool{ID: "workers-r6i-2xlarge", InstanceType: "r6i.2xlarge", Replicas: 3}
+		pools.ready["workers-r6i-2xlarge"] = 3
+		Expect(newMigration(1).Run(context.Background())).To(Succeed())
+		Expect(pools.calls).To(Equal([]string{"scale workers 0", "delete workers"}))
+		Expect(messages[0]).To(Equal("Resuming the migration to existing pool 'workers-r6i-2xlarge'"))
+	})
+
+	It("Does nothing when the migration is complete", func() {
+		delete(pools.pools, "workers")
+		pools.pools["workers-r6i-2xlarge"] = &Pool{ID: "workers-r6i-2xlarge", InstanceType: "r6i.2xlarge", Replicas: 3}
+		Expect(newMigration(1).Run(context.Background())).To(Succeed())
+		Expect(pools.calls).To(BeEmpty())
+		Expect(messages).To(Equal([]string{"Pool 'workers' was already migrated to pool 'workers-r6i-2xlarge'"}))
+	})
+
+	It("Fails if the target pool has another instance type", func() {
+		pools.pools["workers-r6i-2xlarge"] = &Pool{ID: "workers-r6i-2xlarge", InstanceType: "m5.large"}
+		err := newMigration(1).Run(context.Background())
+		Expect(err).To(MatchError("pool 'workers-r6i-2xlarge' already exists with instance type 'm5.large'"))
+	})
+
+	It("Fails if the pool already uses the instance type", func() {
+		pools.pools["workers"].InstanceType = "r6i.2xlarge"
+		err := newMigration(1).Run(context.Background())
+		Expect(err).To(MatchError("pool 'workers' already uses instance type 'r6i.2xlarge'"))
+	})
+
+	It("Fails if the pool does not exist", func() {
+		delete(pools.pools, "workers")
+		err := newMigration(1).Run(context.Background())
+		Expect(err).To(MatchError("pool 'workers' does not exist"))
+	})
+
+	It("Times out when the nodes don't become ready", func() {
+		pools.stuck = true
+		migration := newMigration(1)
+		migration.options.Timeout = time.Nanosecond
+		err := migration.Run(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("waiting for the nodes of pool 'workers-r6i-2xlarge', " +
+			"0 nodes are ready")))
+		Expect(pools.calls).To(HaveLen(1))
+	})
+})
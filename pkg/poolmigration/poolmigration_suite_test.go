@@ -0,0 +1,13 @@
+package poolmigration
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPoolMigration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Pool Migration Suite")
+}
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poolmigration
+
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// Pool is the state of a machine pool or node pool that drives the migration
+type Pool struct {
+	ID           string
+	InstanceType string
+	Replicas     int
+	Autoscaling  bool
+	MinReplicas  int
+	MaxReplicas  int
+}
+
+// DesiredReplicas returns the number of nodes the pool must have before the source pool is scaled
+// down, the minimum of an autoscaling pool
+func (p *Pool) DesiredReplicas() int {
+	if p.Autoscaling {
+		return p.MinReplicas
+	}
+	return p.Replicas
+}
+
+// Pools manages the machine pools of a classic cluster or the node pools of a hosted control plane
+// cluster
+type Pools interface {
+	// Get returns the pool with the given ID and false if it doesn't exist
+	Get(id string) (*Pool, bool, error)
+	// Clone creates a pool with the given ID and instance type from the source pool, returning the
+	// settings that could not be cloned
+	Clone(sourceID string, targetID string, instanceType string) ([]string, error)
+	// ReadyReplicas returns the number of nodes of the pool that are running
+	ReadyReplicas(id string) (int, error)
+	// Scale sets the replicas of the pool, pinning the minimum and maximum of an autoscaling pool to them
+	// so that the autoscaler can't add the nodes back
+	Scale(id string, replicas int) error
+	// Delete deletes the pool
+	Delete(id string) error
+}
+
+// ScaleMachinePool returns the update that sets the replicas of the machine pool. The autoscaling of the
+// pool is pinned to the replicas, the autoscaler requires at least one node so an autoscaling pool that
+// is scaled to zero is switched to replicas instead.
+func ScaleMachinePool(pool *Pool, replicas int) *cmv1.MachinePoolBuilder {
+	builder := cmv1.NewMachinePool().ID(pool.ID)
+	if pool.Autoscaling && replicas > 0 {
+		return builder.Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(replicas).MaxReplicas(replicas))
+	}
+	return builder.Replicas(replicas)
+}
+
+// ScaleNodePool returns the update that sets the replicas of the node pool, see ScaleMachinePool
+func ScaleNodePool(pool *Pool, replicas int) *cmv1.NodePoolBuilder {
+	builder := cmv1.NewNodePool().ID(pool.ID)
+	if pool.Autoscaling && replicas > 0 {
+		return builder.Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(replicas).MaxReplica(replicas))
+	}
+	return builder.Replicas(replicas)
+}
+
+type machinePools struct {
+	ocmClient *ocm.Client
+	awsClient aws.Client
+	clusterID string
+	infraID   string
+}
+
+// NewMachinePools returns the pools of a classic cluster. Machine pools don't report the state of
+// their nodes, the running EC2 instances of the pool are counted instead.
+func NewMachinePools(ocmClient *ocm.Client, awsClient aws.Client, cluster *cmv1.Cluster) Pools {
+	return &machinePools{
+		ocmClient: ocmClient,
+		awsClient: awsClient,
+		clusterID: cluster.ID(),
+		infraID:   cluster.InfraID(),
+	}
+}
+
+func (m *machinePools) Get(id string) (*Pool, bool, error) {
+	machinePool, exists, err := m.ocmClient.GetMachinePool(m.clusterID, id)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	pool := &Pool{
+		ID:           machinePool.ID(),
+		InstanceType: machinePool.InstanceType(),
+		Replicas:     machinePool.Replicas(),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		pool.Autoscaling = true
+		pool.MinReplicas = autoscaling.MinReplicas()
+		pool.MaxReplicas = autoscaling.MaxReplicas()
+	}
+	return pool, true, nil
+}
+
+func (m *machinePools) Clone(sourceID string, targetID string, instanceType string) ([]string, error) {
+	source, _, err := m.ocmClient.GetMachinePool(m.clusterID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	machinePool, err := CloneMachinePool(source, targetID, instanceType).Build()
+	if err != nil {
+		return nil, err
+	}
+	_, err = m.ocmClient.CreateMachinePool(m.clusterID, machinePool)
+	return []string{}, err
+}
+
+func (m *machinePools) ReadyReplicas(id string) (int, error) {
+	instances, err := m.awsClient.ListMachinePoolInstances(m.infraID, id)
+	if err != nil {
+		return 0, err
+	}
+	return len(instances), nil
+}
+
+func (m *machinePools) Scale(id string, replicas int) error {
+	pool, exists, err := m.Get(id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("pool '%s' does not exist", id)
+	}
+	machinePool, err := ScaleMachinePool(pool, replicas).Build()
+	if err != nil {
+		return err
+	}
+	_, err = m.ocmClient.UpdateMachinePool(m.clusterID, machinePool)
+	return err
+}
+
+func (m *machinePools) Delete(id string) error {
+	return m.ocmClient.DeleteMachinePool(m.clusterID, id)
+}
+
+type nodePools struct {
+	ocmClient *ocm.Client
+	clusterID string
+}
+
+// NewNodePools returns the pools of a hosted control plane cluster
+func NewNodePools(ocmClient *ocm.Client, cluster *cmv1.Cluster) Pools {
+	return &nodePools{
+		ocmClient: ocmClient,
+		clusterID: cluster.ID(),
+	}
+}
+
+func (n *nodePools) Get(id string) (*Pool, bool, error) {
+	nodePool, exists, err := n.ocmClient.GetNodePool(n.clusterID, id)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	pool := &Pool{
+		ID:           nodePool.ID(),
+		InstanceType: nodePool.AWSNodePool().InstanceType(),
+		Replicas:     nodePool.Replicas(),
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		pool.Autoscaling = true
+		pool.MinReplicas = autoscaling.MinReplica()
+		pool.MaxReplicas = autoscaling.MaxReplica()
+	}
+	return pool, true, nil
+}
+
+func (n *nodePools) Clone(sourceID string, targetID string, instanceType string) ([]string, error) {
+	source, _, err := n.ocmClient.GetNodePool(n.clusterID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	builder, skipped := CloneNodePool(source, targetID, instanceType)
+	nodePool, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	_, err = n.ocmClient.CreateNodePool(n.clusterID, nodePool)
+	return skipped, err
+}
+
+func (n *nodePools) ReadyReplicas(id string) (int, error) {
+	nodePool, exists, err := n.ocmClient.GetNodePool(n.clusterID, id)
+	if err != nil || !exists {
+		return 0, err
+	}
+	return nodePool.Status().CurrentReplicas(), nil
+}
+
+func (n *nodePools) Scale(id string, replicas int) error {
+	pool, exists, err := n.Get(id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("pool '%s' does not exist", id)
+	}
+	nodePool, err := ScaleNodePool(pool, replicas).Build()
+	if err != nil {
+		return err
+	}
+	_, err = n.ocmClient.UpdateNodePool(n.clusterID, nodePool)
+	return err
+}
+
+func (n *nodePools) Delete(id string) error {
+	return n.ocmClient.DeleteNodePool(n.clusterID, id)
+}
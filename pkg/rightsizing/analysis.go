@@ -0,0 +1,370 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rightsizing
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/instancetype"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/poolmigration"
+)
+
+const (
+	// DefaultTargetUtilization is the share of the CPU and memory of the nodes that the workloads should use
+	DefaultTargetUtilization = 70
+
+	// An instance type is only suggested when it provisions this much less overhead than the current one,
+	// replacing the nodes of a machine pool is not worth a small gain
+	minOverheadGain = 0.15
+
+	// Replacement node pools are named after the node pool with this suffix when the name derived from
+	// the instance type is too long
+	replacementSuffix = "-new"
+)
+
+// Pool is a machine pool or node pool to analyze
+type Pool struct {
+	ID           string
+	InstanceType string
+	Autoscaling  bool
+	Replicas     int
+	MinReplicas  int
+	MaxReplicas  int
+	// Step is the number of replicas added or removed at once, the number of zones of multi-AZ machine pools
+	Step int
+	// Hosted is set for the node pools of hosted control plane clusters, whose names are limited in length
+	Hosted bool
+}
+
+// MachinePools returns the pools of the machine pools of a classic cluster
+func MachinePools(machinePools []*cmv1.MachinePool) []*Pool {
+	pools := []*Pool{}
+	for _, machinePool := range machinePools {
+		pool := &Pool{
+			ID:           machinePool.ID(),
+			InstanceType: machinePool.InstanceType(),
+			Replicas:     machinePool.Replicas(),
+			Step:         max(len(machinePool.AvailabilityZones()), 1),
+		}
+		if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+			pool.Autoscaling = true
+			pool.MinReplicas = autoscaling.MinReplicas()
+			pool.MaxReplicas = autoscaling.MaxReplicas()
+		}
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// NodePools returns the pools of the node pools of a hosted control plane cluster
+func NodePools(nodePools []*cmv1.NodePool) []*Pool {
+	pools := []*Pool{}
+	for _, nodePool := range nodePools {
+		pool := &Pool{
+			ID:           nodePool.ID(),
+			InstanceType: nodePool.AWSNodePool().InstanceType(),
+			Replicas:     nodePool.Replicas(),
+			Step:         1,
+			Hosted:       true,
+		}
+		if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+			pool.Autoscaling = true
+			pool.MinReplicas = autoscaling.MinReplica()
+			pool.MaxReplicas = autoscaling.MaxReplica()
+		}
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// Usage is the resources requested and used on the nodes of a pool. The demand is the larger of the
+// requests and the usage.
+type Usage struct {
+	Nodes          int     `json:"nodes"`
+	CPURequests    float64 `json:"cpu_requests"`
+	CPUUsage       float64 `json:"cpu_usage"`
+	MemoryRequests float64 `json:"memory_requests"`
+	MemoryUsage    float64 `json:"memory_usage"`
+}
+
+func (u *Usage) cpuDemand() float64 {
+	return math.Max(u.CPURequests, u.CPUUsage)
+}
+
+func (u *Usage) memoryDemand() float64 {
+	return math.Max(u.MemoryRequests, u.MemoryUsage)
+}
+
+// Recommendation is the result of the analysis of a pool
+type Recommendation struct {
+	MachinePool       string   `json:"machine_pool"`
+	InstanceType      string   `json:"instance_type"`
+	Replicas          string   `json:"replicas"`
+	Usage             Usage    `json:"usage"`
+	CPUUtilization    float64  `json:"cpu_utilization"`
+	MemoryUtilization float64  `json:"memory_utilization"`
+	NewInstanceType   string   `json:"new_instance_type,omitempty"`
+	NewReplicas       string   `json:"new_replicas,omitempty"`
+	Reason            string   `json:"reason"`
+	Commands          []string `json:"commands,omitempty"`
+}
+
+// Changed returns true if the recommendation changes the pool
+func (r *Recommendation) Changed() bool {
+	return len(r.Commands) > 0
+}
+
+// Analysis is the result of the analysis of the pools of a cluster
+type Analysis struct {
+	TargetUtilization int               `json:"target_utilization"`
+	Recommendations   []*Recommendation `json:"recommendations"`
+	Warnings          []string          `json:"warnings,omitempty"`
+}
+
+// Analyze compares the demand of the nodes of every pool with the capacity of its instance type and suggests
+// the replicas, and if it fits much better another instance type, that bring the utilization of the nodes to
+// the target utilization percentage. Instance types are taken from the available instance types of the list
+// with the same architecture. The name of the cluster matches the metrics of hosted control plane nodes to
+// their node pools.
+func Analyze(clusterKey string, clusterName string, pools []*Pool, metrics *Metrics, machineTypes ocm.MachineTypeList,
+	targetUtilization int) *Analysis {
+	analysis := &Analysis{TargetUtilization: targetUtilization}
+
+	usages := map[string]*Usage{}
+	unknown := map[string]bool{}
+	for _, node := range metrics.Nodes {
+		pool := findPool(pools, clusterName, node.MachinePool)
+		if pool == nil {
+			if node.MachinePool == "" {
+				unknown[node.Node] = true
+			} else {
+				unknown[node.MachinePool] = true
+			}
+			continue
+		}
+		usage, ok := usages[pool.ID]
+		if !ok {
+			usage = &Usage{}
+			usages[pool.ID] = usage
+		}
+		usage.Nodes++
+		usage.CPURequests += node.CPURequests
+		usage.CPUUsage += node.CPUUsage
+		usage.MemoryRequests += node.MemoryRequests
+		usage.MemoryUsage += node.MemoryUsage
+	}
+	if len(unknown) > 0 {
+		names := []string{}
+		for name := range unknown {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("Metrics of nodes or machine pools that "+
+			"are not machine pools of the cluster are ignored: %s", strings.Join(names, ", ")))
+	}
+
+	for _, pool := range pools {
+		usage, ok := usages[pool.ID]
+		if !ok {
+			analysis.Warnings = append(analysis.Warnings,
+				fmt.Sprintf("There are no metrics for machine pool '%s'", pool.ID))
+			continue
+		}
+		machineType := machineTypes.Find(pool.InstanceType)
+		if machineType == nil {
+			analysis.Warnings = append(analysis.Warnings, fmt.Sprintf("Instance type '%s' of machine pool "+
+				"'%s' is unknown", pool.InstanceType, pool.ID))
+			continue
+		}
+		analysis.Recommendations = append(analysis.Recommendations,
+			analyzePool(clusterKey, pool, usage, machineType, machineTypes, targetUtilization))
+	}
+	return analysis
+}
+
+// findPool returns the pool of a machine pool label. The node pool label of hosted control plane nodes is
+// the name of the cluster followed by the ID of the node pool.
+func findPool(pools []*Pool, clusterName string, machinePool string) *Pool {
+	if machinePool == "" {
+		return nil
+	}
+	for _, pool := range pools {
+		if pool.ID == machinePool {
+			return pool
+		}
+	}
+	for _, pool := range pools {
+		if machinePool == clusterName+"-"+pool.ID {
+			return pool
+		}
+	}
+	return nil
+}
+
+func analyzePool(clusterKey string, pool *Pool, usage *Usage, machineType *ocm.MachineType,
+	machineTypes ocm.MachineTypeList, targetUtilization int) *Recommendation {
+	cpu := instancetype.CPUCores(machineType.MachineType)
+	memory := instancetype.MemoryBytes(machineType.MachineType)
+	recommendation := &Recommendation{
+		MachinePool:  pool.ID,
+		InstanceType: pool.InstanceType,
+		Replicas:     formatReplicas(pool.Autoscaling, pool.Replicas, pool.MinReplicas, pool.MaxReplicas),
+		Usage:        *usage,
+	}
+	if cpu > 0 && memory > 0 {
+		recommendation.CPUUtilization = usage.cpuDemand() / float64(usage.Nodes*cpu)
+		recommendation.MemoryUtilization = usage.memoryDemand() / float64(int64(usage.Nodes)*memory)
+	}
+
+	target := float64(targetUtilization) / 100
+	requirements := instancetype.Requirements{
+		CPU:            int(math.Ceil(usage.cpuDemand() / target)),
+		Memory:         int64(math.Ceil(usage.memoryDemand() / target)),
+		MaxPodsPerNode: instancetype.DefaultMaxPodsPerNode,
+	}
+	if requirements.CPU == 0 && requirements.Memory == 0 {
+		recommendation.Reason = "The nodes have no requests and no usage"
+		return recommendation
+	}
+
+	filter := &instancetype.Filter{Architecture: string(machineType.MachineType.Architecture())}
+	if machineType.MachineType.Category() != cmv1.MachineTypeCategoryAcceleratedComputing {
+		filter.Categories = []string{
+			string(cmv1.MachineTypeCategoryComputeOptimized),
+			string(cmv1.MachineTypeCategoryGeneralPurpose),
+			string(cmv1.MachineTypeCategoryMemoryOptimized),
+		}
+	}
+	candidates := instancetype.Recommend(filter.Apply(machineTypes), requirements)
+	// The current instance type is sized even without quota for more nodes
+	currentType := &ocm.MachineType{MachineType: machineType.MachineType, Available: true}
+	current := instancetype.Recommend(ocm.MachineTypeList{Items: []*ocm.MachineType{currentType}}, requirements)
+	if len(current) == 0 {
+		recommendation.Reason = fmt.Sprintf("The size of instance type '%s' is unknown", pool.InstanceType)
+		return recommendation
+	}
+	nodes := roundUp(current[0].Nodes, pool.Step)
+
+	// Accelerated instance types are chosen for their devices, not for their CPU and memory
+	if filter.Categories != nil && len(candidates) > 0 {
+		best := candidates[0]
+		if best.MachineType.MachineType.ID() != pool.InstanceType && best.HasQuota() &&
+			best.Overhead+minOverheadGain <= current[0].Overhead {
+			bestNodes := roundUp(best.Nodes, pool.Step)
+			recommendation.NewInstanceType = best.MachineType.MachineType.ID()
+			recommendation.NewReplicas = newReplicas(pool, bestNodes)
+			recommendation.Reason = fmt.Sprintf("%d nodes of instance type '%s' fit the demand with %.0f%% "+
+				"overhead, instead of %.0f%% with %d nodes of '%s'", bestNodes, recommendation.NewInstanceType,
+				best.Overhead*100, current[0].Overhead*100, nodes, pool.InstanceType)
+			recommendation.Commands = replaceCommands(clusterKey, pool, recommendation.NewInstanceType,
+				bestNodes)
+			return recommendation
+		}
+	}
+
+	if !pool.Autoscaling {
+		if nodes == pool.Replicas {
+			recommendation.Reason = "The replicas fit the demand"
+			return recommendation
+		}
+		recommendation.NewReplicas = fmt.Sprintf("%d", nodes)
+		recommendation.Reason = fmt.Sprintf("%d nodes reach the target utilization of %d%%, the machine "+
+			"pool has %d", nodes, targetUtilization, pool.Replicas)
+		recommendation.Commands = []string{fmt.Sprintf("rosa edit machinepool --cluster=%s --replicas=%d %s",
+			clusterKey, nodes, pool.ID)}
+		return recommendation
+	}
+
+	minReplicas := pool.MinReplicas
+	maxReplicas := pool.MaxReplicas
+	switch {
+	case nodes > pool.MaxReplicas:
+		maxReplicas = nodes
+		recommendation.Reason = fmt.Sprintf("%d nodes reach the target utilization of %d%%, above the "+
+			"maximum replicas of the machine pool", nodes, targetUtilization)
+	case nodes < pool.MinReplicas:
+		minReplicas = nodes
+		recommendation.Reason = fmt.Sprintf("%d nodes reach the target utilization of %d%%, below the "+
+			"minimum replicas of the machine pool", nodes, targetUtilization)
+	default:
+		recommendation.Reason = "The autoscaling limits fit the demand"
+		return recommendation
+	}
+	recommendation.NewReplicas = formatReplicas(true, 0, minReplicas, maxReplicas)
+	recommendation.Commands = []string{fmt.Sprintf("rosa edit machinepool --cluster=%s --min-replicas=%d "+
+		"--max-replicas=%d %s", clusterKey, minReplicas, maxReplicas, pool.ID)}
+	return recommendation
+}
+
+// newReplicas returns the replicas of a replacement pool, autoscaling pools keep their maximum if it is
+// larger than the nodes needed
+func newReplicas(pool *Pool, nodes int) string {
+	if pool.Autoscaling {
+		return formatReplicas(true, 0, min(pool.MinReplicas, nodes), max(pool.MaxReplicas, nodes))
+	}
+	return fmt.Sprintf("%d", nodes)
+}
+
+// replaceCommands returns the commands that replace a pool with a copy of another instance type, as the
+// instance type of existing machine pools can't be changed. The migration moves the workloads to the new
+// pool step by step before deleting the pool, the new pool starts with the replicas of the pool and is
+// resized afterwards.
+func replaceCommands(clusterKey string, pool *Pool, instanceType string, nodes int) []string {
+	migrate := fmt.Sprintf("rosa migrate machinepool --cluster=%s --instance-type=%s", clusterKey, instanceType)
+	targetID := poolmigration.TargetID(pool.ID, instanceType)
+	if pool.Hosted && len(targetID) > poolmigration.MaxNodePoolNameLength {
+		prefix := pool.ID[:min(len(pool.ID), poolmigration.MaxNodePoolNameLength-len(replacementSuffix))]
+		targetID = strings.TrimRight(prefix, "-") + replacementSuffix
+		migrate = fmt.Sprintf("%s --name=%s", migrate, targetID)
+	}
+	commands := []string{fmt.Sprintf("%s %s", migrate, pool.ID)}
+
+	if !pool.Autoscaling {
+		if nodes != pool.Replicas {
+			commands = append(commands, fmt.Sprintf("rosa edit machinepool --cluster=%s --replicas=%d %s",
+				clusterKey, nodes, targetID))
+		}
+		return commands
+	}
+	minReplicas := min(pool.MinReplicas, nodes)
+	maxReplicas := max(pool.MaxReplicas, nodes)
+	if minReplicas != pool.MinReplicas || maxReplicas != pool.MaxReplicas {
+		commands = append(commands, fmt.Sprintf("rosa edit machinepool --cluster=%s --min-replicas=%d "+
+			"--max-replicas=%d %s", clusterKey, minReplicas, maxReplicas, targetID))
+	}
+	return commands
+}
+
+func formatReplicas(autoscaling bool, replicas int, minReplicas int, maxReplicas int) string {
+	if autoscaling {
+		return fmt.Sprintf("%d-%d", minReplicas, maxReplicas)
+	}
+	return fmt.Sprintf("%d", replicas)
+}
+
+func roundUp(value int, step int) int {
+	if step <= 1 {
+		return value
+	}
+	return (value + step - 1) / step * step
+}
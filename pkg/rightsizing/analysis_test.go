@@ -0,0 +1,164 @@
+package rightsizing
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+const gib = 1 << 30
+
+var _ = Describe("Analyze", func() {
+	machineType := func(id string, category cmv1.MachineTypeCategory, cpu int, memory int) *ocm.MachineType {
+		machineType, err := cmv1.NewMachineType().ID(id).Category(category).Architecture(cmv1.ProcessorTypeAMD64).
+			CPU(cmv1.NewValue().Value(float64(cpu)).Unit("vCPU")).
+			Memory(cmv1.NewValue().Value(float64(memory) * gib).Unit("B")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		return &ocm.MachineType{MachineType: machineType, Available: true}
+	}
+	machineTypes := ocm.MachineTypeList{Items: []*ocm.MachineType{
+		machineType("m5.xlarge", cmv1.MachineTypeCategoryGeneralPurpose, 4, 16),
+		machineType("m5.4xlarge", cmv1.MachineTypeCategoryGeneralPurpose, 16, 64),
+		machineType("r5.xlarge", cmv1.MachineTypeCategoryMemoryOptimized, 4, 32),
+	}}
+
+	// nodes returns the usage of the nodes of a machine pool, the demand spread evenly between the nodes
+	nodes := func(machinePool string, count int, cpu float64, memory float64) []*NodeUsage {
+		usage := []*NodeUsage{}
+		for i := 0; i < count; i++ {
+			usage = append(usage, &NodeUsage{
+				Node:           machinePool + string(rune('a'+i)),
+				MachinePool:    machinePool,
+				CPURequests:    cpu / float64(count),
+				MemoryRequests: memory * gib / float64(count),
+			})
+		}
+		return usage
+	}
+
+	It("Scales down an oversized machine pool", func() {
+		pools := []*Pool{{ID: "workers", InstanceType: "m5.xlarge", Replicas: 6, Step: 1}}
+		metrics := &Metrics{Nodes: nodes("workers", 6, 5, 20)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		Expect(analysis.Warnings).To(BeEmpty())
+		Expect(analysis.Recommendations).To(HaveLen(1))
+		recommendation := analysis.Recommendations[0]
+		Expect(recommendation.CPUUtilization).To(BeNumerically("~", 5.0/24, 0.001))
+		Expect(recommendation.NewInstanceType).To(BeEmpty())
+		Expect(recommendation.NewReplicas).To(Equal("2"))
+		Expect(recommendation.Commands).To(Equal([]string{
+			"rosa edit machinepool --cluster=mycluster --replicas=2 workers",
+		}))
+	})
+
+	It("Rounds the replicas of multi-AZ machine pools to the zones", func() {
+		pools := []*Pool{{ID: "workers", InstanceType: "m5.xlarge", Replicas: 9, Step: 3}}
+		metrics := &Metrics{Nodes: nodes("workers", 9, 5, 20)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		Expect(analysis.Recommendations[0].NewReplicas).To(Equal("3"))
+	})
+
+	It("Replaces a machine pool with a better fitting instance type", func() {
+		pools := []*Pool{{ID: "db", InstanceType: "m5.xlarge", Replicas: 4, Step: 1}}
+		metrics := &Metrics{Nodes: nodes("db", 4, 2, 56)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		recommendation := analysis.Recommendations[0]
+		Expect(recommendation.NewInstanceType).To(Equal("r5.xlarge"))
+		Expect(recommendation.NewReplicas).To(Equal("3"))
+		Expect(recommendation.Commands).To(Equal([]string{
+			"rosa migrate machinepool --cluster=mycluster --instance-type=r5.xlarge db",
+			"rosa edit machinepool --cluster=mycluster --replicas=3 db-r5-xlarge",
+		}))
+	})
+
+	It("Names the replacement of a hosted node pool within the length limit", func() {
+		pools := []*Pool{{ID: "analytics-db", InstanceType: "m5.xlarge", Replicas: 3, Step: 1, Hosted: true}}
+		metrics := &Metrics{Nodes: nodes("analytics-db", 3, 2, 42)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		recommendation := analysis.Recommendations[0]
+		Expect(recommendation.NewInstanceType).To(Equal("r5.xlarge"))
+		Expect(recommendation.Commands).To(Equal([]string{
+			"rosa migrate machinepool --cluster=mycluster --instance-type=r5.xlarge --name=analytics-d-new " +
+				"analytics-db",
+			"rosa edit machinepool --cluster=mycluster --replicas=2 analytics-d-new",
+		}))
+	})
+
+	It("Raises the maximum replicas of an autoscaling machine pool", func() {
+		pools := []*Pool{{ID: "batch", InstanceType: "m5.xlarge", Autoscaling: true, MinReplicas: 1,
+			MaxReplicas: 2, Step: 1}}
+		metrics := &Metrics{Nodes: nodes("batch", 2, 10, 10)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		recommendation := analysis.Recommendations[0]
+		Expect(recommendation.NewReplicas).To(Equal("1-4"))
+		Expect(recommendation.Commands).To(Equal([]string{
+			"rosa edit machinepool --cluster=mycluster --min-replicas=1 --max-replicas=4 batch",
+		}))
+	})
+
+	It("Keeps machine pools that fit the demand", func() {
+		pools := []*Pool{{ID: "workers", InstanceType: "m5.xlarge", Replicas: 2, Step: 1}}
+		metrics := &Metrics{Nodes: nodes("workers", 2, 5, 20)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		Expect(analysis.Recommendations[0].Changed()).To(BeFalse())
+		Expect(analysis.Recommendations[0].Reason).To(Equal("The replicas fit the demand"))
+	})
+
+	It("Matches the node pool labels of hosted clusters and warns about unknown pools", func() {
+		pools := []*Pool{
+			{ID: "workers", InstanceType: "m5.xlarge", Replicas: 2, Step: 1},
+			{ID: "idle", InstanceType: "m5.xlarge", Replicas: 2, Step: 1},
+		}
+		metrics := &Metrics{Nodes: append(nodes("mycluster-workers", 2, 5, 20), nodes("gone", 1, 1, 1)...)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		Expect(analysis.Recommendations).To(HaveLen(1))
+		Expect(analysis.Recommendations[0].MachinePool).To(Equal("workers"))
+		Expect(analysis.Recommendations[0].Usage.Nodes).To(Equal(2))
+		Expect(analysis.Warnings).To(Equal([]string{
+			"Metrics of nodes or machine pools that are not machine pools of the cluster are ignored: gone",
+			"There are no metrics for machine pool 'idle'",
+		}))
+	})
+
+	It("Matches the node pool labels of pools whose ID ends with the ID of another pool", func() {
+		pools := []*Pool{
+			{ID: "workers", InstanceType: "m5.xlarge", Replicas: 2, Step: 1},
+			{ID: "gpu-workers", InstanceType: "m5.xlarge", Replicas: 3, Step: 1},
+		}
+		metrics := &Metrics{Nodes: append(nodes("mycluster-gpu-workers", 3, 5, 20),
+			nodes("mycluster-workers", 2, 5, 20)...)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		Expect(analysis.Warnings).To(BeEmpty())
+		Expect(analysis.Recommendations).To(HaveLen(2))
+		Expect(analysis.Recommendations[0].MachinePool).To(Equal("workers"))
+		Expect(analysis.Recommendations[0].Usage.Nodes).To(Equal(2))
+		Expect(analysis.Recommendations[1].MachinePool).To(Equal("gpu-workers"))
+		Expect(analysis.Recommendations[1].Usage.Nodes).To(Equal(3))
+	})
+
+	It("Ignores the node pool labels of other clusters", func() {
+		pools := []*Pool{{ID: "workers", InstanceType: "m5.xlarge", Replicas: 2, Step: 1}}
+		metrics := &Metrics{Nodes: nodes("othercluster-gpu-workers", 2, 5, 20)}
+		analysis := Analyze("mycluster", "mycluster", pools, metrics, machineTypes, DefaultTargetUtilization)
+		Expect(analysis.Warnings).To(ContainElement("Metrics of nodes or machine pools that are not machine " +
+			"pools of the cluster are ignored: othercluster-gpu-workers"))
+	})
+
+	It("Reads the pools of machine pools and node pools", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").InstanceType("m5.xlarge").
+			AvailabilityZones("us-east-1a", "us-east-1b", "us-east-1c").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(3).MaxReplicas(6)).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(MachinePools([]*cmv1.MachinePool{machinePool})).To(Equal([]*Pool{{ID: "workers",
+			InstanceType: "m5.xlarge", Autoscaling: true, MinReplicas: 3, MaxReplicas: 6, Step: 3}}))
+
+		nodePool, err := cmv1.NewNodePool().ID("workers").Replicas(2).
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).Build()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(NodePools([]*cmv1.NodePool{nodePool})).To(Equal([]*Pool{{ID: "workers",
+			InstanceType: "m5.xlarge", Replicas: 2, Step: 1, Hosted: true}}))
+	})
+})
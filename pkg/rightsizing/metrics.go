@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rightsizing
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// MetricsFormat documents the metrics file, it is part of the help of the commands that read it
+const MetricsFormat = `The metrics file is a JSON object with the responses of four instant queries of the Prometheus ` +
+	`HTTP API (/api/v1/query), one per key:
+
+  cpu_requests     sum by (node) (kube_pod_container_resource_requests{resource="cpu"})
+  cpu_usage        quantile_over_time(0.95, sum by (node) (rate(container_cpu_usage_seconds_total{container!=""}[5m]))[7d:5m])
+  memory_requests  sum by (node) (kube_pod_container_resource_requests{resource="memory"})
+  memory_usage     quantile_over_time(0.95, sum by (node) (container_memory_working_set_bytes{container!=""})[7d:5m])
+
+CPU is in cores and memory in bytes. Every series has the 'node' label and the machine pool of the node in the ` +
+	`'machine_pool' label. Append the following to the queries to add it from the node labels:
+
+  * on (node) group_left (machine_pool) label_replace(max by (node, label_hive_openshift_io_machine_pool) (kube_node_labels), "machine_pool", "$1", "label_hive_openshift_io_machine_pool", "(.+)")
+
+For example:
+
+  {
+    "cpu_requests": {
+      "status": "success",
+      "data": {
+        "resultType": "vector",
+        "result": [
+          {"metric": {"node": "ip-10-0-1-10.ec2.internal", "machine_pool": "workers"}, "value": [1760000000, "3.2"]}
+        ]
+      }
+    },
+    "cpu_usage": {...},
+    "memory_requests": {...},
+    "memory_usage": {...}
+  }`
+
+const (
+	cpuRequestsKey    = "cpu_requests"
+	cpuUsageKey       = "cpu_usage"
+	memoryRequestsKey = "memory_requests"
+	memoryUsageKey    = "memory_usage"
+
+	nodeLabel        = "node"
+	machinePoolLabel = "machine_pool"
+)
+
+// Labels of the nodes of classic machine pools and of hosted control plane node pools as exported by
+// kube_node_labels, used when the series don't have the 'machine_pool' label
+var nodeMachinePoolLabels = []string{
+	"label_hive_openshift_io_machine_pool",
+	"label_hypershift_openshift_io_node_pool",
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// NodeUsage is the resources requested and used on a node
+type NodeUsage struct {
+	Node           string
+	MachinePool    string
+	CPURequests    float64
+	CPUUsage       float64
+	MemoryRequests float64
+	MemoryUsage    float64
+}
+
+// Metrics is the usage of the nodes of a cluster read from a metrics file
+type Metrics struct {
+	Nodes []*NodeUsage
+}
+
+// LoadMetrics reads a metrics file, see MetricsFormat
+func LoadMetrics(path string) (*Metrics, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetrics(data)
+}
+
+// ParseMetrics parses the contents of a metrics file, see MetricsFormat
+func ParseMetrics(data []byte) (*Metrics, error) {
+	responses := map[string]*queryResponse{}
+	err := json.Unmarshal(data, &responses)
+	if err != nil {
+		return nil, fmt.Errorf("expected a JSON object with the query responses: %v", err)
+	}
+	nodes := map[string]*NodeUsage{}
+	for _, key := range []string{cpuRequestsKey, cpuUsageKey, memoryRequestsKey, memoryUsageKey} {
+		response, ok := responses[key]
+		if !ok || response == nil {
+			return nil, fmt.Errorf("missing the response of query '%s'", key)
+		}
+		if response.Status != "success" {
+			return nil, fmt.Errorf("query '%s' has status '%s', expected 'success'", key, response.Status)
+		}
+		if response.Data.ResultType != "vector" {
+			return nil, fmt.Errorf("query '%s' has result type '%s', expected an instant query with result "+
+				"type 'vector'", key, response.Data.ResultType)
+		}
+		for i, series := range response.Data.Result {
+			node := series.Metric[nodeLabel]
+			if node == "" {
+				return nil, fmt.Errorf("series %d of query '%s' has no '%s' label", i, key, nodeLabel)
+			}
+			value, err := parseSampleValue(series.Value)
+			if err != nil {
+				return nil, fmt.Errorf("series %d of query '%s': %v", i, key, err)
+			}
+			usage, ok := nodes[node]
+			if !ok {
+				usage = &NodeUsage{Node: node}
+				nodes[node] = usage
+			}
+			if machinePool := seriesMachinePool(series.Metric); machinePool != "" {
+				usage.MachinePool = machinePool
+			}
+			switch key {
+			case cpuRequestsKey:
+				usage.CPURequests += value
+			case cpuUsageKey:
+				usage.CPUUsage += value
+			case memoryRequestsKey:
+				usage.MemoryRequests += value
+			case memoryUsageKey:
+				usage.MemoryUsage += value
+			}
+		}
+	}
+
+	metrics := &Metrics{}
+	for _, usage := range nodes {
+		metrics.Nodes = append(metrics.Nodes, usage)
+	}
+	sort.Slice(metrics.Nodes, func(i, j int) bool {
+		return metrics.Nodes[i].Node < metrics.Nodes[j].Node
+	})
+	return metrics, nil
+}
+
+func seriesMachinePool(metric map[string]string) string {
+	if machinePool := metric[machinePoolLabel]; machinePool != "" {
+		return machinePool
+	}
+	for _, label := range nodeMachinePoolLabels {
+		if machinePool := metric[label]; machinePool != "" {
+			return machinePool
+		}
+	}
+	return ""
+}
+
+// parseSampleValue parses the value of a sample, a pair of the timestamp and the value as a string
+func parseSampleValue(sample []interface{}) (float64, error) {
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("expected a value with a timestamp and a number")
+	}
+	text, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("expected the value to be a string, got '%v'", sample[1])
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s': %v", text, err)
+	}
+	return value, nil
+}
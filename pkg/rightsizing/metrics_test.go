@@ -0,0 +1,84 @@
+package rightsizing
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// vectorResponse returns the response of an instant query with one series per node
+func vectorResponse(series ...string) string {
+	result := ""
+	for i, s := range series {
+		if i > 0 {
+			result += ","
+		}
+		result += s
+	}
+	return fmt.Sprintf(`{"status": "success", "data": {"resultType": "vector", "result": [%s]}}`, result)
+}
+
+func sample(labels string, value string) string {
+	return fmt.Sprintf(`{"metric": {%s}, "value": [1760000000, "%s"]}`, labels, value)
+}
+
+func metricsFile(cpuRequests string, cpuUsage string, memoryRequests string, memoryUsage string) string {
+	return fmt.Sprintf(`{"cpu_requests": %s, "cpu_usage": %s, "memory_requests": %s, "memory_usage": %s}`,
+		cpuRequests, cpuUsage, memoryRequests, memoryUsage)
+}
+
+var _ = Describe("Metrics", func() {
+	It("Parses the usage of the nodes", func() {
+		worker := `"node": "ip-10-0-1-10", "machine_pool": "workers"`
+		hosted := `"node": "ip-10-0-1-11", "label_hypershift_openshift_io_node_pool": "mycluster-db"`
+		metrics, err := ParseMetrics([]byte(metricsFile(
+			vectorResponse(sample(worker, "1.5"), sample(hosted, "0.5")),
+			vectorResponse(sample(worker, "2")),
+			vectorResponse(sample(worker, "4294967296")),
+			vectorResponse(sample(worker, "2147483648"), sample(hosted, "1073741824")),
+		)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metrics.Nodes).To(Equal([]*NodeUsage{
+			{Node: "ip-10-0-1-10", MachinePool: "workers", CPURequests: 1.5, CPUUsage: 2,
+				MemoryRequests: 4294967296, MemoryUsage: 2147483648},
+			{Node: "ip-10-0-1-11", MachinePool: "mycluster-db", CPURequests: 0.5, MemoryUsage: 1073741824},
+		}))
+	})
+
+	It("Loads a metrics file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "usage.json")
+		empty := vectorResponse()
+		Expect(os.WriteFile(path, []byte(metricsFile(empty, empty, empty, empty)), 0600)).To(Succeed())
+		metrics, err := LoadMetrics(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metrics.Nodes).To(BeEmpty())
+	})
+
+	It("Fails when a query is missing", func() {
+		empty := vectorResponse()
+		_, err := ParseMetrics([]byte(fmt.Sprintf(`{"cpu_requests": %s, "cpu_usage": %s}`, empty, empty)))
+		Expect(err).To(MatchError("missing the response of query 'memory_requests'"))
+	})
+
+	It("Fails for range queries", func() {
+		matrix := `{"status": "success", "data": {"resultType": "matrix", "result": []}}`
+		empty := vectorResponse()
+		_, err := ParseMetrics([]byte(metricsFile(matrix, empty, empty, empty)))
+		Expect(err).To(MatchError(ContainSubstring("query 'cpu_requests' has result type 'matrix'")))
+	})
+
+	It("Fails for series without a node", func() {
+		empty := vectorResponse()
+		_, err := ParseMetrics([]byte(metricsFile(empty, vectorResponse(sample(`"pod": "a"`, "1")), empty, empty)))
+		Expect(err).To(MatchError("series 0 of query 'cpu_usage' has no 'node' label"))
+	})
+
+	It("Fails for invalid values", func() {
+		empty := vectorResponse()
+		_, err := ParseMetrics([]byte(metricsFile(empty, empty, vectorResponse(sample(`"node": "a"`, "x")), empty)))
+		Expect(err).To(MatchError(ContainSubstring("series 0 of query 'memory_requests': invalid value 'x'")))
+	})
+})
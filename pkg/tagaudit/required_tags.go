@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tagaudit
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/rosa/pkg/aws"
+)
+
+// ParseRequiredTags parses a list of required tags. Each entry is either a bare key, meaning that any
+// value is accepted, or a key and value separated by ':' or by a space.
+func ParseRequiredTags(values []string) (map[string]string, error) {
+	requiredTags := map[string]string{}
+	if len(values) == 0 {
+		return requiredTags, nil
+	}
+	delimiter := aws.GetTagsDelimiter(values)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		parts := strings.SplitN(value, delimiter, 2)
+		key := strings.TrimSpace(parts[0])
+		if key == "" {
+			return nil, fmt.Errorf("invalid required tag '%s', the tag key can't be empty", value)
+		}
+		if _, found := requiredTags[key]; found {
+			return nil, fmt.Errorf("invalid required tags, duplicate key '%s' found", key)
+		}
+		requiredTags[key] = ""
+		if len(parts) == 2 {
+			requiredTags[key] = strings.TrimSpace(parts[1])
+		}
+	}
+	return requiredTags, nil
+}
+
+// ReadRequiredTagsFile reads the required tags from a YAML or JSON file mapping each tag key to its
+// expected value. An empty value means that any value is accepted, for example:
+//
+//	cost-center: ""
+//	owner: platform-team
+func ReadRequiredTagsFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read required tags file '%s': %v", path, err)
+	}
+	requiredTags := map[string]string{}
+	err = yaml.Unmarshal(content, &requiredTags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse required tags file '%s': %v", path, err)
+	}
+	for key := range requiredTags {
+		if strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("invalid required tags file '%s', the tag key can't be empty", path)
+		}
+	}
+	return requiredTags, nil
+}
+
+// MergeRequiredTags merges several sets of required tags, failing if the same key is required with
+// different values
+func MergeRequiredTags(sets ...map[string]string) (map[string]string, error) {
+	merged := map[string]string{}
+	for _, set := range sets {
+		for key, value := range set {
+			if existing, found := merged[key]; found && existing != value {
+				return nil, fmt.Errorf("tag '%s' is required with conflicting values '%s' and '%s'",
+					key, existing, value)
+			}
+			merged[key] = value
+		}
+	}
+	return merged, nil
+}
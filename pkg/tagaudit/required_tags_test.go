@@ -0,0 +1,47 @@
+package tagaudit
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Required tags", func() {
+	Context("ParseRequiredTags", func() {
+		It("Accepts bare keys and key value pairs", func() {
+			requiredTags, err := ParseRequiredTags([]string{"cost-center", "owner:platform"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requiredTags).To(Equal(map[string]string{"cost-center": "", "owner": "platform"}))
+
+			requiredTags, err = ParseRequiredTags([]string{"owner platform"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requiredTags).To(Equal(map[string]string{"owner": "platform"}))
+		})
+		It("Rejects duplicate keys", func() {
+			_, err := ParseRequiredTags([]string{"owner:a", "owner:b"})
+			Expect(err).To(MatchError(ContainSubstring("duplicate key 'owner'")))
+		})
+	})
+
+	Context("ReadRequiredTagsFile", func() {
+		It("Reads a map of keys to expected values", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "tags.yaml")
+			Expect(os.WriteFile(path, []byte("cost-center: \"\"\nowner: platform\n"), 0600)).To(Succeed())
+			requiredTags, err := ReadRequiredTagsFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requiredTags).To(Equal(map[string]string{"cost-center": "", "owner": "platform"}))
+		})
+	})
+
+	Context("MergeRequiredTags", func() {
+		It("Fails on conflicting values", func() {
+			_, err := MergeRequiredTags(map[string]string{"owner": "a"}, map[string]string{"owner": "b"})
+			Expect(err).To(HaveOccurred())
+			merged, err := MergeRequiredTags(map[string]string{"owner": "a"}, map[string]string{"env": ""})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(HaveLen(2))
+		})
+	})
+})
@@ -0,0 +1,379 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tagaudit
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+type ResourceType string
+
+const (
+	AccountRole  ResourceType = "account-role"
+	OperatorRole ResourceType = "operator-role"
+	Policy       ResourceType = "policy"
+	OidcProvider ResourceType = "oidc-provider"
+	Subnet       ResourceType = "subnet"
+
+	// subnetRoleValue is the value expected on the load balancer role tags of a subnet
+	subnetRoleValue = "1"
+)
+
+var operatorRoleRE = regexp.MustCompile(`(?i)[\w+=,.@-]+-(openshift|kube-system)-`)
+
+// Finding describes a required tag that is missing from a resource or has an unexpected value
+type Finding struct {
+	ResourceType ResourceType
+	Resource     string
+	Key          string
+	// Expected is empty when any value is accepted
+	Expected string
+	Actual   string
+	Missing  bool
+}
+
+// Fixable returns true when the value the tag should have is known
+func (f Finding) Fixable() bool {
+	return f.Expected != ""
+}
+
+// Fix holds the tags that need to be applied to a single resource
+type Fix struct {
+	ResourceType ResourceType
+	Resource     string
+	Tags         map[string]string
+}
+
+// Result holds the findings of an audit and the number of resources that were checked
+type Result struct {
+	Findings []Finding
+	Checked  map[ResourceType]int
+}
+
+// IsCompliant returns true when every checked resource has all the required tags
+func (r *Result) IsCompliant() bool {
+	return len(r.Findings) == 0
+}
+
+// Fixes groups the fixable findings by resource, keeping the order in which resources were audited
+func (r *Result) Fixes() []Fix {
+	fixes := []Fix{}
+	index := map[string]int{}
+	for _, finding := range r.Findings {
+		if !finding.Fixable() {
+			continue
+		}
+		id := fmt.Sprintf("%s/%s", finding.ResourceType, finding.Resource)
+		i, ok := index[id]
+		if !ok {
+			i = len(fixes)
+			index[id] = i
+			fixes = append(fixes, Fix{
+				ResourceType: finding.ResourceType,
+				Resource:     finding.Resource,
+				Tags:         map[string]string{},
+			})
+		}
+		fixes[i].Tags[finding.Key] = finding.Expected
+	}
+	return fixes
+}
+
+// Unfixable returns the findings whose expected value is unknown and need to be fixed by hand
+func (r *Result) Unfixable() []Finding {
+	findings := []Finding{}
+	for _, finding := range r.Findings {
+		if !finding.Fixable() {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// Auditor checks ROSA account roles, operator roles, their customer managed policies, OIDC providers
+// and VPC subnets for the tags ROSA relies on, plus any tags required by the organisation
+type Auditor struct {
+	awsClient    aws.Client
+	ocmClient    *ocm.Client
+	accountID    string
+	requiredTags map[string]string
+	subnetIDs    []string
+}
+
+// NewAuditor creates an Auditor. Required tags with an empty value only need to be present. Subnets are
+// only audited when subnet IDs are given.
+func NewAuditor(awsClient aws.Client, ocmClient *ocm.Client, accountID string,
+	requiredTags map[string]string, subnetIDs []string) *Auditor {
+	return &Auditor{
+		awsClient:    awsClient,
+		ocmClient:    ocmClient,
+		accountID:    accountID,
+		requiredTags: requiredTags,
+		subnetIDs:    subnetIDs,
+	}
+}
+
+// Audit checks every supported kind of resource
+func (a *Auditor) Audit() (*Result, error) {
+	result := &Result{
+		Findings: []Finding{},
+		Checked:  map[ResourceType]int{},
+	}
+	policyArns, err := a.auditRoles(result)
+	if err != nil {
+		return nil, err
+	}
+	err = a.auditPolicies(result, policyArns)
+	if err != nil {
+		return nil, err
+	}
+	err = a.auditOidcProviders(result)
+	if err != nil {
+		return nil, err
+	}
+	err = a.auditSubnets(result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Apply adds the tags of the fix to its resource
+func (a *Auditor) Apply(fix Fix) error {
+	switch fix.ResourceType {
+	case AccountRole, OperatorRole:
+		for _, key := range sortedKeys(fix.Tags) {
+			err := a.awsClient.AddRoleTag(fix.Resource, key, fix.Tags[key])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case Policy:
+		return a.awsClient.AddPolicyTags(fix.Resource, fix.Tags)
+	case OidcProvider:
+		return a.awsClient.AddOpenIDConnectProviderTags(fix.Resource, fix.Tags)
+	case Subnet:
+		return a.awsClient.AddSubnetTags(fix.Resource, fix.Tags)
+	}
+	return fmt.Errorf("unsupported resource type '%s'", fix.ResourceType)
+}
+
+// auditRoles checks the account and operator roles and returns the customer managed policies attached to them
+func (a *Auditor) auditRoles(result *Result) ([]string, error) {
+	roles, err := a.awsClient.ListRoles()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %v", err)
+	}
+
+	policyArns := []string{}
+	seenPolicies := map[string]bool{}
+	for _, role := range roles {
+		roleName := awssdk.ToString(role.RoleName)
+		var resourceType ResourceType
+		var required map[string]string
+		if prefix, roleType, ok := ParseAccountRoleName(roleName); ok {
+			resourceType = AccountRole
+			required = map[string]string{
+				tags.RedHatManaged: tags.True,
+				tags.RolePrefix:    prefix,
+				tags.RoleType:      roleType,
+			}
+		} else if operatorRoleRE.MatchString(roleName) {
+			resourceType = OperatorRole
+			required = map[string]string{
+				tags.RedHatManaged:     tags.True,
+				tags.OperatorNamespace: "",
+				tags.OperatorName:      "",
+			}
+		} else {
+			continue
+		}
+
+		roleTags, err := a.awsClient.GetRoleTags(roleName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags of role '%s': %v", roleName, err)
+		}
+		a.check(result, resourceType, roleName, roleTags, required)
+
+		attachedPolicies, err := a.awsClient.ListAttachedRolePolicies(roleName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list policies attached to role '%s': %v", roleName, err)
+		}
+		for _, policyArn := range attachedPolicies {
+			if seenPolicies[policyArn] || isAWSManagedPolicy(policyArn) {
+				continue
+			}
+			seenPolicies[policyArn] = true
+			policyArns = append(policyArns, policyArn)
+		}
+	}
+	return policyArns, nil
+}
+
+func (a *Auditor) auditPolicies(result *Result, policyArns []string) error {
+	for _, policyArn := range policyArns {
+		policyTags, err := a.awsClient.GetPolicyTags(policyArn)
+		if err != nil {
+			return fmt.Errorf("failed to get tags of policy '%s': %v", policyArn, err)
+		}
+		a.check(result, Policy, policyArn, policyTags, map[string]string{
+			tags.RedHatManaged: tags.True,
+		})
+	}
+	return nil
+}
+
+// auditOidcProviders checks the Red Hat managed OIDC providers as well as the providers of the OIDC
+// configurations registered for the account, which might have lost their tags
+func (a *Auditor) auditOidcProviders(result *Result) error {
+	providers, err := a.awsClient.ListOidcProviders("", nil)
+	if err != nil {
+		return fmt.Errorf("failed to list OIDC providers: %v", err)
+	}
+	providerArns := []string{}
+	seen := map[string]bool{}
+	for _, provider := range providers {
+		if !seen[provider.Arn] {
+			seen[provider.Arn] = true
+			providerArns = append(providerArns, provider.Arn)
+		}
+	}
+
+	configs, err := a.ocmClient.ListOidcConfigs(a.accountID)
+	if err != nil {
+		return fmt.Errorf("failed to list OIDC configurations: %v", err)
+	}
+	for _, config := range configs {
+		providerArn, err := a.awsClient.GetOpenIDConnectProviderByOidcEndpointUrl(config.IssuerUrl())
+		if err != nil {
+			return fmt.Errorf("failed to find OIDC provider for '%s': %v", config.IssuerUrl(), err)
+		}
+		if providerArn != "" && !seen[providerArn] {
+			seen[providerArn] = true
+			providerArns = append(providerArns, providerArn)
+		}
+	}
+
+	for _, providerArn := range providerArns {
+		providerTags, err := a.awsClient.GetOpenIDConnectProviderTags(providerArn)
+		if err != nil {
+			return fmt.Errorf("failed to get tags of OIDC provider '%s': %v", providerArn, err)
+		}
+		a.check(result, OidcProvider, providerArn, providerTags, map[string]string{
+			tags.RedHatManaged: tags.True,
+		})
+	}
+	return nil
+}
+
+func (a *Auditor) auditSubnets(result *Result) error {
+	if len(a.subnetIDs) == 0 {
+		return nil
+	}
+	subnets, err := a.awsClient.ListSubnets(a.subnetIDs...)
+	if err != nil {
+		return fmt.Errorf("failed to list subnets: %v", err)
+	}
+	publicSubnets, err := a.awsClient.FetchPublicSubnetMap(subnets)
+	if err != nil {
+		return fmt.Errorf("failed to determine which subnets are public: %v", err)
+	}
+	for _, subnet := range subnets {
+		subnetID := awssdk.ToString(subnet.SubnetId)
+		subnetTags := map[string]string{}
+		for _, tag := range subnet.Tags {
+			subnetTags[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
+		}
+		roleTag := tags.InternalElbRole
+		if publicSubnets[subnetID] {
+			roleTag = tags.ElbRole
+		}
+		a.check(result, Subnet, subnetID, subnetTags, map[string]string{
+			roleTag: subnetRoleValue,
+		})
+	}
+	return nil
+}
+
+// check compares the tags of a resource with the required ROSA tags and the organisation tags.
+// ROSA tags take precedence when both define the same key.
+func (a *Auditor) check(result *Result, resourceType ResourceType, resource string,
+	resourceTags map[string]string, required map[string]string) {
+	expected := map[string]string{}
+	for key, value := range a.requiredTags {
+		expected[key] = value
+	}
+	for key, value := range required {
+		expected[key] = value
+	}
+	result.Checked[resourceType]++
+	for _, key := range sortedKeys(expected) {
+		actual, found := resourceTags[key]
+		if found && (expected[key] == "" || actual == expected[key]) {
+			continue
+		}
+		result.Findings = append(result.Findings, Finding{
+			ResourceType: resourceType,
+			Resource:     resource,
+			Key:          key,
+			Expected:     expected[key],
+			Actual:       actual,
+			Missing:      !found,
+		})
+	}
+}
+
+// ParseAccountRoleName returns the prefix and role type of an account role based on its name
+func ParseAccountRoleName(roleName string) (string, string, bool) {
+	for _, accountRoles := range []map[string]aws.AccountRole{aws.HCPAccountRoles, aws.AccountRoles} {
+		for roleType, accountRole := range accountRoles {
+			suffix := fmt.Sprintf("-%s-Role", accountRole.Name)
+			if strings.HasSuffix(roleName, suffix) && len(roleName) > len(suffix) {
+				return strings.TrimSuffix(roleName, suffix), roleType, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func isAWSManagedPolicy(policyArn string) bool {
+	parsedArn, err := arn.Parse(policyArn)
+	if err != nil {
+		return false
+	}
+	return parsedArn.AccountID == "aws"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
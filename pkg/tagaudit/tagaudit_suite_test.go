@@ -0,0 +1,13 @@
+package tagaudit
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTagAudit(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tag Audit Suite")
+}
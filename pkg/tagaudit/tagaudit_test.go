@@ -0,0 +1,176 @@
+package tagaudit
+
+import (
+	"net/http"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Tag audit", func() {
+	var (
+		t         *TestingRuntime
+		awsClient *aws.MockClient
+	)
+
+	const (
+		installerRole = "foo-HCP-ROSA-Installer-Role"
+		operatorRole  = "foo-openshift-ingress-operator-cloud-credentials"
+		policyArn     = "arn:aws:iam::123:policy/foo-openshift-ingress-operator-cloud-credentials"
+		managedArn    = "arn:aws:iam::aws:policy/service-role/ROSAIngressOperatorPolicy"
+		providerArn   = "arn:aws:iam::123:oidc-provider/oidc.example.com/abc"
+	)
+
+	BeforeEach(func() {
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+	})
+
+	noOidcConfigs := func() {
+		t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+			FormatList([]*cmv1.OidcConfig{}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+	}
+
+	Context("ParseAccountRoleName", func() {
+		It("Derives the prefix and type of classic and HCP account roles", func() {
+			prefix, roleType, ok := ParseAccountRoleName("ManagedOpenShift-ControlPlane-Role")
+			Expect(ok).To(BeTrue())
+			Expect(prefix).To(Equal("ManagedOpenShift"))
+			Expect(roleType).To(Equal(aws.ControlPlaneAccountRole))
+
+			prefix, roleType, ok = ParseAccountRoleName(installerRole)
+			Expect(ok).To(BeTrue())
+			Expect(prefix).To(Equal("foo"))
+			Expect(roleType).To(Equal(aws.InstallerAccountRole))
+		})
+		It("Ignores other roles", func() {
+			_, _, ok := ParseAccountRoleName(operatorRole)
+			Expect(ok).To(BeFalse())
+			_, _, ok = ParseAccountRoleName("ManagedOpenShift-OCM-Role-123")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("Audit", func() {
+		It("Reports missing and incorrect tags and groups the fixable ones by resource", func() {
+			awsClient.EXPECT().ListRoles().Return([]iamtypes.Role{
+				{RoleName: awssdk.String(installerRole)},
+				{RoleName: awssdk.String(operatorRole)},
+				{RoleName: awssdk.String("unrelated")},
+			}, nil)
+			awsClient.EXPECT().GetRoleTags(installerRole).Return(map[string]string{
+				tags.RedHatManaged: tags.True,
+				tags.RolePrefix:    "bar",
+				tags.RoleType:      aws.InstallerAccountRole,
+				"cost-center":      "42",
+			}, nil)
+			awsClient.EXPECT().ListAttachedRolePolicies(installerRole).Return([]string{}, nil)
+			awsClient.EXPECT().GetRoleTags(operatorRole).Return(map[string]string{
+				tags.OperatorNamespace: "openshift-ingress-operator",
+			}, nil)
+			awsClient.EXPECT().ListAttachedRolePolicies(operatorRole).Return([]string{policyArn, managedArn}, nil)
+			awsClient.EXPECT().GetPolicyTags(policyArn).Return(map[string]string{
+				tags.RedHatManaged: tags.True,
+				"cost-center":      "42",
+			}, nil)
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+			config, err := cmv1.NewOidcConfig().ID("abc").IssuerUrl("https://oidc.example.com/abc").Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				FormatList([]*cmv1.OidcConfig{config}, cmv1.MarshalOidcConfigList, "OidcConfigList")))
+			awsClient.EXPECT().GetOpenIDConnectProviderByOidcEndpointUrl("https://oidc.example.com/abc").
+				Return(providerArn, nil)
+			awsClient.EXPECT().GetOpenIDConnectProviderTags(providerArn).Return(map[string]string{
+				"cost-center": "42",
+			}, nil)
+
+			auditor := NewAuditor(awsClient, t.RosaRuntime.OCMClient, "123",
+				map[string]string{"cost-center": ""}, nil)
+			result, err := auditor.Audit()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.IsCompliant()).To(BeFalse())
+			Expect(result.Checked).To(Equal(map[ResourceType]int{
+				AccountRole:  1,
+				OperatorRole: 1,
+				Policy:       1,
+				OidcProvider: 1,
+			}))
+			Expect(result.Findings).To(ConsistOf(
+				Finding{ResourceType: AccountRole, Resource: installerRole, Key: tags.RolePrefix,
+					Expected: "foo", Actual: "bar"},
+				Finding{ResourceType: OperatorRole, Resource: operatorRole, Key: "cost-center", Missing: true},
+				Finding{ResourceType: OperatorRole, Resource: operatorRole, Key: tags.OperatorName, Missing: true},
+				Finding{ResourceType: OperatorRole, Resource: operatorRole, Key: tags.RedHatManaged,
+					Expected: tags.True, Missing: true},
+				Finding{ResourceType: OidcProvider, Resource: providerArn, Key: tags.RedHatManaged,
+					Expected: tags.True, Missing: true},
+			))
+			Expect(result.Fixes()).To(Equal([]Fix{
+				{ResourceType: AccountRole, Resource: installerRole, Tags: map[string]string{tags.RolePrefix: "foo"}},
+				{ResourceType: OperatorRole, Resource: operatorRole, Tags: map[string]string{tags.RedHatManaged: tags.True}},
+				{ResourceType: OidcProvider, Resource: providerArn, Tags: map[string]string{tags.RedHatManaged: tags.True}},
+			}))
+			Expect(result.Unfixable()).To(HaveLen(2))
+		})
+
+		It("Expects the load balancer role tag matching the subnet visibility", func() {
+			awsClient.EXPECT().ListRoles().Return([]iamtypes.Role{}, nil)
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{}, nil)
+			noOidcConfigs()
+			awsClient.EXPECT().ListSubnets("subnet-public", "subnet-private").Return([]ec2types.Subnet{
+				{SubnetId: awssdk.String("subnet-public")},
+				{
+					SubnetId: awssdk.String("subnet-private"),
+					Tags:     []ec2types.Tag{{Key: awssdk.String(tags.InternalElbRole), Value: awssdk.String("1")}},
+				},
+			}, nil)
+			awsClient.EXPECT().FetchPublicSubnetMap(gomock.Any()).Return(map[string]bool{
+				"subnet-public":  true,
+				"subnet-private": false,
+			}, nil)
+
+			auditor := NewAuditor(awsClient, t.RosaRuntime.OCMClient, "123", map[string]string{},
+				[]string{"subnet-public", "subnet-private"})
+			result, err := auditor.Audit()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Checked[Subnet]).To(Equal(2))
+			Expect(result.Findings).To(Equal([]Finding{
+				{ResourceType: Subnet, Resource: "subnet-public", Key: tags.ElbRole, Expected: "1", Missing: true},
+			}))
+		})
+	})
+
+	Context("Apply", func() {
+		It("Tags each kind of resource with the matching call", func() {
+			auditor := NewAuditor(awsClient, t.RosaRuntime.OCMClient, "123", nil, nil)
+			gomock.InOrder(
+				awsClient.EXPECT().AddRoleTag(installerRole, "a", "1").Return(nil),
+				awsClient.EXPECT().AddRoleTag(installerRole, "b", "2").Return(nil),
+			)
+			Expect(auditor.Apply(Fix{ResourceType: AccountRole, Resource: installerRole,
+				Tags: map[string]string{"b": "2", "a": "1"}})).To(Succeed())
+
+			awsClient.EXPECT().AddPolicyTags(policyArn, map[string]string{"a": "1"}).Return(nil)
+			Expect(auditor.Apply(Fix{ResourceType: Policy, Resource: policyArn,
+				Tags: map[string]string{"a": "1"}})).To(Succeed())
+
+			awsClient.EXPECT().AddOpenIDConnectProviderTags(providerArn, map[string]string{"a": "1"}).Return(nil)
+			Expect(auditor.Apply(Fix{ResourceType: OidcProvider, Resource: providerArn,
+				Tags: map[string]string{"a": "1"}})).To(Succeed())
+
+			awsClient.EXPECT().AddSubnetTags("subnet-1", map[string]string{"a": "1"}).Return(nil)
+			Expect(auditor.Apply(Fix{ResourceType: Subnet, Resource: "subnet-1",
+				Tags: map[string]string{"a": "1"}})).To(Succeed())
+		})
+	})
+})
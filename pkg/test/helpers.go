@@ -248,6 +248,14 @@ func FormatHtpasswdUserList(htpasswdUsers []*v1.HTPasswdUser) string {
 	return FormatList(htpasswdUsers, v1.MarshalHTPasswdUserList, "HTPasswdUserList")
 }
 
+func FormatGroupList(groups []*v1.Group) string {
+	return FormatList(groups, v1.MarshalGroupList, "GroupList")
+}
+
+func FormatUserList(users []*v1.User) string {
+	return FormatList(users, v1.MarshalUserList, "UserList")
+}
+
 func FormatExternalAuthList(externalAuths []*v1.ExternalAuth) string {
 	return FormatList(externalAuths, v1.MarshalExternalAuthList, "ExternalAuthList")
 }
@@ -0,0 +1,290 @@
+/*
+Copyright (c) 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tuningconfig
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	profileField   = "profile"
+	recommendField = "recommend"
+
+	nodeMatchType = "node"
+	podMatchType  = "pod"
+)
+
+var (
+	specFields      = []string{profileField, recommendField}
+	profileFields   = []string{"name", "data"}
+	recommendFields = []string{"profile", "priority", "match", "machineConfigLabels", "operand"}
+	matchFields     = []string{"label", "value", "type", "match"}
+	matchTypes      = []string{nodeMatchType, podMatchType}
+
+	// Profiles shipped with the Node Tuning Operator that recommendations can refer to without defining them
+	builtinProfiles = []string{"openshift", "openshift-node", "openshift-control-plane"}
+)
+
+// SpecError is the problems found in the spec of a tuning config
+type SpecError struct {
+	Problems []string
+}
+
+func (e *SpecError) Error() string {
+	return strings.Join(e.Problems, "; ")
+}
+
+type specValidator struct {
+	problems []string
+}
+
+func (v *specValidator) addf(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+// ValidateSpec validates the Tuned spec of a tuning config, the 'profile' and 'recommend' sections of a
+// Tuned object of the Node Tuning Operator. It checks the structure of the spec, the syntax of the TuneD
+// profiles, the labels matched by the recommendations, their priorities and the profiles they refer to.
+// It returns a *SpecError with all the problems found.
+func ValidateSpec(spec map[string]interface{}) error {
+	v := &specValidator{}
+	v.unknownFields("spec", spec, specFields)
+
+	profiles := []string{}
+	profileList, ok := v.list(profileField, spec[profileField])
+	if ok && len(profileList) == 0 {
+		v.addf("%s: expected at least one profile", profileField)
+	}
+	for i, item := range profileList {
+		path := fmt.Sprintf("%s[%d]", profileField, i)
+		profile, ok := v.object(path, item)
+		if !ok {
+			continue
+		}
+		v.unknownFields(path, profile, profileFields)
+		name, ok := v.string(path+".name", profile["name"], true)
+		if ok {
+			if slices.Contains(profiles, name) {
+				v.addf("%s.name: duplicate profile name '%s'", path, name)
+			}
+			profiles = append(profiles, name)
+		}
+		data, ok := v.string(path+".data", profile["data"], true)
+		if ok {
+			for _, problem := range validateProfileData(data) {
+				v.addf("%s.data: %s", path, problem)
+			}
+		}
+	}
+
+	recommendList, ok := v.list(recommendField, spec[recommendField])
+	if ok && len(recommendList) == 0 {
+		v.addf("%s: expected at least one recommendation", recommendField)
+	}
+	for i, item := range recommendList {
+		path := fmt.Sprintf("%s[%d]", recommendField, i)
+		recommend, ok := v.object(path, item)
+		if !ok {
+			continue
+		}
+		v.unknownFields(path, recommend, recommendFields)
+		profile, ok := v.string(path+".profile", recommend["profile"], true)
+		if ok && !slices.Contains(profiles, profile) && !slices.Contains(builtinProfiles, profile) {
+			v.addf("%s.profile: profile '%s' is not defined in '%s'", path, profile, profileField)
+		}
+		v.priority(path+".priority", recommend["priority"])
+		if match, ok := recommend["match"]; ok {
+			v.match(path+".match", match)
+		}
+		if labels, ok := recommend["machineConfigLabels"]; ok {
+			v.machineConfigLabels(path+".machineConfigLabels", labels)
+		}
+		if operand, ok := recommend["operand"]; ok {
+			v.object(path+".operand", operand)
+		}
+	}
+
+	if len(v.problems) > 0 {
+		return &SpecError{Problems: v.problems}
+	}
+	return nil
+}
+
+func (v *specValidator) unknownFields(path string, object map[string]interface{}, fields []string) {
+	keys := []string{}
+	for key := range object {
+		if !slices.Contains(fields, key) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		v.addf("%s: unknown field '%s', expected one of '%s'", path, key, strings.Join(fields, "', '"))
+	}
+}
+
+func (v *specValidator) list(path string, value interface{}) ([]interface{}, bool) {
+	if value == nil {
+		v.addf("%s: required field is missing", path)
+		return nil, false
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		v.addf("%s: expected a list", path)
+		return nil, false
+	}
+	return list, true
+}
+
+func (v *specValidator) object(path string, value interface{}) (map[string]interface{}, bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		v.addf("%s: expected an object", path)
+		return nil, false
+	}
+	return object, true
+}
+
+func (v *specValidator) string(path string, value interface{}, required bool) (string, bool) {
+	if value == nil {
+		if required {
+			v.addf("%s: required field is missing", path)
+		}
+		return "", false
+	}
+	text, ok := value.(string)
+	if !ok {
+		v.addf("%s: expected a string", path)
+		return "", false
+	}
+	if required && strings.TrimSpace(text) == "" {
+		v.addf("%s: expected a non-empty string", path)
+		return "", false
+	}
+	return text, true
+}
+
+// priority validates the priority of a recommendation, a non-negative integer where lower values take
+// precedence
+func (v *specValidator) priority(path string, value interface{}) {
+	if value == nil {
+		v.addf("%s: required field is missing", path)
+		return
+	}
+	number, ok := value.(float64)
+	if !ok || number != math.Trunc(number) {
+		v.addf("%s: expected an integer", path)
+		return
+	}
+	if number < 0 {
+		v.addf("%s: expected a priority of 0 or more, got %.0f", path, number)
+	}
+}
+
+func (v *specValidator) match(path string, value interface{}) {
+	list, ok := v.list(path, value)
+	if !ok {
+		return
+	}
+	for i, item := range list {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		match, ok := v.object(itemPath, item)
+		if !ok {
+			continue
+		}
+		v.unknownFields(itemPath, match, matchFields)
+		label, ok := v.string(itemPath+".label", match["label"], true)
+		if ok {
+			if errs := validation.IsQualifiedName(label); len(errs) != 0 {
+				v.addf("%s.label: invalid label '%s': %s", itemPath, label, strings.Join(errs, "; "))
+			}
+		}
+		labelValue, ok := v.string(itemPath+".value", match["value"], false)
+		if ok {
+			if errs := validation.IsValidLabelValue(labelValue); len(errs) != 0 {
+				v.addf("%s.value: invalid label value '%s': %s", itemPath, labelValue, strings.Join(errs, "; "))
+			}
+		}
+		matchType, ok := v.string(itemPath+".type", match["type"], false)
+		if ok && !slices.Contains(matchTypes, matchType) {
+			v.addf("%s.type: expected one of '%s', got '%s'", itemPath, strings.Join(matchTypes, "', '"),
+				matchType)
+		}
+		if nested, ok := match["match"]; ok {
+			v.match(itemPath+".match", nested)
+		}
+	}
+}
+
+func (v *specValidator) machineConfigLabels(path string, value interface{}) {
+	labels, ok := v.object(path, value)
+	if !ok {
+		return
+	}
+	keys := []string{}
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			v.addf("%s: invalid label '%s': %s", path, key, strings.Join(errs, "; "))
+		}
+		if _, ok := labels[key].(string); !ok {
+			v.addf("%s.%s: expected a string", path, key)
+		}
+	}
+}
+
+// validateProfileData validates the syntax of a TuneD profile, an INI file with sections of key=value pairs
+func validateProfileData(data string) []string {
+	problems := []string{}
+	section := ""
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || strings.TrimSpace(line[1:len(line)-1]) == "" {
+				problems = append(problems, fmt.Sprintf("line %d: invalid section header '%s'", i+1, line))
+				continue
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			continue
+		}
+		key, _, found := strings.Cut(line, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			problems = append(problems, fmt.Sprintf("line %d: expected a section header, a key=value pair "+
+				"or a comment, got '%s'", i+1, line))
+			continue
+		}
+		if section == "" {
+			problems = append(problems, fmt.Sprintf("line %d: key '%s' is outside of a section", i+1,
+				strings.TrimSpace(key)))
+		}
+	}
+	if section == "" && len(problems) == 0 {
+		problems = append(problems, "expected at least one section, for example '[main]'")
+	}
+	return problems
+}
@@ -0,0 +1,120 @@
+package tuningconfig
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+)
+
+func parseSpec(data string) map[string]interface{} {
+	spec := map[string]interface{}{}
+	Expect(yaml.Unmarshal([]byte(data), &spec)).To(Succeed())
+	return spec
+}
+
+func specProblems(data string) []string {
+	err := ValidateSpec(parseSpec(data))
+	if err == nil {
+		return nil
+	}
+	specErr, ok := err.(*SpecError)
+	Expect(ok).To(BeTrue())
+	return specErr.Problems
+}
+
+var _ = Describe("ValidateSpec", func() {
+	It("Accepts a valid spec", func() {
+		Expect(specProblems(`
+profile:
+- data: |-
+    # Custom profile
+    [main]
+    summary=Custom OpenShift profile
+    include=openshift-node
+    [sysctl]
+    vm.dirty_ratio="55"
+  name: tuned-1-profile
+recommend:
+- priority: 20
+  profile: tuned-1-profile
+  match:
+  - label: node-role.kubernetes.io/worker
+    type: node
+    match:
+    - label: tuned.openshift.io/elasticsearch
+      value: ""
+      type: pod
+- priority: 30
+  profile: openshift-node
+`)).To(BeEmpty())
+	})
+
+	It("Reports the structure problems", func() {
+		Expect(specProblems(`
+profiles: []
+profile:
+- name: tuned-1-profile
+recommend: {}
+`)).To(Equal([]string{
+			"spec: unknown field 'profiles', expected one of 'profile', 'recommend'",
+			"profile[0].data: required field is missing",
+			"recommend: expected a list",
+		}))
+	})
+
+	It("Reports the syntax problems of the profile data", func() {
+		Expect(specProblems(`
+profile:
+- name: tuned-1-profile
+  data: |-
+    summary=Custom OpenShift profile
+    [main
+    [sysctl]
+    vm.dirty_ratio
+recommend:
+- priority: 20
+  profile: tuned-1-profile
+`)).To(Equal([]string{
+			"profile[0].data: line 1: key 'summary' is outside of a section",
+			"profile[0].data: line 2: invalid section header '[main'",
+			"profile[0].data: line 4: expected a section header, a key=value pair or a comment, got 'vm.dirty_ratio'",
+		}))
+	})
+
+	It("Reports the problems of the recommendations", func() {
+		Expect(specProblems(`
+profile:
+- name: tuned-1-profile
+  data: "[main]"
+- name: tuned-1-profile
+  data: "[main]"
+recommend:
+- priority: -1
+  profile: tuned-2-profile
+  match:
+  - label: "invalid label!"
+    type: machine
+- priority: 1.5
+  profile: tuned-1-profile
+  match:
+  - value: "true"
+`)).To(Equal([]string{
+			"profile[1].name: duplicate profile name 'tuned-1-profile'",
+			"recommend[0].profile: profile 'tuned-2-profile' is not defined in 'profile'",
+			"recommend[0].priority: expected a priority of 0 or more, got -1",
+			"recommend[0].match[0].label: invalid label 'invalid label!': name part must consist of " +
+				"alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric " +
+				"character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is " +
+				"'([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')",
+			"recommend[0].match[0].type: expected one of 'node', 'pod', got 'machine'",
+			"recommend[1].priority: expected an integer",
+			"recommend[1].match[0].label: required field is missing",
+		}))
+	})
+
+	It("Joins the problems in the error", func() {
+		err := ValidateSpec(parseSpec("profile: []\nrecommend: []\n"))
+		Expect(err).To(MatchError("profile: expected at least one profile; " +
+			"recommend: expected at least one recommendation"))
+	})
+})
@@ -0,0 +1,13 @@
+package tuningconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTuningConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Tuning Config Suite")
+}
@@ -63,9 +63,10 @@ type TuningConfigList struct {
 
 // Struct for the 'rosa describe cluster' output
 type TuningConfigDescription struct {
-	Name string `yaml:"Name,omitempty"`
-	ID   string `yaml:"ID,omitempty"`
-	Spec string `yaml:"Spec,omitempty"`
+	Name      string `yaml:"Name,omitempty"`
+	ID        string `yaml:"ID,omitempty"`
+	NodePools string `yaml:"Node pools,omitempty"`
+	Spec      string `yaml:"Spec,omitempty"`
 }
 
 type TuningConfigSpecRoot struct {